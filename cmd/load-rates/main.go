@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/repo"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/import_exchange_rates"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	committer "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// A small loader that imports exchange rates from a JSON or CSV file.
+// The format is chosen by file extension. Both formats carry the same fields:
+//
+//	JSON: [{"from": "EUR", "to": "USD", "rate": "1.085", "effective_at": "2026-01-01T00:00:00Z"}]
+//	CSV:  from,to,rate,effective_at (header row required)
+//
+// Rates are decimal strings to keep them exact; effective_at is RFC3339.
+//
+// Usage (emulator):
+//
+//	set SPANNER_EMULATOR_HOST=localhost:9010
+//	set SPANNER_DATABASE=projects/test-project/instances/emulator-instance/databases/test-db
+//	go run ./cmd/load-rates rates.csv
+func main() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	if len(os.Args) != 2 {
+		log.Fatal("usage: load-rates <rates.json|rates.csv>")
+	}
+	path := os.Args[1]
+
+	db := os.Getenv("SPANNER_DATABASE")
+	if db == "" {
+		log.Fatal("SPANNER_DATABASE is required (e.g. projects/test-project/instances/emulator-instance/databases/test-db)")
+	}
+
+	rates, err := readRates(path)
+	if err != nil {
+		log.Fatalf("read %s: %v", path, err)
+	}
+
+	client, err := spanner.NewClient(ctx, db)
+	if err != nil {
+		log.Fatalf("spanner.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	uc := import_exchange_rates.NewInteractor(repo.NewExchangeRateRepo(), repo.NewOutboxRepo(), committer.NewAdapter(client), clock.RealClock{})
+	n, err := uc.Execute(ctx, import_exchange_rates.Request{Rates: rates})
+	if err != nil {
+		log.Fatalf("import: %v", err)
+	}
+
+	fmt.Printf("Imported %d exchange rate(s) into %s\n", n, db)
+}
+
+// rateRecord is the file representation of one rate.
+type rateRecord struct {
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Rate        string `json:"rate"`
+	EffectiveAt string `json:"effective_at"`
+}
+
+func readRates(path string) ([]import_exchange_rates.Rate, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []rateRecord
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		records, err = decodeJSON(f)
+	case ".csv":
+		records, err = decodeCSV(f)
+	default:
+		return nil, fmt.Errorf("unsupported file extension %q (want .json or .csv)", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]import_exchange_rates.Rate, 0, len(records))
+	for i, rec := range records {
+		rate, ok := new(big.Rat).SetString(strings.TrimSpace(rec.Rate))
+		if !ok {
+			return nil, fmt.Errorf("record %d: invalid rate %q", i+1, rec.Rate)
+		}
+		effectiveAt, err := time.Parse(time.RFC3339, strings.TrimSpace(rec.EffectiveAt))
+		if err != nil {
+			return nil, fmt.Errorf("record %d: invalid effective_at %q: %w", i+1, rec.EffectiveAt, err)
+		}
+		out = append(out, import_exchange_rates.Rate{
+			From:        strings.TrimSpace(rec.From),
+			To:          strings.TrimSpace(rec.To),
+			Rate:        rate,
+			EffectiveAt: effectiveAt.UTC(),
+		})
+	}
+	return out, nil
+}
+
+func decodeJSON(r io.Reader) ([]rateRecord, error) {
+	var records []rateRecord
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func decodeCSV(r io.Reader) ([]rateRecord, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	// Map header names to column positions so column order is free.
+	idx := map[string]int{}
+	for i, name := range rows[0] {
+		idx[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, col := range []string{"from", "to", "rate", "effective_at"} {
+		if _, ok := idx[col]; !ok {
+			return nil, fmt.Errorf("missing CSV column %q", col)
+		}
+	}
+
+	records := make([]rateRecord, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		records = append(records, rateRecord{
+			From:        row[idx["from"]],
+			To:          row[idx["to"]],
+			Rate:        row[idx["rate"]],
+			EffectiveAt: row[idx["effective_at"]],
+		})
+	}
+	return records, nil
+}
@@ -6,15 +6,30 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"cloud.google.com/go/spanner"
 	database "cloud.google.com/go/spanner/admin/database/apiv1"
 	databasepb "cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/pkg/migration"
 )
 
-// A tiny migration helper that applies the DDL in migrations/001_initial_schema.sql
-// to a Cloud Spanner database (typically the emulator for local dev).
+// migrationsTable records which migration files have already been applied,
+// so `make migrate` can be re-run safely as new files are added.
+const migrationsTable = "schema_migrations"
+
+// A tiny migration helper that applies the SQL files in migrations/ (in lexical
+// order, e.g. 001_initial_schema.sql, 002_price_lists.sql) to a Cloud Spanner
+// database (typically the emulator for local dev). Files that were already
+// applied are skipped. DDL statements are batched; data backfills run between
+// the batches, in file order: UPDATE and DELETE as partitioned DML, INSERT (which
+// partitioned DML does not support) in a regular read-write transaction. A SELECT
+// statement is a data check: the migration stops if it returns any row, listing
+// the rows' first column (e.g. the IDs of rows a backfill cannot convert).
 //
 // Usage (emulator):
 //
@@ -30,13 +45,13 @@ func main() {
 		log.Fatal("SPANNER_DATABASE is required (e.g. projects/test-project/instances/emulator-instance/databases/test-db)")
 	}
 
-	ddlPath := filepath.Join("migrations", "001_initial_schema.sql")
-	stmts, err := readDDLStatements(ddlPath)
+	files, err := filepath.Glob(filepath.Join("migrations", "*.sql"))
 	if err != nil {
-		log.Fatalf("read DDL: %v", err)
+		log.Fatalf("list migrations: %v", err)
 	}
-	if len(stmts) == 0 {
-		log.Fatalf("no DDL statements found in %s", ddlPath)
+	sort.Strings(files)
+	if len(files) == 0 {
+		log.Fatal("no migration files found in migrations/")
 	}
 
 	admin, err := database.NewDatabaseAdminClient(ctx)
@@ -45,37 +60,185 @@ func main() {
 	}
 	defer admin.Close()
 
+	if err := applyDDL(ctx, admin, db, []string{
+		"CREATE TABLE IF NOT EXISTS " + migrationsTable + " (version STRING(255) NOT NULL, applied_at TIMESTAMP NOT NULL) PRIMARY KEY (version)",
+	}); err != nil {
+		log.Fatalf("create %s: %v", migrationsTable, err)
+	}
+
+	client, err := spanner.NewClient(ctx, db)
+	if err != nil {
+		log.Fatalf("spanner.NewClient: %v", err)
+	}
+	defer client.Close()
+
+	applied, err := appliedVersions(ctx, client)
+	if err != nil {
+		log.Fatalf("read %s: %v", migrationsTable, err)
+	}
+
+	total := 0
+	for _, path := range files {
+		version := filepath.Base(path)
+		if applied[version] {
+			continue
+		}
+
+		stmts, err := readStatements(path)
+		if err != nil {
+			log.Fatalf("read migration: %v", err)
+		}
+		if len(stmts) == 0 {
+			log.Fatalf("no statements found in %s", path)
+		}
+
+		if err := applyMigration(ctx, admin, client, db, stmts); err != nil {
+			log.Fatalf("apply %s: %v", version, err)
+		}
+
+		if _, err := client.Apply(ctx, []*spanner.Mutation{
+			spanner.Insert(migrationsTable, []string{"version", "applied_at"}, []interface{}{version, spanner.CommitTimestamp}),
+		}); err != nil {
+			log.Fatalf("record %s: %v", version, err)
+		}
+
+		fmt.Printf("Applied %s (%d statements)\n", version, len(stmts))
+		total++
+	}
+
+	fmt.Printf("Applied %d migration(s) to %s\n", total, db)
+}
+
+// applyMigration applies one file's statements in order. Consecutive DDL
+// statements are sent as one batch; each DML statement flushes the pending
+// batch first so it sees the columns it backfills.
+func applyMigration(ctx context.Context, admin *database.DatabaseAdminClient, client *spanner.Client, db string, stmts []string) error {
+	var batch []string
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := applyDDL(ctx, admin, db, batch)
+		batch = nil
+		return err
+	}
+
+	for _, stmt := range stmts {
+		kind := migration.Classify(stmt)
+		if kind == migration.KindDDL {
+			batch = append(batch, stmt)
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		run := applyDML
+		if kind == migration.KindCheck {
+			run = runCheck
+		}
+		if err := run(ctx, client, stmt); err != nil {
+			return err
+		}
+	}
+	return flush()
+}
+
+// applyDML runs a data statement. INSERT ... SELECT cannot be partitioned, so it
+// runs in one read-write transaction; UPDATE and DELETE run as partitioned DML.
+func applyDML(ctx context.Context, client *spanner.Client, stmt string) error {
+	if strings.EqualFold(strings.Fields(stmt)[0], "INSERT") {
+		_, err := client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+			_, err := txn.Update(ctx, spanner.Statement{SQL: stmt})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Update: %w", err)
+		}
+		return nil
+	}
+	if _, err := client.PartitionedUpdate(ctx, spanner.Statement{SQL: stmt}); err != nil {
+		return fmt.Errorf("PartitionedUpdate: %w", err)
+	}
+	return nil
+}
+
+// maxCheckRows bounds how many offending rows a failed check lists.
+const maxCheckRows = 50
+
+// runCheck runs a data check and fails, listing the first column of the rows it
+// returned, if it returned any.
+func runCheck(ctx context.Context, client *spanner.Client, stmt string) error {
+	iter := client.Single().Query(ctx, spanner.Statement{SQL: stmt})
+	defer iter.Stop()
+
+	var offending []string
+	count := 0
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("check: %w", err)
+		}
+		count++
+		if len(offending) < maxCheckRows {
+			var v spanner.GenericColumnValue
+			if err := row.Column(0, &v); err != nil {
+				return fmt.Errorf("check: %w", err)
+			}
+			offending = append(offending, v.Value.GetStringValue())
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	list := strings.Join(offending, ", ")
+	if count > len(offending) {
+		list += fmt.Sprintf(", ... (%d more)", count-len(offending))
+	}
+	return fmt.Errorf("check failed for %d row(s): %s", count, list)
+}
+
+func applyDDL(ctx context.Context, admin *database.DatabaseAdminClient, db string, stmts []string) error {
 	op, err := admin.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
 		Database:   db,
 		Statements: stmts,
 	})
 	if err != nil {
-		log.Fatalf("UpdateDatabaseDdl: %v", err)
+		return fmt.Errorf("UpdateDatabaseDdl: %w", err)
 	}
-
 	if err := op.Wait(ctx); err != nil {
-		log.Fatalf("UpdateDatabaseDdl wait: %v", err)
+		return fmt.Errorf("UpdateDatabaseDdl wait: %w", err)
 	}
+	return nil
+}
 
-	fmt.Printf("Applied %d DDL statements to %s\n", len(stmts), db)
+func appliedVersions(ctx context.Context, client *spanner.Client) (map[string]bool, error) {
+	iter := client.Single().Query(ctx, spanner.Statement{SQL: "SELECT version FROM " + migrationsTable})
+	defer iter.Stop()
+
+	out := map[string]bool{}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var v string
+		if err := row.Columns(&v); err != nil {
+			return nil, err
+		}
+		out[v] = true
+	}
 }
 
-func readDDLStatements(path string) ([]string, error) {
+func readStatements(path string) ([]string, error) {
 	b, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
-	// Normalize line endings for Windows-authored files.
-	sql := strings.ReplaceAll(string(b), "\r\n", "\n")
-
-	parts := strings.Split(sql, ";")
-	out := make([]string, 0, len(parts))
-	for _, p := range parts {
-		stmt := strings.TrimSpace(p)
-		if stmt == "" {
-			continue
-		}
-		out = append(out, stmt)
-	}
-	return out, nil
+	return migration.Split(string(b)), nil
 }
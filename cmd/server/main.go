@@ -12,16 +12,64 @@ import (
 	"cloud.google.com/go/spanner"
 	"google.golang.org/grpc"
 
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/attribute_definitions"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/categories"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_price_lists"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/price_change_requests"
 	"github.com/murkotick/product-catalog-service/internal/app/product/repo"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_channel"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_relation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_tags"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/adjust_stock"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_discount"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_scheduled_availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_scheduled_prices"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/approve_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/cancel_scheduled_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/deactivate_channel"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/define_attribute"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/delete_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/delete_price_list"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/move_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reject_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/release_reservation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_attribute"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_discount"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_price_list_entry"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_relation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_tags"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_translation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reorder_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reserve_stock"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_bundle"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_channel_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_regions"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_translation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_variant"
 	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
 	committer "github.com/murkotick/product-catalog-service/internal/pkg/committer"
 	grpcproduct "github.com/murkotick/product-catalog-service/internal/transport/grpc/product"
@@ -32,6 +80,36 @@ func main() {
 	addr := env("GRPC_ADDR", ":50051")
 	spannerDB := env("SPANNER_DATABASE", "projects/test-project/instances/emulator-instance/databases/test-db")
 
+	// e.g. PRICE_ROUNDING="currency:JPY=floor;category:grocery=psychological"
+	roundingRules, err := domain.ParseRoundingRules(env("PRICE_ROUNDING", ""))
+	if err != nil {
+		log.Fatalf("PRICE_ROUNDING: %v", err)
+	}
+
+	// e.g. MIN_MARGINS="default=0.1;category:grocery=0.05"
+	margins, err := domain.ParseMarginPolicy(env("MIN_MARGINS", ""))
+	if err != nil {
+		log.Fatalf("MIN_MARGINS: %v", err)
+	}
+
+	// e.g. PRICE_APPROVAL_THRESHOLDS="price_drop=0.3;price_increase=1;discount=0.5"
+	approvals, err := domain.ParseApprovalPolicy(env("PRICE_APPROVAL_THRESHOLDS", ""))
+	if err != nil {
+		log.Fatalf("PRICE_APPROVAL_THRESHOLDS: %v", err)
+	}
+
+	// How often due scheduled price changes are applied; "0" disables the scheduler.
+	schedulerInterval, err := time.ParseDuration(env("PRICE_SCHEDULER_INTERVAL", "1m"))
+	if err != nil || schedulerInterval < 0 {
+		log.Fatalf("PRICE_SCHEDULER_INTERVAL: invalid duration %q", os.Getenv("PRICE_SCHEDULER_INTERVAL"))
+	}
+
+	// How often due launches and discontinuations are performed; "0" disables the scheduler.
+	availabilityInterval, err := time.ParseDuration(env("AVAILABILITY_SCHEDULER_INTERVAL", "1m"))
+	if err != nil || availabilityInterval < 0 {
+		log.Fatalf("AVAILABILITY_SCHEDULER_INTERVAL: invalid duration %q", os.Getenv("AVAILABILITY_SCHEDULER_INTERVAL"))
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -53,21 +131,91 @@ func main() {
 	clk := clock.RealClock{}
 	prodRepo := repo.NewProductRepo()
 	outboxRepo := repo.NewOutboxRepo()
+	priceListRepo := repo.NewPriceListRepo()
+	changeRequestRepo := repo.NewPriceChangeRequestRepo()
+	attributeRepo := repo.NewAttributeSchemaRepo()
+	categoryRepo := repo.NewCategoryRepo()
+	stockRepo := repo.NewStockRepo()
 	cm := committer.NewAdapter(client)
-	readModel := queries.NewSpannerReadModel(client)
+	readModel := queries.NewSpannerReadModel(client, queries.WithRoundingPolicy(domain.NewRoundingPolicy(roundingRules...)))
 
 	// CQRS wiring
 	cmds := grpcproduct.Commands{
-		Create:     create_product.NewInteractor(prodRepo, outboxRepo, cm, clk),
-		Update:     update_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		Create:     create_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, readModel, readModel, margins, clk),
+		Update:     update_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, readModel, readModel, margins, clk),
 		Activate:   activate_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
 		Deactivate: deactivate_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
-		ApplyDis:   apply_discount.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		Archive:    archive_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		ApplyDis:   apply_discount.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, margins, approvals, clk),
 		RemoveDis:  remove_discount.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		SetPrice:    set_product_price.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, margins, approvals, clk),
+		RemovePrice: remove_product_price.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		SchedulePrice:        schedule_price_change.NewInteractor(prodRepo, outboxRepo, cm, readModel, margins, approvals, clk),
+		CancelScheduledPrice: cancel_scheduled_price_change.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		ApproveChange: approve_price_change.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, readModel, margins, clk),
+		RejectChange:  reject_price_change.NewInteractor(changeRequestRepo, outboxRepo, cm, readModel, clk),
+
+		AddVariant:    add_variant.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, margins, approvals, clk),
+		UpdateVariant: update_variant.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, margins, approvals, clk),
+		RemoveVariant: remove_variant.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		AddTags:    add_tags.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		RemoveTags: remove_tags.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		SetTranslation:    set_translation.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		RemoveTranslation: remove_translation.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		AddMedia:     add_media.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		ReorderMedia: reorder_media.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		RemoveMedia:  remove_media.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		AddRelation:    add_relation.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		RemoveRelation: remove_relation.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		SetBundle: set_bundle.NewInteractor(prodRepo, outboxRepo, cm, readModel, margins, clk),
+
+		AdjustStock:        adjust_stock.NewInteractor(stockRepo, outboxRepo, cm, readModel, readModel, clk),
+		ReserveStock:       reserve_stock.NewInteractor(stockRepo, outboxRepo, cm, readModel, readModel, clk),
+		ReleaseReservation: release_reservation.NewInteractor(stockRepo, outboxRepo, cm, readModel, clk),
+
+		ActivateChannel:   activate_channel.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		DeactivateChannel: deactivate_channel.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		SetChannelPrice:   set_channel_price.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, margins, approvals, clk),
+
+		SetRegions:      set_product_regions.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+		SetAvailability: set_product_availability.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk),
+
+		CreatePriceList:  create_price_list.NewInteractor(priceListRepo, outboxRepo, cm, readModel, clk),
+		UpdatePriceList:  update_price_list.NewInteractor(priceListRepo, outboxRepo, cm, readModel, clk),
+		DeletePriceList:  delete_price_list.NewInteractor(priceListRepo, outboxRepo, cm, readModel, clk),
+		SetPriceEntry:    set_price_list_entry.NewInteractor(priceListRepo, outboxRepo, cm, readModel, readModel, clk),
+		RemovePriceEntry: remove_price_list_entry.NewInteractor(priceListRepo, outboxRepo, cm, readModel, clk),
+
+		SetTaxRate: set_tax_rate.NewInteractor(repo.NewTaxRateRepo(), outboxRepo, cm, clk),
+
+		DefineAttribute: define_attribute.NewInteractor(attributeRepo, outboxRepo, cm, readModel, readModel, clk),
+		RemoveAttribute: remove_attribute.NewInteractor(attributeRepo, outboxRepo, cm, readModel, clk),
+
+		CreateCategory: create_category.NewInteractor(categoryRepo, outboxRepo, cm, readModel, clk),
+		UpdateCategory: update_category.NewInteractor(categoryRepo, outboxRepo, cm, readModel, clk),
+		MoveCategory:   move_category.NewInteractor(categoryRepo, outboxRepo, cm, readModel, clk),
+		DeleteCategory: delete_category.NewInteractor(categoryRepo, outboxRepo, cm, readModel, clk),
 	}
 	qrys := grpcproduct.Queries{
 		Get:  get_product.NewHandler(readModel),
 		List: list_products.NewHandler(readModel),
+
+		GetPriceList:   get_price_list.NewHandler(readModel),
+		ListPriceLists: list_price_lists.NewHandler(readModel),
+
+		ListChangeRequests: price_change_requests.NewHandler(readModel),
+
+		ListAttributes: attribute_definitions.NewHandler(readModel),
+
+		Categories: categories.NewHandler(readModel),
 	}
 	h := grpcproduct.NewHandler(cmds, qrys)
 
@@ -88,6 +236,15 @@ func main() {
 		}
 	}()
 
+	if schedulerInterval > 0 {
+		scheduler := apply_scheduled_prices.NewInteractor(prodRepo, outboxRepo, cm, readModel, readModel, margins, clk)
+		go runPriceScheduler(ctx, scheduler, schedulerInterval)
+	}
+	if availabilityInterval > 0 {
+		scheduler := apply_scheduled_availability.NewInteractor(cmds.Activate, cmds.Deactivate, readModel, clk)
+		go runAvailabilityScheduler(ctx, scheduler, availabilityInterval)
+	}
+
 	<-ctx.Done()
 	stopped := make(chan struct{})
 	go func() {
@@ -104,6 +261,48 @@ func main() {
 	log.Println("server stopped")
 }
 
+// runPriceScheduler applies due scheduled price changes every interval until ctx is done.
+func runPriceScheduler(ctx context.Context, it *apply_scheduled_prices.Interactor, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			applied, err := it.Execute(ctx, apply_scheduled_prices.Request{})
+			if applied > 0 {
+				log.Printf("price scheduler: applied %d scheduled price changes", applied)
+			}
+			if err != nil {
+				log.Printf("price scheduler: %v", err)
+			}
+		}
+	}
+}
+
+// runAvailabilityScheduler performs due launches and discontinuations every interval until ctx is done.
+func runAvailabilityScheduler(ctx context.Context, it *apply_scheduled_availability.Interactor, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			performed, err := it.Execute(ctx, apply_scheduled_availability.Request{})
+			if performed > 0 {
+				log.Printf("availability scheduler: performed %d scheduled transitions", performed)
+			}
+			if err != nil {
+				log.Printf("availability scheduler: %v", err)
+			}
+		}
+	}
+}
+
 func env(key, def string) string {
 	v := os.Getenv(key)
 	if v == "" {
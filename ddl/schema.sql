@@ -1,17 +1,37 @@
+-- The schema after every migration in migrations/ has run. Change it through a new
+-- migration, then update the CREATE statements here to match.
+
 CREATE TABLE products (
   product_id STRING(36) NOT NULL,
   name STRING(255) NOT NULL,
   description STRING(MAX),
   category STRING(100) NOT NULL,
-  base_price_numerator INT64 NOT NULL,
-  base_price_denominator INT64 NOT NULL,
+  category_id STRING(36) NOT NULL,
+  base_price NUMERIC NOT NULL,
+  currency STRING(3) NOT NULL DEFAULT ('USD'),
+  cost_price NUMERIC,
+  tax_category STRING(50) NOT NULL,
+  package_quantity NUMERIC,
+  unit_of_measure STRING(8),
   discount_percent NUMERIC,
   discount_start_date TIMESTAMP,
   discount_end_date TIMESTAMP,
   status STRING(20) NOT NULL,
+  sku STRING(64),
+  gtin STRING(14),
+  slug STRING(120) NOT NULL,
+  default_locale STRING(10) NOT NULL,
+  product_type STRING(10) NOT NULL,
+  bundle_pricing STRING(20),
+  bundle_discount NUMERIC,
+  allowed_regions ARRAY<STRING(2)>,
+  blocked_regions ARRAY<STRING(2)>,
+  available_from TIMESTAMP,
+  available_until TIMESTAMP,
   created_at TIMESTAMP NOT NULL,
   updated_at TIMESTAMP NOT NULL,
-  archived_at TIMESTAMP
+  archived_at TIMESTAMP,
+  CONSTRAINT ck_products_package_size CHECK ((package_quantity IS NULL) = (unit_of_measure IS NULL))
 ) PRIMARY KEY (product_id);
 
 CREATE TABLE outbox_events (
@@ -25,4 +45,243 @@ CREATE TABLE outbox_events (
 ) PRIMARY KEY (event_id);
 
 CREATE INDEX idx_outbox_status ON outbox_events(status, created_at);
-CREATE INDEX idx_products_category ON products(category, status);
\ No newline at end of file
+CREATE INDEX idx_products_category ON products(category, status);
+
+CREATE TABLE price_lists (
+  price_list_id STRING(36) NOT NULL,
+  segment STRING(50) NOT NULL,
+  name STRING(255) NOT NULL,
+  description STRING(MAX),
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (price_list_id);
+
+CREATE UNIQUE INDEX idx_price_lists_segment ON price_lists(segment);
+
+CREATE TABLE price_list_entries (
+  price_list_id STRING(36) NOT NULL,
+  product_id STRING(36) NOT NULL,
+  override_price NUMERIC,
+  override_currency STRING(3),
+  adjustment_percent NUMERIC,
+  updated_at TIMESTAMP NOT NULL,
+  CONSTRAINT ck_price_list_entry_rule CHECK (override_price IS NOT NULL OR adjustment_percent IS NOT NULL)
+) PRIMARY KEY (price_list_id, product_id),
+  INTERLEAVE IN PARENT price_lists ON DELETE CASCADE;
+
+CREATE TABLE product_prices (
+  product_id STRING(36) NOT NULL,
+  currency STRING(3) NOT NULL,
+  price NUMERIC NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, currency),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE TABLE exchange_rates (
+  from_currency STRING(3) NOT NULL,
+  to_currency STRING(3) NOT NULL,
+  effective_at TIMESTAMP NOT NULL,
+  rate NUMERIC NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (from_currency, to_currency, effective_at DESC);
+
+CREATE TABLE tax_rates (
+  region STRING(8) NOT NULL,
+  tax_category STRING(50) NOT NULL,
+  effective_at TIMESTAMP NOT NULL,
+  rate NUMERIC NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (region, tax_category, effective_at DESC);
+
+CREATE TABLE scheduled_price_changes (
+  product_id STRING(36) NOT NULL,
+  schedule_id STRING(36) NOT NULL,
+  price NUMERIC NOT NULL,
+  currency STRING(3) NOT NULL,
+  effective_at TIMESTAMP NOT NULL,
+  status STRING(20) NOT NULL,
+  margin_override BOOL NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, schedule_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_scheduled_price_changes_due
+  ON scheduled_price_changes(status, effective_at);
+
+CREATE TABLE price_change_requests (
+  request_id STRING(36) NOT NULL,
+  product_id STRING(36) NOT NULL,
+  kind STRING(20) NOT NULL,
+  variant_id STRING(36),
+  channel STRING(30),
+  currency STRING(3),
+  old_price NUMERIC,
+  new_price NUMERIC,
+  discount_percent NUMERIC,
+  discount_start_date TIMESTAMP,
+  discount_end_date TIMESTAMP,
+  override_margin BOOL NOT NULL,
+  requested_by STRING(100),
+  requested_role STRING(50),
+  status STRING(20) NOT NULL,
+  decided_by STRING(100),
+  decision_reason STRING(1000),
+  created_at TIMESTAMP NOT NULL,
+  decided_at TIMESTAMP
+) PRIMARY KEY (request_id);
+
+CREATE INDEX idx_price_change_requests_product_status
+  ON price_change_requests(product_id, status);
+
+CREATE TABLE product_variants (
+  product_id STRING(36) NOT NULL,
+  variant_id STRING(36) NOT NULL,
+  sku STRING(64) NOT NULL,
+  option_values JSON NOT NULL,
+  price NUMERIC,
+  status STRING(20) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, variant_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_product_variants_sku ON product_variants(sku);
+
+CREATE TABLE attribute_definitions (
+  category STRING(100) NOT NULL,
+  name STRING(50) NOT NULL,
+  attribute_type STRING(20) NOT NULL,
+  required BOOL NOT NULL,
+  enum_values ARRAY<STRING(100)>,
+  unit STRING(20),
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (category, name);
+
+CREATE TABLE product_attributes (
+  product_id STRING(36) NOT NULL,
+  name STRING(50) NOT NULL,
+  value STRING(500) NOT NULL,
+  number_value NUMERIC,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, name),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_attributes_name_value ON product_attributes(name, value);
+
+CREATE TABLE categories (
+  category_id STRING(36) NOT NULL,
+  parent_id STRING(36),
+  name STRING(100) NOT NULL,
+  slug STRING(100) NOT NULL,
+  path STRING(300) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (category_id);
+
+CREATE UNIQUE INDEX idx_categories_slug ON categories(slug);
+
+CREATE INDEX idx_categories_parent ON categories(parent_id);
+
+CREATE INDEX idx_categories_path ON categories(path);
+
+CREATE INDEX idx_products_category_id ON products(category_id, status);
+
+CREATE TABLE product_tags (
+  product_id STRING(36) NOT NULL,
+  tag STRING(50) NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, tag),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_tags_tag ON product_tags(tag);
+
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_sku ON products(sku);
+
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_gtin ON products(gtin);
+
+CREATE UNIQUE INDEX idx_products_slug ON products(slug);
+
+CREATE TABLE product_slug_history (
+  slug STRING(120) NOT NULL,
+  product_id STRING(36) NOT NULL,
+  retired_at TIMESTAMP NOT NULL
+) PRIMARY KEY (slug);
+
+CREATE INDEX idx_product_slug_history_product ON product_slug_history(product_id);
+
+CREATE TABLE product_translations (
+  product_id STRING(36) NOT NULL,
+  locale STRING(10) NOT NULL,
+  name STRING(255) NOT NULL,
+  description STRING(MAX),
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, locale),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE TABLE product_media (
+  product_id STRING(36) NOT NULL,
+  media_id STRING(36) NOT NULL,
+  url STRING(2048) NOT NULL,
+  kind STRING(20) NOT NULL,
+  alt_texts JSON NOT NULL,
+  sort_order INT64 NOT NULL,
+  is_primary BOOL NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, media_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE TABLE product_relations (
+  product_id STRING(36) NOT NULL,
+  relation_type STRING(30) NOT NULL,
+  related_product_id STRING(36) NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, relation_type, related_product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_relations_related ON product_relations(related_product_id, relation_type);
+
+CREATE TABLE product_bundle_components (
+  product_id STRING(36) NOT NULL,
+  component_product_id STRING(36) NOT NULL,
+  quantity INT64 NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, component_product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_bundle_components_component ON product_bundle_components(component_product_id);
+
+CREATE TABLE stock_levels (
+  product_id STRING(36) NOT NULL,
+  location_id STRING(50) NOT NULL,
+  on_hand INT64 NOT NULL,
+  reserved INT64 NOT NULL,
+  low_stock_threshold INT64 NOT NULL,
+  version INT64 NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  CONSTRAINT ck_stock_levels_reserved CHECK (reserved >= 0 AND reserved <= on_hand)
+) PRIMARY KEY (product_id, location_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE TABLE stock_reservations (
+  reservation_id STRING(36) NOT NULL,
+  product_id STRING(36) NOT NULL,
+  location_id STRING(50) NOT NULL,
+  quantity INT64 NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (reservation_id);
+
+CREATE TABLE product_channels (
+  product_id STRING(36) NOT NULL,
+  channel STRING(30) NOT NULL,
+  active BOOL NOT NULL,
+  price_override NUMERIC,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, channel),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_products_available_from ON products(available_from);
+
+CREATE INDEX idx_products_available_until ON products(available_until);
@@ -0,0 +1,13 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// AttributeSchemaRepo is the write-side repository interface for category attribute schemas.
+// Methods return Spanner mutations; they do not apply them.
+type AttributeSchemaRepo interface {
+	// DefinitionMuts returns upserts or deletes for definitions marked dirty, or nil.
+	DefinitionMuts(s *domain.AttributeSchema) []*spanner.Mutation
+}
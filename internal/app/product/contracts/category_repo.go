@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// CategoryRepo is the write-side repository interface for the category taxonomy.
+// Methods return Spanner mutations; they do not apply them.
+type CategoryRepo interface {
+	// InsertMut returns a mutation that inserts the category.
+	InsertMut(c *domain.Category) *spanner.Mutation
+
+	// UpdateMut returns a mutation that updates the category according to its ChangeTracker (or nil).
+	UpdateMut(c *domain.Category) *spanner.Mutation
+
+	// DeleteMut returns a mutation that deletes the category.
+	DeleteMut(c *domain.Category) *spanner.Mutation
+}
@@ -0,0 +1,16 @@
+package contracts
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// ExchangeRateRepo is the write-side repository interface for exchange rates.
+// Methods return Spanner mutations; they do not apply them.
+type ExchangeRateRepo interface {
+	// UpsertMut returns a mutation that stores the rate, replacing any rate
+	// for the same pair and effective time.
+	UpsertMut(r *domain.ExchangeRate, now time.Time) *spanner.Mutation
+}
@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// PriceChangeRequestRepo is the write-side repository interface for price change requests.
+// Methods return Spanner mutations and commit checks; they do not apply them.
+type PriceChangeRequestRepo interface {
+	// InsertMut returns a mutation that inserts the request.
+	InsertMut(r *domain.PriceChangeRequest) *spanner.Mutation
+
+	// UpdateMut returns a mutation that records the request's decision (or nil if undecided).
+	UpdateMut(r *domain.PriceChangeRequest) *spanner.Mutation
+
+	// PendingCheck returns a check failing with domain.ErrPriceChangeRequestNotPending when
+	// the request was decided by someone else after it was loaded.
+	PendingCheck(r *domain.PriceChangeRequest) commitplan.Check
+}
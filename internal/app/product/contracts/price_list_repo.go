@@ -0,0 +1,25 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// PriceListRepo is the write-side repository interface for customer-segment price lists.
+// Methods return Spanner mutations; they do not apply them.
+type PriceListRepo interface {
+	// InsertMut returns a mutation that inserts the price list.
+	InsertMut(pl *domain.PriceList) *spanner.Mutation
+
+	// UpdateMut returns a mutation that updates the price list according to its ChangeTracker (or nil).
+	UpdateMut(pl *domain.PriceList) *spanner.Mutation
+
+	// DeleteMut returns a mutation that deletes the price list together with its entries.
+	DeleteMut(pl *domain.PriceList) *spanner.Mutation
+
+	// UpsertEntryMut returns a mutation that creates or replaces an entry and stamps the list's updated_at.
+	UpsertEntryMut(pl *domain.PriceList, entry *domain.PriceListEntry) *spanner.Mutation
+
+	// DeleteEntryMut returns a mutation that removes the entry for a product.
+	DeleteEntryMut(pl *domain.PriceList, productID string) *spanner.Mutation
+}
@@ -14,6 +14,34 @@ type ProductRepo interface {
 	// UpdateMut returns a mutation that updates the product according to its ChangeTracker (or nil).
 	UpdateMut(p *domain.Product) *spanner.Mutation
 
+	// CurrencyPriceMuts returns mutations for per-currency prices marked dirty
+	// (upserts for set prices, deletes for removed ones), or nil.
+	CurrencyPriceMuts(p *domain.Product) []*spanner.Mutation
+
+	// ScheduledPriceMuts returns upserts for scheduled price changes marked dirty, or nil.
+	ScheduledPriceMuts(p *domain.Product) []*spanner.Mutation
+
+	// VariantMuts returns upserts or deletes for variants marked dirty, or nil.
+	VariantMuts(p *domain.Product) []*spanner.Mutation
+
+	// AttributeMuts returns upserts or deletes for attribute values marked dirty, or nil.
+	AttributeMuts(p *domain.Product) []*spanner.Mutation
+
+	// TagMuts returns inserts or deletes for tags marked dirty, or nil.
+	TagMuts(p *domain.Product) []*spanner.Mutation
+	// MediaMuts returns upserts or deletes for media entries marked dirty, or nil.
+	MediaMuts(p *domain.Product) []*spanner.Mutation
+	// RelationMuts returns inserts or deletes for links to related products marked dirty, or nil.
+	RelationMuts(p *domain.Product) []*spanner.Mutation
+	// ChannelMuts returns upserts for sales channel assignments marked dirty, or nil.
+	ChannelMuts(p *domain.Product) []*spanner.Mutation
+	// BundleComponentMuts returns upserts or deletes for bundle components marked dirty, or nil.
+	BundleComponentMuts(p *domain.Product) []*spanner.Mutation
+	// TranslationMuts returns upserts or deletes for translations marked dirty, or nil.
+	TranslationMuts(p *domain.Product) []*spanner.Mutation
+	// SlugHistoryMuts returns the slug history changes for a changed slug, or nil.
+	SlugHistoryMuts(p *domain.Product) []*spanner.Mutation
+
 	// ArchiveMut returns a mutation to soft-delete (archive) the product (or nil).
 	ArchiveMut(p *domain.Product) *spanner.Mutation
 }
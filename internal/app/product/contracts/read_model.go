@@ -2,11 +2,169 @@ package contracts
 
 import (
 	"context"
+	"math/big"
+	"time"
 
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
 )
 
+// ProductReadOptions tunes how read queries price products.
+// The zero value returns retail prices.
+type ProductReadOptions struct {
+	// Segment selects a customer-segment price list (e.g. "wholesale").
+	// Empty means no price list is applied.
+	Segment string
+
+	// Currency selects the ISO 4217 currency prices are returned in (e.g. "EUR").
+	// Empty means each product's primary currency. Products without a price in the
+	// requested currency are not found (GetProduct) or skipped (ListActiveProducts).
+	Currency string
+
+	// DisplayCurrency converts effective prices into the shopper's currency using the
+	// exchange rate in effect, rounded to the currency's minor units. Empty means no conversion.
+	DisplayCurrency string
+
+	// TaxRegion adds a net/tax/gross breakdown of the effective price using the rate in
+	// effect for each product's tax category in the region (e.g. "DE"). Empty means none.
+	TaxRegion string
+
+	// At evaluates discounts, scheduled price changes, exchange rates and tax rates
+	// as of this time instead of now. The zero value means now.
+	At time.Time
+
+	// Locales lists the reader's locales, most preferred first (see
+	// domain.ParseAcceptLanguage). Names and descriptions are returned in the first
+	// of them, or their languages, the product is translated into; empty means
+	// each product's default locale.
+	Locales []string
+
+	// Channel selects a sales channel (e.g. "web"): products not active on it are not
+	// found (GetProduct) or skipped (ListActiveProducts), and its price override, if
+	// any, replaces the primary base price. Empty means no channel.
+	Channel string
+
+	// Region selects the ISO 3166-1 alpha-2 region the shopper is in (e.g. "DE"):
+	// products that do not ship to it are not found (GetProduct) or skipped
+	// (ListActiveProducts). Empty means every region.
+	Region string
+}
+
+// AttributeFilter restricts product listings to products whose custom attribute
+// Name equals Value (case-insensitively; numbers compare by value) and, for number
+// attributes, lies within [Min, Max]. Nil bounds and a nil Value are not checked.
+type AttributeFilter struct {
+	Name  string
+	Value *string
+	Min   *big.Rat
+	Max   *big.Rat
+}
+
+// TagMatch says how a product's tags must match the tags of a ProductFilter.
+type TagMatch string
+
+const (
+	// TagMatchAny selects products carrying at least one of the tags.
+	TagMatchAny TagMatch = "any"
+
+	// TagMatchAll selects products carrying every one of the tags.
+	TagMatchAll TagMatch = "all"
+)
+
+// ProductFilter restricts product listings; the zero value lists every active product.
+type ProductFilter struct {
+	// Category selects products in the category with this slug (or a name that
+	// slugifies to it, e.g. "Home Office"), without its subcategories.
+	Category *string
+
+	// CategoryID selects products anywhere in the subtree rooted at this category.
+	CategoryID *string
+
+	// Attributes must all match one of the product's custom attribute values.
+	Attributes []AttributeFilter
+
+	// Tags restricts listings to tagged products as TagMatch says (TagMatchAny when
+	// empty); the tags are normalized like domain.NormalizeTag.
+	Tags     []string
+	TagMatch TagMatch
+
+	// RelatedTo lists the products the product with this ID links to, of any of
+	// RelationTypes (every type when empty), ordered by type and the time they were
+	// linked. With RelatedInverse it lists the products linking to it instead.
+	RelatedTo      *string
+	RelationTypes  []domain.RelationType
+	RelatedInverse bool
+
+	// InStock restricts listings to products with units available (on hand and not
+	// reserved) at some location.
+	InStock bool
+}
+
 type ReadModel interface {
-	GetProduct(ctx context.Context, productID string) (*dto.ProductDTO, error)
-	ListActiveProducts(ctx context.Context, category *string, limit, offset int) ([]*dto.ProductSummaryDTO, error)
+	GetProduct(ctx context.Context, productID string, opts ProductReadOptions) (*dto.ProductDTO, error)
+	// FindProductIDBySKU resolves a product SKU, or a variant SKU to its product;
+	// FindProductIDByGTIN resolves a GTIN-14. Both return domain.ErrProductNotFound when nothing matches.
+	FindProductIDBySKU(ctx context.Context, sku string) (string, error)
+	FindProductIDByGTIN(ctx context.Context, gtin string) (string, error)
+	// FindProductIDBySlug resolves a current or former product slug; current is false
+	// for a slug the product had before a rename. Returns domain.ErrProductNotFound when nothing matches.
+	FindProductIDBySlug(ctx context.Context, slug string) (productID string, current bool, err error)
+	// FindBundleIDsByComponent lists the IDs of the bundles, in any status, that contain
+	// the product as a component; none is not an error.
+	FindBundleIDsByComponent(ctx context.Context, productID string) ([]string, error)
+	ListActiveProducts(ctx context.Context, filter ProductFilter, opts ProductReadOptions, limit, offset int) ([]*dto.ProductSummaryDTO, error)
+}
+
+// CategoryReadModel serves the category taxonomy for both queries and interactors.
+type CategoryReadModel interface {
+	GetCategory(ctx context.Context, categoryID string) (*dto.CategoryDTO, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*dto.CategoryDTO, error)
+	// ListCategories lists the children of parentID ordered by name; an empty parentID lists the root categories.
+	ListCategories(ctx context.Context, parentID string) ([]*dto.CategoryDTO, error)
+	// ListCategoryDescendants lists every category below categoryID, shallowest first.
+	ListCategoryDescendants(ctx context.Context, categoryID string) ([]*dto.CategoryDTO, error)
+	// CountCategoryProducts counts the products of any status that belong directly to the category.
+	CountCategoryProducts(ctx context.Context, categoryID string) (int, error)
+}
+
+// AttributeSchemaReadModel serves category attribute definitions for both queries and interactors.
+type AttributeSchemaReadModel interface {
+	// ListAttributeDefinitions returns the category's definitions ordered by name; none is not an error.
+	ListAttributeDefinitions(ctx context.Context, category string) ([]*dto.AttributeDefinitionDTO, error)
+}
+
+// PriceListReadModel serves price list reads for both queries and interactors.
+type PriceListReadModel interface {
+	GetPriceList(ctx context.Context, priceListID string) (*dto.PriceListDTO, error)
+	GetPriceListBySegment(ctx context.Context, segment string) (*dto.PriceListDTO, error)
+	ListPriceLists(ctx context.Context) ([]*dto.PriceListDTO, error)
+}
+
+// ScheduledPriceReadModel finds scheduled price changes the scheduler has to apply.
+type ScheduledPriceReadModel interface {
+	// ListDueScheduledPriceChanges returns up to limit pending changes effective at or
+	// before the given time, oldest first.
+	ListDueScheduledPriceChanges(ctx context.Context, at time.Time, limit int) ([]*dto.DueScheduledPriceChangeDTO, error)
+}
+
+// ScheduledAvailabilityReadModel finds launches and discontinuations the scheduler has to perform.
+type ScheduledAvailabilityReadModel interface {
+	// ListDueAvailabilityTransitions returns up to limit products due for activation or
+	// deactivation at the given time, oldest first.
+	ListDueAvailabilityTransitions(ctx context.Context, at time.Time, limit int) ([]*dto.DueAvailabilityTransitionDTO, error)
+}
+
+// PriceChangeRequestReadModel serves price change request reads for both queries and interactors.
+type PriceChangeRequestReadModel interface {
+	GetPriceChangeRequest(ctx context.Context, requestID string) (*dto.PriceChangeRequestDTO, error)
+	// ListPriceChangeRequests lists requests, newest first, optionally filtered by product and status.
+	ListPriceChangeRequests(ctx context.Context, productID, status string, limit, offset int) ([]*dto.PriceChangeRequestDTO, error)
+}
+
+// StockReadModel serves inventory reads for interactors.
+type StockReadModel interface {
+	// GetStockLevel returns domain.ErrStockLevelNotFound when the product has no stock at the location.
+	GetStockLevel(ctx context.Context, productID, locationID string) (*dto.StockLevelDTO, error)
+	// GetStockReservation returns domain.ErrReservationNotFound for unknown or released reservations.
+	GetStockReservation(ctx context.Context, reservationID string) (*dto.StockReservationDTO, error)
 }
@@ -0,0 +1,24 @@
+package contracts
+
+import (
+	"cloud.google.com/go/spanner"
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// StockRepo is the write-side repository interface for inventory.
+// Methods return Spanner mutations and commit checks; they do not apply them.
+type StockRepo interface {
+	// SaveMut returns a mutation that writes the stock level with its version bumped.
+	SaveMut(s *domain.StockLevel) *spanner.Mutation
+
+	// VersionCheck returns a check failing with domain.ErrStockConflict when the stock
+	// level was saved by someone else after it was loaded.
+	VersionCheck(s *domain.StockLevel) commitplan.Check
+
+	// ReservationInsertMut returns a mutation that inserts the reservation.
+	ReservationInsertMut(r *domain.StockReservation) *spanner.Mutation
+
+	// ReservationDeleteMut returns a mutation that deletes the reservation.
+	ReservationDeleteMut(r *domain.StockReservation) *spanner.Mutation
+}
@@ -0,0 +1,16 @@
+package contracts
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// TaxRateRepo is the write-side repository interface for tax rates.
+// Methods return Spanner mutations; they do not apply them.
+type TaxRateRepo interface {
+	// UpsertMut returns a mutation that stores the rate, replacing any rate
+	// for the same region, tax category and effective time.
+	UpsertMut(r *domain.TaxRate, now time.Time) *spanner.Mutation
+}
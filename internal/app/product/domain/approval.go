@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+)
+
+// CanApprovePriceChanges reports whether the role may decide price change requests.
+func (r Role) CanApprovePriceChanges() bool {
+	return r == RolePricingManager || r == RoleAdmin
+}
+
+// ApprovalPolicy holds the thresholds above which a price change must be approved
+// by a second actor before it takes effect. Thresholds are fractions: a price drop
+// or increase relative to the current base price, or a discount's percentage.
+// A nil threshold never requires approval; a nil policy requires none.
+type ApprovalPolicy struct {
+	maxPriceDrop     *big.Rat
+	maxPriceIncrease *big.Rat
+	maxDiscount      *big.Rat
+}
+
+// ParseApprovalPolicy parses a rule list such as "price_drop=0.3;price_increase=1;discount=0.5".
+// Thresholds are positive fractions; an empty spec yields a policy that requires no approvals.
+func ParseApprovalPolicy(spec string) (*ApprovalPolicy, error) {
+	p := &ApprovalPolicy{}
+	for _, part := range strings.Split(spec, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		target, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, ErrInvalidApprovalRule
+		}
+		threshold, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok || threshold.Sign() <= 0 {
+			return nil, ErrInvalidApprovalRule
+		}
+		switch strings.ToLower(strings.TrimSpace(target)) {
+		case "price_drop":
+			if threshold.Cmp(big.NewRat(1, 1)) > 0 {
+				return nil, ErrInvalidApprovalRule
+			}
+			p.maxPriceDrop = threshold
+		case "price_increase":
+			p.maxPriceIncrease = threshold
+		case "discount":
+			if threshold.Cmp(big.NewRat(1, 1)) > 0 {
+				return nil, ErrInvalidApprovalRule
+			}
+			p.maxDiscount = threshold
+		default:
+			return nil, ErrInvalidApprovalRule
+		}
+	}
+	return p, nil
+}
+
+// PriceChangeNeedsApproval reports whether changing the base price from oldPrice to
+// newPrice moves it by more than the policy allows, relative to oldPrice.
+func (p *ApprovalPolicy) PriceChangeNeedsApproval(oldPrice, newPrice *Money) bool {
+	if p == nil || oldPrice == nil || newPrice == nil || !oldPrice.IsPositive() || !oldPrice.SameCurrency(newPrice) {
+		return false
+	}
+	delta := new(big.Rat).Sub(newPrice.Rat(), oldPrice.Rat())
+	change := new(big.Rat).Quo(new(big.Rat).Abs(delta), oldPrice.Rat())
+	switch delta.Sign() {
+	case -1:
+		return p.maxPriceDrop != nil && change.Cmp(p.maxPriceDrop) > 0
+	case 1:
+		return p.maxPriceIncrease != nil && change.Cmp(p.maxPriceIncrease) > 0
+	}
+	return false
+}
+
+// DiscountNeedsApproval reports whether the discount is deeper than the policy allows.
+func (p *ApprovalPolicy) DiscountNeedsApproval(d *Discount) bool {
+	if p == nil || d == nil || p.maxDiscount == nil {
+		return false
+	}
+	return d.PercentageRat().Cmp(p.maxDiscount) > 0
+}
@@ -0,0 +1,382 @@
+package domain
+
+import (
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AttributeType is the type of values a custom product attribute holds.
+type AttributeType string
+
+const (
+	// AttributeTypeString attributes hold free text (e.g. material "merino wool").
+	AttributeTypeString AttributeType = "string"
+
+	// AttributeTypeNumber attributes hold an exact decimal, optionally with a unit (e.g. 15.6 "in").
+	AttributeTypeNumber AttributeType = "number"
+
+	// AttributeTypeBoolean attributes hold "true" or "false".
+	AttributeTypeBoolean AttributeType = "boolean"
+
+	// AttributeTypeEnum attributes hold one of the definition's enum values.
+	AttributeTypeEnum AttributeType = "enum"
+)
+
+// ParseAttributeType validates an attribute type.
+func ParseAttributeType(s string) (AttributeType, error) {
+	switch t := AttributeType(strings.ToLower(strings.TrimSpace(s))); t {
+	case AttributeTypeString, AttributeTypeNumber, AttributeTypeBoolean, AttributeTypeEnum:
+		return t, nil
+	}
+	return "", ErrInvalidAttributeType
+}
+
+const (
+	maxAttributeEnumValues  = 100
+	maxAttributeEnumLength  = 100
+	maxAttributeUnitLength  = 20
+	maxAttributeValueLength = 500
+)
+
+// attributeNamePattern restricts attribute names to lowercase identifiers (e.g. "screen_size").
+var attributeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// NormalizeAttributeName lower-cases and validates an attribute name.
+func NormalizeAttributeName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !attributeNamePattern.MatchString(name) {
+		return "", ErrInvalidAttributeName
+	}
+	return name, nil
+}
+
+// fieldAttributePrefix prefixes the dirty-field name of a product attribute or an
+// attribute definition, e.g. "attribute:screen_size". Use AttributeField to build it.
+const fieldAttributePrefix = "attribute:"
+
+// AttributeField returns the change-tracking field name for an attribute.
+func AttributeField(name string) string {
+	return fieldAttributePrefix + name
+}
+
+// AttributeNameFromField returns the name of an attribute field, or false if the field is not an attribute field.
+func AttributeNameFromField(field string) (string, bool) {
+	if !strings.HasPrefix(field, fieldAttributePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(field, fieldAttributePrefix), true
+}
+
+// AttributeDefinition describes one custom attribute of the products in a category.
+type AttributeDefinition struct {
+	name       string
+	typ        AttributeType
+	required   bool
+	enumValues []string
+	unit       string
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// ReconstructAttributeDefinition reconstructs a definition from persisted state.
+func ReconstructAttributeDefinition(name string, typ AttributeType, required bool, enumValues []string, unit string, createdAt, updatedAt time.Time) *AttributeDefinition {
+	return &AttributeDefinition{
+		name:       name,
+		typ:        typ,
+		required:   required,
+		enumValues: append([]string(nil), enumValues...),
+		unit:       unit,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+func (d *AttributeDefinition) Name() string {
+	return d.name
+}
+
+func (d *AttributeDefinition) Type() AttributeType {
+	return d.typ
+}
+
+func (d *AttributeDefinition) Required() bool {
+	return d.required
+}
+
+// EnumValues returns a copy of the allowed values of an enum attribute.
+func (d *AttributeDefinition) EnumValues() []string {
+	return append([]string(nil), d.enumValues...)
+}
+
+// Unit is the unit number values are given in (e.g. "in", "W"); empty for other types.
+func (d *AttributeDefinition) Unit() string {
+	return d.unit
+}
+
+func (d *AttributeDefinition) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *AttributeDefinition) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+func (d *AttributeDefinition) equals(other *AttributeDefinition) bool {
+	if d.typ != other.typ || d.required != other.required || d.unit != other.unit || len(d.enumValues) != len(other.enumValues) {
+		return false
+	}
+	for i, v := range d.enumValues {
+		if other.enumValues[i] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize validates a value against the definition and returns it canonically:
+// numbers as exact decimals, booleans as "true"/"false", enum values in the
+// definition's spelling (matched case-insensitively) and text trimmed.
+func (d *AttributeDefinition) normalize(value string) (AttributeValue, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || len(value) > maxAttributeValueLength {
+		return AttributeValue{}, ErrInvalidAttributeValue
+	}
+	switch d.typ {
+	case AttributeTypeNumber:
+		n, ok := new(big.Rat).SetString(value)
+		if !ok {
+			return AttributeValue{}, ErrInvalidAttributeValue
+		}
+		s, ok := numericString(n)
+		if !ok {
+			return AttributeValue{}, ErrInvalidAttributeValue
+		}
+		return AttributeValue{value: s, number: n}, nil
+	case AttributeTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return AttributeValue{}, ErrInvalidAttributeValue
+		}
+		return AttributeValue{value: strconv.FormatBool(b)}, nil
+	case AttributeTypeEnum:
+		for _, allowed := range d.enumValues {
+			if strings.EqualFold(allowed, value) {
+				return AttributeValue{value: allowed}, nil
+			}
+		}
+		return AttributeValue{}, ErrInvalidAttributeValue
+	}
+	return AttributeValue{value: value}, nil
+}
+
+// AttributeValue is a validated, canonical attribute value of a product.
+type AttributeValue struct {
+	value  string
+	number *big.Rat
+}
+
+// ReconstructAttributeValue reconstructs a stored value; number is nil unless the
+// attribute is a number attribute.
+func ReconstructAttributeValue(value string, number *big.Rat) AttributeValue {
+	return AttributeValue{value: value, number: number}
+}
+
+func (v AttributeValue) String() string {
+	return v.value
+}
+
+// Number returns a copy of a number attribute's value, or nil for other types.
+func (v AttributeValue) Number() *big.Rat {
+	if v.number == nil {
+		return nil
+	}
+	return new(big.Rat).Set(v.number)
+}
+
+func (v AttributeValue) equals(other AttributeValue) bool {
+	if v.value != other.value || (v.number == nil) != (other.number == nil) {
+		return false
+	}
+	return v.number == nil || v.number.Cmp(other.number) == 0
+}
+
+// AttributeSchema is the aggregate root for the custom attributes of one product
+// category, identified by the category's slug. Products in the category may only
+// carry attributes it defines, with values of the defined type, and must carry all
+// required attributes.
+type AttributeSchema struct {
+	category    string
+	definitions map[string]*AttributeDefinition
+	changes     *ChangeTracker
+	events      []DomainEvent
+}
+
+// NewAttributeSchema returns an empty schema for a category slug, normalized with
+// Slugify; categories without stored definitions have an empty schema.
+func NewAttributeSchema(category string) (*AttributeSchema, error) {
+	slug := Slugify(category)
+	if slug == "" {
+		return nil, ErrInvalidCategorySlug
+	}
+	return ReconstructAttributeSchema(slug), nil
+}
+
+// ReconstructAttributeSchema reconstructs a schema from persisted definitions.
+func ReconstructAttributeSchema(category string, definitions ...*AttributeDefinition) *AttributeSchema {
+	s := &AttributeSchema{
+		category:    category,
+		definitions: make(map[string]*AttributeDefinition, len(definitions)),
+		changes:     NewChangeTracker(),
+		events:      make([]DomainEvent, 0),
+	}
+	for _, d := range definitions {
+		s.definitions[d.name] = d
+	}
+	return s
+}
+
+func (s *AttributeSchema) Category() string {
+	return s.category
+}
+
+// Definitions returns the schema's definitions ordered by name.
+func (s *AttributeSchema) Definitions() []*AttributeDefinition {
+	out := make([]*AttributeDefinition, 0, len(s.definitions))
+	for _, d := range s.definitions {
+		out = append(out, d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+	return out
+}
+
+// Definition returns the definition of the named attribute, if any.
+func (s *AttributeSchema) Definition(name string) (*AttributeDefinition, bool) {
+	d, ok := s.definitions[name]
+	return d, ok
+}
+
+func (s *AttributeSchema) Changes() *ChangeTracker {
+	return s.changes
+}
+
+func (s *AttributeSchema) DomainEvents() []DomainEvent {
+	return s.events
+}
+
+// Define adds an attribute definition or replaces the one with the same name.
+// Enum attributes need 1-100 distinct values; only number attributes have a unit.
+// Products already carrying the attribute are not revalidated; their values are
+// checked against the new definition the next time their attributes are set.
+func (s *AttributeSchema) Define(name string, typ AttributeType, required bool, enumValues []string, unit string, now time.Time) (*AttributeDefinition, error) {
+	name, err := NormalizeAttributeName(name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ParseAttributeType(string(typ)); err != nil {
+		return nil, err
+	}
+
+	var values []string
+	if typ == AttributeTypeEnum {
+		if len(enumValues) == 0 || len(enumValues) > maxAttributeEnumValues {
+			return nil, ErrInvalidAttributeDefinition
+		}
+		seen := make(map[string]bool, len(enumValues))
+		for _, v := range enumValues {
+			v = strings.TrimSpace(v)
+			key := strings.ToLower(v)
+			if v == "" || len(v) > maxAttributeEnumLength || seen[key] {
+				return nil, ErrInvalidAttributeDefinition
+			}
+			seen[key] = true
+			values = append(values, v)
+		}
+	} else if len(enumValues) > 0 {
+		return nil, ErrInvalidAttributeDefinition
+	}
+
+	unit = strings.TrimSpace(unit)
+	if len(unit) > maxAttributeUnitLength || (unit != "" && typ != AttributeTypeNumber) {
+		return nil, ErrInvalidAttributeDefinition
+	}
+
+	d := &AttributeDefinition{
+		name:       name,
+		typ:        typ,
+		required:   required,
+		enumValues: values,
+		unit:       unit,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+	if existing, ok := s.definitions[name]; ok {
+		if existing.equals(d) {
+			return existing, nil
+		}
+		d.createdAt = existing.createdAt
+	}
+
+	s.definitions[name] = d
+	s.changes.MarkDirty(AttributeField(name))
+	s.events = append(s.events, &AttributeDefinedEvent{
+		Category:   s.category,
+		Name:       name,
+		Type:       typ,
+		Required:   required,
+		EnumValues: d.EnumValues(),
+		Unit:       unit,
+		DefinedAt:  now,
+	})
+
+	return d, nil
+}
+
+// Remove deletes the named attribute definition. Products keep their stored values
+// until their attributes are next set, when the attribute is no longer accepted.
+func (s *AttributeSchema) Remove(name string, now time.Time) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if _, ok := s.definitions[name]; !ok {
+		return ErrAttributeNotFound
+	}
+
+	delete(s.definitions, name)
+	s.changes.MarkDirty(AttributeField(name))
+	s.events = append(s.events, &AttributeRemovedEvent{
+		Category:  s.category,
+		Name:      name,
+		RemovedAt: now,
+	})
+
+	return nil
+}
+
+// Validate checks attribute values against the schema and returns them normalized,
+// keyed by lower-cased name.
+func (s *AttributeSchema) Validate(values map[string]string) (map[string]AttributeValue, error) {
+	out := make(map[string]AttributeValue, len(values))
+	for name, value := range values {
+		name = strings.ToLower(strings.TrimSpace(name))
+		d, ok := s.definitions[name]
+		if !ok {
+			return nil, ErrUnknownAttribute
+		}
+		if _, dup := out[name]; dup {
+			return nil, ErrInvalidAttributeValue
+		}
+		v, err := d.normalize(value)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = v
+	}
+	for name, d := range s.definitions {
+		if _, ok := out[name]; d.required && !ok {
+			return nil, ErrMissingRequiredAttribute
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLaptopSchema(t *testing.T, now time.Time) *AttributeSchema {
+	t.Helper()
+	s, err := NewAttributeSchema(" laptops ")
+	require.NoError(t, err)
+	_, err = s.Define("Screen_Size", AttributeTypeNumber, true, nil, "in", now)
+	require.NoError(t, err)
+	_, err = s.Define("panel", AttributeTypeEnum, false, []string{"IPS", "OLED"}, "", now)
+	require.NoError(t, err)
+	_, err = s.Define("touch", AttributeTypeBoolean, false, nil, "", now)
+	require.NoError(t, err)
+	return s
+}
+
+func TestAttributeSchemaDefine(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := newLaptopSchema(t, now)
+	assert.Equal(t, "laptops", s.Category())
+	require.Len(t, s.Definitions(), 3)
+	assert.Equal(t, "screen_size", s.Definitions()[1].Name())
+	assert.True(t, s.Changes().Dirty(AttributeField("screen_size")))
+	assert.Len(t, s.DomainEvents(), 3)
+
+	for _, tc := range []struct {
+		name  string
+		typ   AttributeType
+		enum  []string
+		unit  string
+		error error
+	}{
+		{"1st", AttributeTypeString, nil, "", ErrInvalidAttributeName},
+		{"color", "colour", nil, "", ErrInvalidAttributeType},
+		{"color", AttributeTypeEnum, nil, "", ErrInvalidAttributeDefinition},
+		{"color", AttributeTypeEnum, []string{"red", "Red"}, "", ErrInvalidAttributeDefinition},
+		{"color", AttributeTypeString, []string{"red"}, "", ErrInvalidAttributeDefinition},
+		{"color", AttributeTypeString, nil, "cm", ErrInvalidAttributeDefinition},
+	} {
+		_, err := s.Define(tc.name, tc.typ, false, tc.enum, tc.unit, now)
+		assert.ErrorIs(t, err, tc.error, tc.name)
+	}
+
+	// Redefining keeps the creation time; an identical definition is a no-op.
+	later := now.Add(time.Hour)
+	d, err := s.Define("touch", AttributeTypeBoolean, true, nil, "", later)
+	require.NoError(t, err)
+	assert.Equal(t, now, d.CreatedAt())
+	assert.Equal(t, later, d.UpdatedAt())
+	_, err = s.Define("touch", AttributeTypeBoolean, true, nil, "", later)
+	require.NoError(t, err)
+	assert.Len(t, s.DomainEvents(), 4)
+
+	assert.ErrorIs(t, s.Remove("weight", later), ErrAttributeNotFound)
+	require.NoError(t, s.Remove("Touch", later))
+	_, ok := s.Definition("touch")
+	assert.False(t, ok)
+}
+
+func TestAttributeSchemaValidate(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := newLaptopSchema(t, now)
+
+	values, err := s.Validate(map[string]string{"Screen_Size": " 15.60 ", "panel": "oled", "touch": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "15.6", values["screen_size"].String())
+	assert.Equal(t, "78/5", values["screen_size"].Number().RatString())
+	assert.Equal(t, "OLED", values["panel"].String())
+	assert.Equal(t, "true", values["touch"].String())
+	assert.Nil(t, values["touch"].Number())
+
+	for _, tc := range []struct {
+		values map[string]string
+		error  error
+	}{
+		{map[string]string{"panel": "IPS"}, ErrMissingRequiredAttribute},
+		{map[string]string{"screen_size": "big"}, ErrInvalidAttributeValue},
+		{map[string]string{"screen_size": "1/3"}, ErrInvalidAttributeValue},
+		{map[string]string{"screen_size": "15", "panel": "TN"}, ErrInvalidAttributeValue},
+		{map[string]string{"screen_size": "15", "touch": "maybe"}, ErrInvalidAttributeValue},
+		{map[string]string{"screen_size": "15", "weight": "2"}, ErrUnknownAttribute},
+	} {
+		_, err := s.Validate(tc.values)
+		assert.ErrorIs(t, err, tc.error, tc.values)
+	}
+}
+
+func TestProductSetAttributes(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := newLaptopSchema(t, now)
+	p, err := NewProduct("prod-1", "Ultrabook", "", testCategory("laptops"), NewMoney(999, 1), now)
+	require.NoError(t, err)
+
+	other, err := NewAttributeSchema("tablets")
+	require.NoError(t, err)
+	assert.ErrorIs(t, p.SetAttributes(nil, other, now), ErrAttributeSchemaMismatch)
+
+	require.NoError(t, p.SetAttributes(map[string]string{"screen_size": "13.3", "touch": "false"}, s, now))
+	assert.Equal(t, map[string]string{"screen_size": "13.3", "touch": "false"}, p.Attributes())
+	p.Changes().Clear()
+	events := len(p.DomainEvents())
+
+	// Equal values in another spelling change nothing.
+	require.NoError(t, p.SetAttributes(map[string]string{"screen_size": "13.30", "touch": "0"}, s, now))
+	assert.False(t, p.Changes().HasChanges())
+	assert.Len(t, p.DomainEvents(), events)
+
+	require.NoError(t, p.SetAttributes(map[string]string{"screen_size": "14"}, s, now))
+	assert.True(t, p.Changes().Dirty(AttributeField("screen_size")))
+	assert.True(t, p.Changes().Dirty(AttributeField("touch")))
+	_, ok := p.Attribute("touch")
+	assert.False(t, ok)
+}
@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+// InAvailabilityWindow reports whether at lies within a product's availability window:
+// at or after from and before until. A nil bound leaves that side open.
+func InAvailabilityWindow(from, until *time.Time, at time.Time) bool {
+	if from != nil && at.Before(*from) {
+		return false
+	}
+	return until == nil || at.Before(*until)
+}
+
+// OnSaleAt reports whether a product with the given status and availability window is
+// on sale at the given time, whether or not the scheduler has performed its transitions
+// yet: an active product within its window is, and so is a draft or inactive product
+// whose launch (from) has passed while its window is still open. Such a pending launch
+// also needs the prices checked by RegionsPriced, which Product.OnSaleAt adds.
+func OnSaleAt(status ProductStatus, from, until *time.Time, at time.Time) bool {
+	if !InAvailabilityWindow(from, until, at) {
+		return false
+	}
+	switch status {
+	case ProductStatusActive:
+		return true
+	case ProductStatusDraft, ProductStatusInactive:
+		return from != nil
+	default:
+		return false
+	}
+}
+
+// sameTimePtr reports whether two optional times are both unset or equal.
+func sameTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// timeOrNil returns the time in RFC 3339 form for event payloads, or nil when unset.
+func timeOrNil(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
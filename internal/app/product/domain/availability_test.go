@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnSaleAt(t *testing.T) {
+	launch := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	before, during, after := launch.Add(-time.Second), launch.Add(time.Hour), sunset
+
+	assert.False(t, OnSaleAt(ProductStatusActive, &launch, &sunset, before))
+	assert.True(t, OnSaleAt(ProductStatusActive, &launch, &sunset, during))
+	assert.False(t, OnSaleAt(ProductStatusActive, &launch, &sunset, after))
+
+	// A passed launch puts a draft on sale before the scheduler activates it.
+	assert.True(t, OnSaleAt(ProductStatusDraft, &launch, nil, during))
+	assert.False(t, OnSaleAt(ProductStatusInactive, nil, &sunset, during))
+	assert.False(t, OnSaleAt(ProductStatusArchived, nil, nil, during))
+}
+
+func TestProductSetAvailability(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	launch := now.Add(24 * time.Hour)
+	sunset := launch.Add(30 * 24 * time.Hour)
+	p := ReconstructProduct("prod-1", "Lamp", "", "lighting", NewMoney(40, 1), nil, ProductStatusDraft, now, now, nil)
+
+	assert.ErrorIs(t, p.SetAvailability(&sunset, &launch, now), ErrInvalidAvailabilityWindow)
+	assert.ErrorIs(t, p.SetAvailability(&launch, &launch, now), ErrInvalidAvailabilityWindow)
+
+	require.NoError(t, p.SetAvailability(&launch, &sunset, now))
+	assert.True(t, p.Changes().Dirty(FieldAvailability))
+	assert.False(t, p.OnSaleAt(now))
+	assert.True(t, p.OnSaleAt(launch))
+	require.Len(t, p.DomainEvents(), 1)
+	ev := p.DomainEvents()[0].(*ProductUpdatedEvent)
+	assert.Equal(t, "2026-01-02T12:00:00Z", ev.Changes["available_from"])
+
+	// Setting the same window again changes nothing.
+	require.NoError(t, p.SetAvailability(&launch, &sunset, now))
+	assert.Len(t, p.DomainEvents(), 1)
+}
+
+func TestProductScheduledTransitions(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	launch, sunset := now.Add(-time.Hour), now.Add(time.Hour)
+	p := ReconstructProduct("prod-1", "Lamp", "", "lighting", NewMoney(40, 1), nil, ProductStatusDraft, now, now, nil,
+		WithAvailability(&launch, &sunset))
+
+	// Activating after the launch consumes it.
+	require.NoError(t, p.Activate(now))
+	assert.Nil(t, p.AvailableFrom())
+	assert.Equal(t, &sunset, p.AvailableUntil())
+
+	// Deactivating after the sunset consumes it, and so does not block a later activation.
+	require.NoError(t, p.Deactivate(sunset))
+	assert.Nil(t, p.AvailableUntil())
+	require.NoError(t, p.Activate(sunset))
+
+	q := ReconstructProduct("prod-2", "Desk", "", "furniture", NewMoney(90, 1), nil, ProductStatusInactive, now, now, nil,
+		WithAvailability(nil, &launch))
+	assert.ErrorIs(t, q.Activate(now), ErrAvailabilityEnded)
+
+	// A launch that has passed is also consumed by a manual deactivation, so the
+	// scheduler does not put the product back on sale.
+	r := ReconstructProduct("prod-3", "Chair", "", "furniture", NewMoney(60, 1), nil, ProductStatusActive, now, now, nil,
+		WithAvailability(&launch, &sunset))
+	require.NoError(t, r.Deactivate(now))
+	assert.Nil(t, r.AvailableFrom())
+	assert.Equal(t, &sunset, r.AvailableUntil())
+	assert.True(t, r.Changes().Dirty(FieldAvailability))
+}
+
+func TestPendingLaunchNeedsRegionPrices(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	launch := now.Add(-time.Hour)
+	p := ReconstructProduct("prod-1", "Lamp", "", "lighting", NewMoney(40, 1), nil, ProductStatusDraft, now, now, nil,
+		WithRegions([]Region{"US", "DE", "AQ"}, nil), WithAvailability(&launch, nil))
+
+	// Activate would refuse the launch, so the product is not on sale yet.
+	assert.False(t, RegionsPriced(p.AllowedRegions(), []Currency{"USD"}))
+	assert.False(t, p.OnSaleAt(now))
+
+	require.NoError(t, p.SetCurrencyPrice(NewMoneyIn("EUR", 37, 1), now))
+	assert.True(t, RegionsPriced(p.AllowedRegions(), []Currency{"USD", "EUR"}))
+	assert.True(t, p.OnSaleAt(now))
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// ProductType says whether a product is sold on its own or as a bundle of other products.
+type ProductType string
+
+const (
+	// ProductTypeSimple is a product sold on its own.
+	ProductTypeSimple ProductType = "simple"
+
+	// ProductTypeBundle is a product composed of component products, e.g. a gift set.
+	ProductTypeBundle ProductType = "bundle"
+)
+
+// BundlePricingMode says how a bundle's base price is determined.
+type BundlePricingMode string
+
+const (
+	// BundlePricingComponentsSum prices a bundle at the sum of its components' base
+	// prices times their quantities, less the bundle discount.
+	BundlePricingComponentsSum BundlePricingMode = "components_sum"
+
+	// BundlePricingFixed prices a bundle at its own base price.
+	BundlePricingFixed BundlePricingMode = "fixed"
+)
+
+// ParseBundlePricingMode validates a bundle pricing mode.
+func ParseBundlePricingMode(s string) (BundlePricingMode, error) {
+	switch BundlePricingMode(strings.ToLower(strings.TrimSpace(s))) {
+	case BundlePricingComponentsSum:
+		return BundlePricingComponentsSum, nil
+	case BundlePricingFixed:
+		return BundlePricingFixed, nil
+	}
+	return "", ErrInvalidBundlePricingMode
+}
+
+const (
+	// MaxBundleComponents is the most component products a bundle can have.
+	MaxBundleComponents = 20
+
+	// MaxBundleComponentQuantity is the most units of one component a bundle can hold.
+	MaxBundleComponentQuantity = 99
+)
+
+// fieldBundleComponentPrefix prefixes the dirty-field name of a bundle component,
+// e.g. "bundle_component:<product id>". Use BundleComponentField to build it.
+const fieldBundleComponentPrefix = "bundle_component:"
+
+// BundleComponentField returns the change-tracking field name for a bundle component.
+func BundleComponentField(productID string) string {
+	return fieldBundleComponentPrefix + productID
+}
+
+// BundleComponentIDFromField returns the component product ID of a bundle component
+// field, or false if the field is not a bundle component field.
+func BundleComponentIDFromField(field string) (string, bool) {
+	if !strings.HasPrefix(field, fieldBundleComponentPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(field, fieldBundleComponentPrefix), true
+}
+
+// BundleComponent is a quantity of a component product in a bundle.
+type BundleComponent struct {
+	productID string
+	quantity  int64
+}
+
+// NewBundleComponent validates a component of productID with 1 to
+// MaxBundleComponentQuantity units.
+func NewBundleComponent(productID string, quantity int64) (*BundleComponent, error) {
+	productID = strings.TrimSpace(productID)
+	if productID == "" {
+		return nil, ErrInvalidBundleComponent
+	}
+	if quantity < 1 || quantity > MaxBundleComponentQuantity {
+		return nil, ErrInvalidBundleQuantity
+	}
+	return &BundleComponent{productID: productID, quantity: quantity}, nil
+}
+
+// ReconstructBundleComponent reconstructs a bundle component from persisted state.
+func ReconstructBundleComponent(productID string, quantity int64) *BundleComponent {
+	return &BundleComponent{productID: productID, quantity: quantity}
+}
+
+// ProductID returns the ID of the component product.
+func (c *BundleComponent) ProductID() string {
+	return c.productID
+}
+
+// Quantity returns how many units of the component product the bundle holds.
+func (c *BundleComponent) Quantity() int64 {
+	return c.quantity
+}
+
+// ComponentProduct is what a bundle needs to know about a component product,
+// loaded by the caller.
+type ComponentProduct struct {
+	ID        string
+	Type      ProductType
+	Status    ProductStatus
+	BasePrice *Money
+}
+
+// BundleLine is a component of a bundle priced at the component's unit price.
+type BundleLine struct {
+	ProductID string
+	Quantity  int64
+	UnitPrice *Money
+}
+
+// BundlePrice returns the price of a components_sum bundle: the sum of each line's
+// unit price times its quantity, less discount (a fraction in [0, 1); nil means
+// none). All unit prices must share one currency.
+func BundlePrice(lines []BundleLine, discount *big.Rat) (*Money, error) {
+	if len(lines) == 0 {
+		return nil, ErrEmptyBundle
+	}
+	total := ZeroIn(lines[0].UnitPrice.Currency())
+	for _, l := range lines {
+		sum, err := total.Add(l.UnitPrice.MultiplyByFraction(l.Quantity, 1))
+		if err != nil {
+			return nil, ErrBundleCurrencyMismatch
+		}
+		total = sum
+	}
+	if discount == nil || discount.Sign() == 0 {
+		return total, nil
+	}
+	return total.MultiplyByRat(new(big.Rat).Sub(big.NewRat(1, 1), discount)), nil
+}
+
+// AllocateBundlePrice splits a bundle's price across its lines in proportion to
+// each line's unit price times its quantity, for revenue reporting. Shares are
+// exact, so they add up to price; they are returned in the order of lines and
+// quoted in price's currency. Unit prices only weigh the lines, so they may be
+// quoted in another currency than price, but must share one.
+func AllocateBundlePrice(price *Money, lines []BundleLine) ([]*Money, error) {
+	if len(lines) == 0 {
+		return nil, ErrEmptyBundle
+	}
+	weights := make([]*big.Rat, len(lines))
+	total := new(big.Rat)
+	for i, l := range lines {
+		if !l.UnitPrice.SameCurrency(lines[0].UnitPrice) {
+			return nil, ErrBundleCurrencyMismatch
+		}
+		weights[i] = new(big.Rat).Mul(l.UnitPrice.Rat(), new(big.Rat).SetInt64(l.Quantity))
+		total.Add(total, weights[i])
+	}
+
+	out := make([]*Money, len(lines))
+	for i, w := range weights {
+		if total.Sign() == 0 {
+			// Free components share the price evenly.
+			out[i] = price.MultiplyByFraction(1, int64(len(lines)))
+			continue
+		}
+		out[i] = price.MultiplyByRat(w.Quo(w, total))
+	}
+	return out, nil
+}
+
+// validateBundleDiscount checks a bundle discount fraction in [0, 1) that fits a
+// NUMERIC column.
+func validateBundleDiscount(discount *big.Rat) error {
+	if discount == nil {
+		return nil
+	}
+	if discount.Sign() < 0 || discount.Cmp(big.NewRat(1, 1)) >= 0 {
+		return ErrInvalidBundleDiscount
+	}
+	if _, ok := numericString(discount); !ok {
+		return ErrInvalidBundleDiscount
+	}
+	return nil
+}
+
+// sortBundleComponents orders components by product ID.
+func sortBundleComponents(components []*BundleComponent) {
+	sort.Slice(components, func(i, j int) bool { return components[i].productID < components[j].productID })
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundlePrice(t *testing.T) {
+	lines := []BundleLine{
+		{ProductID: "prod-soap", Quantity: 2, UnitPrice: NewMoney(450, 100)},
+		{ProductID: "prod-towel", Quantity: 1, UnitPrice: NewMoney(1299, 100)},
+	}
+
+	price, err := BundlePrice(lines, nil)
+	require.NoError(t, err)
+	assert.True(t, price.Equals(NewMoney(2199, 100)))
+
+	price, err = BundlePrice(lines, big.NewRat(1, 10))
+	require.NoError(t, err)
+	assert.True(t, price.Equals(NewMoney(19791, 1000)))
+
+	_, err = BundlePrice(append(lines, BundleLine{ProductID: "prod-candle", Quantity: 1, UnitPrice: NewMoneyIn("EUR", 5, 1)}), nil)
+	assert.ErrorIs(t, err, ErrBundleCurrencyMismatch)
+}
+
+func TestAllocateBundlePrice(t *testing.T) {
+	lines := []BundleLine{
+		{ProductID: "prod-a", Quantity: 1, UnitPrice: NewMoney(10, 1)},
+		{ProductID: "prod-b", Quantity: 1, UnitPrice: NewMoney(10, 1)},
+		{ProductID: "prod-c", Quantity: 1, UnitPrice: NewMoney(10, 1)},
+	}
+
+	// Shares stay exact, so thirds of 10.00 still add up to the bundle price.
+	shares, err := AllocateBundlePrice(NewMoneyIn("EUR", 10, 1), lines)
+	require.NoError(t, err)
+	require.Len(t, shares, 3)
+	total := ZeroIn("EUR")
+	for _, s := range shares {
+		assert.True(t, s.Equals(NewMoneyIn("EUR", 10, 3)))
+		total, err = total.Add(s)
+		require.NoError(t, err)
+	}
+	assert.True(t, total.Equals(NewMoneyIn("EUR", 10, 1)))
+
+	shares, err = AllocateBundlePrice(NewMoney(30, 1), []BundleLine{
+		{ProductID: "prod-a", Quantity: 2, UnitPrice: NewMoney(5, 1)},
+		{ProductID: "prod-b", Quantity: 1, UnitPrice: NewMoney(20, 1)},
+	})
+	require.NoError(t, err)
+	assert.True(t, shares[0].Equals(NewMoney(10, 1)))
+	assert.True(t, shares[1].Equals(NewMoney(20, 1)))
+}
+
+func TestProductSetBundle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-gift", "Gift set", "", "gifts", NewMoney(30, 1), nil, ProductStatusActive, now, now, nil)
+
+	soap, _ := NewBundleComponent("prod-soap", 2)
+	towel, _ := NewBundleComponent("prod-towel", 1)
+	products := map[string]ComponentProduct{
+		"prod-soap":  {ID: "prod-soap", Type: ProductTypeSimple, Status: ProductStatusActive, BasePrice: NewMoney(5, 1)},
+		"prod-towel": {ID: "prod-towel", Type: ProductTypeSimple, Status: ProductStatusActive, BasePrice: NewMoney(20, 1)},
+		"prod-box":   {ID: "prod-box", Type: ProductTypeBundle, Status: ProductStatusActive, BasePrice: NewMoney(40, 1)},
+		"prod-old":   {ID: "prod-old", Type: ProductTypeSimple, Status: ProductStatusArchived, BasePrice: NewMoney(1, 1)},
+	}
+
+	_, err := NewBundleComponent("prod-soap", 100)
+	assert.ErrorIs(t, err, ErrInvalidBundleQuantity)
+
+	box, _ := NewBundleComponent("prod-box", 1)
+	old, _ := NewBundleComponent("prod-old", 1)
+	self, _ := NewBundleComponent("prod-gift", 1)
+	assert.ErrorIs(t, p.SetBundle(BundlePricingComponentsSum, nil, nil, products, now), ErrEmptyBundle)
+	assert.ErrorIs(t, p.SetBundle(BundlePricingComponentsSum, nil, []*BundleComponent{box}, products, now), ErrNestedBundle)
+	assert.ErrorIs(t, p.SetBundle(BundlePricingComponentsSum, nil, []*BundleComponent{old}, products, now), ErrBundleComponentArchived)
+	assert.ErrorIs(t, p.SetBundle(BundlePricingComponentsSum, nil, []*BundleComponent{self}, products, now), ErrInvalidBundleComponent)
+	assert.ErrorIs(t, p.SetBundle(BundlePricingFixed, big.NewRat(1, 10), []*BundleComponent{soap}, products, now), ErrInvalidBundleDiscount)
+	assert.ErrorIs(t, p.SetBundle(BundlePricingComponentsSum, big.NewRat(1, 1), []*BundleComponent{soap}, products, now), ErrInvalidBundleDiscount)
+	assert.False(t, p.IsBundle())
+	assert.Empty(t, p.DomainEvents())
+
+	require.NoError(t, p.SetBundle(BundlePricingComponentsSum, big.NewRat(1, 5), []*BundleComponent{towel, soap}, products, now))
+	assert.True(t, p.IsBundle())
+	assert.True(t, p.BasePrice().Equals(NewMoney(24, 1))) // (2*5 + 20) less 20%
+	assert.True(t, p.Changes().Dirty(BundleComponentField("prod-soap")))
+	assert.True(t, p.Changes().Dirty(FieldBasePrice))
+	require.Len(t, p.DomainEvents(), 2)
+	assert.Equal(t, "20", p.DomainEvents()[0].(*ProductUpdatedEvent).Changes["bundle_discount_percent"])
+	assert.IsType(t, &PriceChangedEvent{}, p.DomainEvents()[1])
+
+	components := p.BundleComponents()
+	require.Len(t, components, 2)
+	assert.Equal(t, "prod-soap", components[0].ProductID())
+	assert.Equal(t, int64(2), components[0].Quantity())
+
+	assert.ErrorIs(t, p.UpdatePrice(NewMoney(25, 1), now), ErrBundlePriceDerived)
+}
+
+func TestProductDeactivateBundle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-gift", "Gift set", "", "gifts", NewMoney(30, 1), nil, ProductStatusActive, now, now, nil,
+		WithBundle(BundlePricingFixed, nil, ReconstructBundleComponent("prod-soap", 2)))
+
+	require.NoError(t, p.DeactivateBundle("prod-towel", now))
+	assert.Equal(t, ProductStatusActive, p.Status())
+
+	require.NoError(t, p.DeactivateBundle("prod-soap", now))
+	assert.Equal(t, ProductStatusInactive, p.Status())
+	require.Len(t, p.DomainEvents(), 1)
+	ev := p.DomainEvents()[0].(*ProductDeactivatedEvent)
+	assert.Equal(t, DeactivationReasonComponentArchived, ev.Reason)
+	assert.Equal(t, "prod-soap", ev.ComponentProductID)
+
+	// Bundles already off sale are left alone.
+	require.NoError(t, p.DeactivateBundle("prod-soap", now))
+	assert.Len(t, p.DomainEvents(), 1)
+}
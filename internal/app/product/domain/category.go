@@ -0,0 +1,261 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Field constants for category change tracking
+const (
+	FieldCategoryName   = "name"
+	FieldCategoryParent = "parent_id"
+	FieldCategoryPath   = "path"
+)
+
+const (
+	// MaxCategoryDepth is the deepest level of the category tree; root categories are level 1.
+	MaxCategoryDepth = 8
+
+	maxCategorySlugLength = 100
+
+	// categoryPathSeparator joins the category IDs of a path, root first.
+	categoryPathSeparator = "/"
+)
+
+// categorySlugPattern restricts category slugs to lowercase words joined by hyphens (e.g. "home-office").
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+var categorySlugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slugify derives a category slug from a name: lower-cased, with each run of other
+// characters replaced by a single hyphen ("Home & Office " becomes "home-office").
+// Names differing only in case, spacing or punctuation share a slug.
+func Slugify(name string) string {
+	slug := categorySlugSeparators.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "-")
+	slug = strings.Trim(slug, "-")
+	if len(slug) > maxCategorySlugLength {
+		slug = strings.TrimRight(slug[:maxCategorySlugLength], "-")
+	}
+	return slug
+}
+
+// Category is the aggregate root of the product taxonomy. Categories form a tree;
+// each stores its path, the IDs of its ancestors and itself from the root down,
+// so a subtree is every category whose path starts with the subtree root's path.
+//
+// The slug is fixed at creation: products, attribute schemas and category price
+// rules refer to categories by slug.
+type Category struct {
+	id        string
+	parentID  string
+	name      string
+	slug      string
+	path      string
+	createdAt time.Time
+	updatedAt time.Time
+	changes   *ChangeTracker
+	events    []DomainEvent
+}
+
+// NewCategory creates a category below parent, or a root category when parent is nil.
+// An empty slug is derived from the name with Slugify.
+func NewCategory(id, name, slug string, parent *Category, now time.Time) (*Category, error) {
+	if err := validateProductCategory(name); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(slug) == "" {
+		slug = name
+	}
+	slug = Slugify(slug)
+	if !categorySlugPattern.MatchString(slug) {
+		return nil, ErrInvalidCategorySlug
+	}
+
+	c := &Category{
+		id:        id,
+		name:      strings.TrimSpace(name),
+		slug:      slug,
+		path:      id,
+		createdAt: now,
+		updatedAt: now,
+		changes:   NewChangeTracker(),
+		events:    make([]DomainEvent, 0),
+	}
+	if parent != nil {
+		if parent.Depth() >= MaxCategoryDepth {
+			return nil, ErrCategoryTooDeep
+		}
+		c.parentID = parent.id
+		c.path = parent.path + categoryPathSeparator + id
+	}
+
+	c.events = append(c.events, &CategoryCreatedEvent{
+		CategoryID: c.id,
+		ParentID:   c.parentID,
+		Name:       c.name,
+		Slug:       c.slug,
+		CreatedAt:  now,
+	})
+
+	return c, nil
+}
+
+// ReconstructCategory reconstructs a Category from persisted state; parentID is empty for root categories.
+func ReconstructCategory(id, parentID, name, slug, path string, createdAt, updatedAt time.Time) *Category {
+	return &Category{
+		id:        id,
+		parentID:  parentID,
+		name:      name,
+		slug:      slug,
+		path:      path,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+		changes:   NewChangeTracker(),
+		events:    make([]DomainEvent, 0),
+	}
+}
+
+// Getters
+
+func (c *Category) ID() string {
+	return c.id
+}
+
+// ParentID returns the ID of the parent category, or "" for a root category.
+func (c *Category) ParentID() string {
+	return c.parentID
+}
+
+func (c *Category) Name() string {
+	return c.name
+}
+
+func (c *Category) Slug() string {
+	return c.slug
+}
+
+// Path returns the "/"-separated IDs from the root category down to this one.
+func (c *Category) Path() string {
+	return c.path
+}
+
+// Depth returns the category's level in the tree; root categories are level 1.
+func (c *Category) Depth() int {
+	return strings.Count(c.path, categoryPathSeparator) + 1
+}
+
+// Contains reports whether other is this category or one of its descendants.
+func (c *Category) Contains(other *Category) bool {
+	return other.path == c.path || strings.HasPrefix(other.path, c.path+categoryPathSeparator)
+}
+
+func (c *Category) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Category) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *Category) Changes() *ChangeTracker {
+	return c.changes
+}
+
+func (c *Category) DomainEvents() []DomainEvent {
+	return c.events
+}
+
+// Business Methods
+
+// Rename changes the category's display name. The slug stays the same.
+func (c *Category) Rename(name string, now time.Time) error {
+	if err := validateProductCategory(name); err != nil {
+		return err
+	}
+	trimmed := strings.TrimSpace(name)
+	if trimmed == c.name {
+		return nil
+	}
+
+	c.name = trimmed
+	c.changes.MarkDirty(FieldCategoryName)
+	c.updatedAt = now
+	c.events = append(c.events, &CategoryUpdatedEvent{
+		CategoryID: c.id,
+		UpdatedAt:  now,
+		Changes:    map[string]interface{}{"name": c.name},
+	})
+
+	return nil
+}
+
+// Move re-parents the category below parent, or makes it a root category when parent
+// is nil. descendants must hold every category below this one; their paths are
+// rewritten to follow the move. A category cannot move below itself or one of its
+// descendants, and the deepest descendant must stay within MaxCategoryDepth.
+func (c *Category) Move(parent *Category, descendants []*Category, now time.Time) error {
+	newParentID, newPath := "", c.id
+	if parent != nil {
+		if c.Contains(parent) {
+			return ErrCategoryCycle
+		}
+		newParentID, newPath = parent.id, parent.path+categoryPathSeparator+c.id
+	}
+	if newParentID == c.parentID {
+		return nil
+	}
+
+	// Every level the category moves down, its whole subtree moves down too.
+	shift := strings.Count(newPath, categoryPathSeparator) - strings.Count(c.path, categoryPathSeparator)
+	deepest := c.Depth()
+	for _, d := range descendants {
+		if c.Contains(d) && d.Depth() > deepest {
+			deepest = d.Depth()
+		}
+	}
+	if deepest+shift > MaxCategoryDepth {
+		return ErrCategoryTooDeep
+	}
+
+	oldParentID, oldPath := c.parentID, c.path
+	for _, d := range descendants {
+		if d == c || !c.Contains(d) {
+			continue
+		}
+		d.path = newPath + strings.TrimPrefix(d.path, oldPath)
+		d.changes.MarkDirty(FieldCategoryPath)
+		d.updatedAt = now
+	}
+
+	c.parentID = newParentID
+	c.path = newPath
+	c.changes.MarkDirty(FieldCategoryParent)
+	c.changes.MarkDirty(FieldCategoryPath)
+	c.updatedAt = now
+	c.events = append(c.events, &CategoryMovedEvent{
+		CategoryID:  c.id,
+		OldParentID: oldParentID,
+		NewParentID: newParentID,
+		MovedAt:     now,
+	})
+
+	return nil
+}
+
+// Delete marks the category as deleted. Only empty categories, without child
+// categories or products, can be deleted.
+func (c *Category) Delete(childCount, productCount int, now time.Time) error {
+	if childCount > 0 || productCount > 0 {
+		return ErrCategoryNotEmpty
+	}
+
+	c.updatedAt = now
+	c.events = append(c.events, &CategoryDeletedEvent{
+		CategoryID: c.id,
+		Slug:       c.slug,
+		DeletedAt:  now,
+	})
+
+	return nil
+}
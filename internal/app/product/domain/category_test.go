@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCategory returns a root category whose slug (and ID suffix) is slug.
+func testCategory(slug string) *Category {
+	return ReconstructCategory("cat-"+slug, "", slug, slug, "cat-"+slug, time.Time{}, time.Time{})
+}
+
+func TestSlugify(t *testing.T) {
+	for in, want := range map[string]string{
+		"Electronics":      "electronics",
+		" electronics ":    "electronics",
+		"Home & Office":    "home-office",
+		"--TV / Audio--":   "tv-audio",
+		"Größe":            "gr-e",
+		"!!!":              "",
+		"laptops-1a2b3c4d": "laptops-1a2b3c4d",
+	} {
+		assert.Equal(t, want, Slugify(in), in)
+	}
+}
+
+func TestNewCategory(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	root, err := NewCategory("root", " Home & Office ", "", nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, "Home & Office", root.Name())
+	assert.Equal(t, "home-office", root.Slug())
+	assert.Equal(t, "root", root.Path())
+	assert.Equal(t, 1, root.Depth())
+	require.Len(t, root.DomainEvents(), 1)
+	assert.Equal(t, "category.created", root.DomainEvents()[0].EventType())
+
+	child, err := NewCategory("desks", "Desks", "Standing Desks", root, now)
+	require.NoError(t, err)
+	assert.Equal(t, "standing-desks", child.Slug())
+	assert.Equal(t, "root", child.ParentID())
+	assert.Equal(t, "root/desks", child.Path())
+	assert.True(t, root.Contains(child))
+	assert.False(t, child.Contains(root))
+
+	_, err = NewCategory("x", "  ", "", nil, now)
+	assert.ErrorIs(t, err, ErrEmptyProductCategory)
+	_, err = NewCategory("x", "!!!", "", nil, now)
+	assert.ErrorIs(t, err, ErrInvalidCategorySlug)
+
+	parent := root
+	for i := 2; i <= MaxCategoryDepth; i++ {
+		parent, err = NewCategory(fmt.Sprintf("c%d", i), "Level", "", parent, now)
+		require.NoError(t, err)
+	}
+	_, err = NewCategory("too-deep", "Level", "", parent, now)
+	assert.ErrorIs(t, err, ErrCategoryTooDeep)
+}
+
+func TestCategoryRename(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := ReconstructCategory("tv", "", "TV", "tv", "tv", now, now)
+
+	require.NoError(t, c.Rename(" TV ", now))
+	assert.False(t, c.Changes().HasChanges())
+
+	later := now.Add(time.Hour)
+	require.NoError(t, c.Rename("Televisions", later))
+	assert.Equal(t, "Televisions", c.Name())
+	assert.Equal(t, "tv", c.Slug(), "slugs are fixed")
+	assert.True(t, c.Changes().Dirty(FieldCategoryName))
+	assert.Equal(t, later, c.UpdatedAt())
+	require.Len(t, c.DomainEvents(), 1)
+	assert.Equal(t, "category.updated", c.DomainEvents()[0].EventType())
+}
+
+func TestCategoryMove(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	electronics := ReconstructCategory("el", "", "Electronics", "electronics", "el", now, now)
+	audio := ReconstructCategory("au", "el", "Audio", "audio", "el/au", now, now)
+	headphones := ReconstructCategory("hp", "au", "Headphones", "headphones", "el/au/hp", now, now)
+	media := ReconstructCategory("me", "", "Media", "media", "me", now, now)
+
+	assert.ErrorIs(t, electronics.Move(headphones, []*Category{audio, headphones}, now), ErrCategoryCycle)
+	assert.ErrorIs(t, audio.Move(audio, []*Category{headphones}, now), ErrCategoryCycle)
+
+	require.NoError(t, audio.Move(media, []*Category{headphones}, now))
+	assert.Equal(t, "me", audio.ParentID())
+	assert.Equal(t, "me/au", audio.Path())
+	assert.Equal(t, "me/au/hp", headphones.Path())
+	assert.True(t, audio.Changes().Dirty(FieldCategoryParent))
+	assert.True(t, headphones.Changes().Dirty(FieldCategoryPath))
+	assert.False(t, headphones.Changes().Dirty(FieldCategoryParent))
+	require.Len(t, audio.DomainEvents(), 1)
+	ev := audio.DomainEvents()[0].(*CategoryMovedEvent)
+	assert.Equal(t, "el", ev.OldParentID)
+	assert.Equal(t, "me", ev.NewParentID)
+
+	// Moving to the current parent is a no-op; moving to nil makes a root category.
+	require.NoError(t, audio.Move(media, []*Category{headphones}, now))
+	assert.Len(t, audio.DomainEvents(), 1)
+	require.NoError(t, audio.Move(nil, []*Category{headphones}, now))
+	assert.Equal(t, "", audio.ParentID())
+	assert.Equal(t, "au/hp", headphones.Path())
+}
+
+func TestCategoryMoveKeepsDepthLimit(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	deep := ReconstructCategory("c7", "c6", "Deep", "deep", "c1/c2/c3/c4/c5/c6/c7", now, now)
+	branch := ReconstructCategory("b", "", "Branch", "branch", "b", now, now)
+	leaf := ReconstructCategory("l", "b", "Leaf", "leaf", "b/l", now, now)
+
+	assert.ErrorIs(t, branch.Move(deep, []*Category{leaf}, now), ErrCategoryTooDeep)
+	require.NoError(t, leaf.Move(deep, nil, now))
+	assert.Equal(t, MaxCategoryDepth, leaf.Depth())
+}
+
+func TestCategoryDelete(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := ReconstructCategory("tv", "", "TV", "tv", "tv", now, now)
+
+	assert.ErrorIs(t, c.Delete(1, 0, now), ErrCategoryNotEmpty)
+	assert.ErrorIs(t, c.Delete(0, 3, now), ErrCategoryNotEmpty)
+	require.NoError(t, c.Delete(0, 0, now))
+	require.Len(t, c.DomainEvents(), 1)
+	assert.Equal(t, "category.deleted", c.DomainEvents()[0].EventType())
+}
+
+func TestProductCategory(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	_, err := NewProduct("prod-1", "Widget", "", nil, NewMoney(10, 1), now)
+	assert.ErrorIs(t, err, ErrEmptyProductCategory)
+
+	p, err := NewProduct("prod-1", "Widget", "", testCategory("tools"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	assert.Equal(t, "tools", p.Category())
+	assert.Equal(t, "cat-tools", p.CategoryID())
+
+	p.ClearEvents()
+	require.NoError(t, p.UpdateDetails("", "", testCategory("tools"), now))
+	assert.False(t, p.Changes().Dirty(FieldCategory))
+	require.NoError(t, p.UpdateDetails("", "", testCategory("garden"), now))
+	assert.True(t, p.Changes().Dirty(FieldCategory))
+	assert.Equal(t, "garden", p.Category())
+	assert.Equal(t, "cat-garden", p.CategoryID())
+	require.Len(t, p.DomainEvents(), 1)
+	assert.Equal(t, map[string]interface{}{"category": "garden", "category_id": "cat-garden"},
+		p.DomainEvents()[0].(*ProductUpdatedEvent).Changes)
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Common sales channels. Any lowercase code names a channel, e.g. "marketplace-amazon".
+const (
+	ChannelWeb       = "web"
+	ChannelMobileApp = "mobile_app"
+)
+
+// channelPattern restricts sales channels to lowercase codes (e.g. "web", "marketplace-amazon").
+var channelPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,29}$`)
+
+// NormalizeChannel trims and lowercases a sales channel code and validates it.
+func NormalizeChannel(channel string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(channel))
+	if !channelPattern.MatchString(normalized) {
+		return "", ErrInvalidChannel
+	}
+	return normalized, nil
+}
+
+// fieldChannelPrefix prefixes the dirty-field name of a channel assignment, e.g.
+// "channel:web". Use ChannelField to build it.
+const fieldChannelPrefix = "channel:"
+
+// ChannelField returns the change-tracking field name for a product's assignment to a channel.
+func ChannelField(channel string) string {
+	return fieldChannelPrefix + channel
+}
+
+// ChannelFromField returns the channel of a channel field, or false if the field is
+// not a channel field.
+func ChannelFromField(field string) (string, bool) {
+	if !strings.HasPrefix(field, fieldChannelPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(field, fieldChannelPrefix), true
+}
+
+// ChannelAssignment is a product's assignment to a sales channel, owned by the product.
+// A product is on sale on a channel while it is active and active on the channel.
+// Price, when set, replaces the product's primary base price on the channel.
+type ChannelAssignment struct {
+	channel   string
+	active    bool
+	price     *Money
+	updatedAt time.Time
+}
+
+// ReconstructChannelAssignment reconstructs a product's assignment to a channel;
+// a nil price means the channel sells at the base price.
+func ReconstructChannelAssignment(channel string, active bool, price *Money, updatedAt time.Time) *ChannelAssignment {
+	return &ChannelAssignment{channel: channel, active: active, price: price, updatedAt: updatedAt}
+}
+
+func (c *ChannelAssignment) Channel() string {
+	return c.channel
+}
+
+func (c *ChannelAssignment) Active() bool {
+	return c.active
+}
+
+// Price returns the channel's price override, or nil when the channel sells at the base price.
+func (c *ChannelAssignment) Price() *Money {
+	return c.price
+}
+
+func (c *ChannelAssignment) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// sortChannels orders channel assignments by channel.
+func sortChannels(channels []*ChannelAssignment) {
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].channel < channels[j].channel
+	})
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeChannel(t *testing.T) {
+	c, err := NormalizeChannel(" Marketplace-Amazon ")
+	require.NoError(t, err)
+	assert.Equal(t, "marketplace-amazon", c)
+
+	_, err = NormalizeChannel("mobile app")
+	assert.ErrorIs(t, err, ErrInvalidChannel)
+
+	field := ChannelField(ChannelWeb)
+	c, ok := ChannelFromField(field)
+	require.True(t, ok)
+	assert.Equal(t, ChannelWeb, c)
+	_, ok = ChannelFromField(TagField("eco"))
+	assert.False(t, ok)
+}
+
+func TestProductChannelActivation(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-1", "Lamp", "", "lighting", NewMoney(40, 1), nil, ProductStatusDraft, now, now, nil,
+		WithChannels(ReconstructChannelAssignment(ChannelMobileApp, false, nil, now)))
+
+	assert.ErrorIs(t, p.DeactivateOnChannel(ChannelWeb, now), ErrChannelNotAssigned)
+	assert.ErrorIs(t, p.DeactivateOnChannel(ChannelMobileApp, now), ErrChannelAlreadyInactive)
+
+	// Channels can be activated before the product itself.
+	require.NoError(t, p.ActivateOnChannel(" Web ", now))
+	assert.ErrorIs(t, p.ActivateOnChannel(ChannelWeb, now), ErrChannelAlreadyActive)
+	assert.True(t, p.Changes().Dirty(ChannelField(ChannelWeb)))
+
+	require.NoError(t, p.DeactivateOnChannel(ChannelWeb, now))
+	require.Len(t, p.DomainEvents(), 2)
+	assert.Equal(t, "web", p.DomainEvents()[0].(*ProductChannelActivatedEvent).Channel)
+	assert.Equal(t, "product.channel_deactivated", p.DomainEvents()[1].EventType())
+
+	channels := p.Channels()
+	require.Len(t, channels, 2)
+	assert.Equal(t, ChannelMobileApp, channels[0].Channel())
+	assert.False(t, channels[1].Active())
+
+	require.NoError(t, p.Archive(now))
+	assert.ErrorIs(t, p.ActivateOnChannel(ChannelWeb, now), ErrProductArchived)
+}
+
+func TestProductChannelPrice(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-1", "Lamp", "", "lighting", NewMoney(40, 1), nil, ProductStatusActive, now, now, nil,
+		WithCostPrice(NewMoney(30, 1)))
+
+	// Clearing a price on an unassigned channel changes nothing.
+	require.NoError(t, p.SetChannelPrice("marketplace-x", nil, now))
+	assert.Empty(t, p.Channels())
+
+	assert.ErrorIs(t, p.SetChannelPrice("marketplace-x", NewMoneyIn("EUR", 45, 1), now), ErrCurrencyMismatch)
+	assert.ErrorIs(t, p.SetChannelPrice("marketplace-x", NewMoney(25, 1), now), ErrMarginViolation)
+
+	require.NoError(t, p.SetChannelPrice("marketplace-x", NewMoney(45, 1), now))
+	c, ok := p.Channel("marketplace-x")
+	require.True(t, ok)
+	assert.False(t, c.Active())
+	assert.True(t, c.Price().Equals(NewMoney(45, 1)))
+	require.NoError(t, p.SetChannelPrice("marketplace-x", NewMoney(45, 1), now))
+	require.Len(t, p.DomainEvents(), 1)
+
+	require.NoError(t, p.SetChannelPrice("marketplace-x", nil, now))
+	ev := p.DomainEvents()[1].(*ChannelPriceChangedEvent)
+	assert.Equal(t, "marketplace-x", ev.Channel)
+	assert.True(t, ev.OldPrice.Equals(NewMoney(45, 1)))
+	assert.Nil(t, ev.NewPrice)
+}
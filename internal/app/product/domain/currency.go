@@ -0,0 +1,48 @@
+package domain
+
+import "strings"
+
+// Currency is an ISO 4217 alphabetic currency code (e.g. "USD", "EUR").
+type Currency string
+
+// DefaultCurrency is used for prices persisted before currencies were introduced
+// and for requests that do not specify a currency.
+const DefaultCurrency Currency = "USD"
+
+// minorUnits maps supported ISO 4217 codes to the number of digits after the
+// decimal separator used by that currency (e.g. 2 for USD cents, 0 for JPY).
+var minorUnits = map[Currency]int{
+	"AED": 2, "ARS": 2, "AUD": 2, "BGN": 2, "BHD": 3, "BRL": 2, "CAD": 2,
+	"CHF": 2, "CLP": 0, "CNY": 2, "COP": 2, "CZK": 2, "DKK": 2, "EGP": 2,
+	"EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2,
+	"ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0, "KWD": 3, "MXN": 2, "MYR": 2,
+	"NOK": 2, "NZD": 2, "OMR": 3, "PHP": 2, "PLN": 2, "RON": 2, "SAR": 2,
+	"SEK": 2, "SGD": 2, "THB": 2, "TND": 3, "TRY": 2, "TWD": 2, "UAH": 2,
+	"USD": 2, "VND": 0, "ZAR": 2,
+}
+
+// ParseCurrency normalizes and validates an ISO 4217 currency code.
+// An empty code yields DefaultCurrency.
+func ParseCurrency(code string) (Currency, error) {
+	normalized := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if normalized == "" {
+		return DefaultCurrency, nil
+	}
+	if _, ok := minorUnits[normalized]; !ok {
+		return "", ErrUnsupportedCurrency
+	}
+	return normalized, nil
+}
+
+// MinorUnits returns the number of decimal digits used by the currency.
+func (c Currency) MinorUnits() int {
+	if digits, ok := minorUnits[c]; ok {
+		return digits
+	}
+	return 2
+}
+
+// String returns the ISO 4217 code.
+func (c Currency) String() string {
+	return string(c)
+}
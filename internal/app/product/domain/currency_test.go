@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+	c, err := ParseCurrency(" eur ")
+	require.NoError(t, err)
+	assert.Equal(t, Currency("EUR"), c)
+	assert.Equal(t, "EUR", c.String())
+
+	c, err = ParseCurrency("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCurrency, c)
+
+	for _, code := range []string{"XYZ", "EURO", "E", "US D", "$"} {
+		_, err := ParseCurrency(code)
+		assert.ErrorIs(t, err, ErrUnsupportedCurrency, code)
+	}
+}
+
+func TestCurrencyMinorUnits(t *testing.T) {
+	cases := map[Currency]int{
+		"USD": 2, "EUR": 2, "GBP": 2,
+		"JPY": 0, "KRW": 0, "ISK": 0,
+		"BHD": 3, "KWD": 3,
+	}
+	for currency, digits := range cases {
+		assert.Equal(t, digits, currency.MinorUnits(), currency)
+	}
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"fmt"
 	"math/big"
+	"strconv"
 	"time"
 )
 
@@ -15,37 +16,26 @@ type Discount struct {
 }
 
 // NewDiscount creates a new Discount with the given percentage and date range.
-// percentage should be between 0 and 100 (e.g., 20 for 20% off).
+// percentage should be between 0 and 100 (e.g., 20 for 20% off). The float is
+// read as its shortest decimal form, so 12.345 means exactly 12.345%; prefer
+// NewDiscountFromRat when the percentage is already exact.
 // Returns an error if the percentage is invalid or date range is invalid.
 func NewDiscount(percentage float64, startDate, endDate time.Time) (*Discount, error) {
-	if percentage < 0 || percentage > 100 {
+	pct, ok := new(big.Rat).SetString(strconv.FormatFloat(percentage, 'f', -1, 64))
+	if !ok {
 		return nil, ErrInvalidDiscountPercentage
 	}
-
-	if endDate.Before(startDate) {
-		return nil, ErrInvalidDiscountPeriod
-	}
-
-	if startDate.Equal(endDate) {
-		return nil, ErrInvalidDiscountPeriod
-	}
-
-	return &Discount{
-		percentage: big.NewRat(int64(percentage*100), 10000), // Store as precise fraction
-		startDate:  startDate,
-		endDate:    endDate,
-	}, nil
+	return NewDiscountFromRat(pct.Quo(pct, big.NewRat(100, 1)), startDate, endDate)
 }
 
 // NewDiscountFromRat creates a Discount with percentage as a big.Rat (0.0 to 1.0).
-// For example: 0.20 for 20% off.
+// For example: 0.20 for 20% off. The fraction must be exactly storable in a
+// NUMERIC column (at most 9 decimal places), so it is never rounded on save.
 func NewDiscountFromRat(percentageRat *big.Rat, startDate, endDate time.Time) (*Discount, error) {
-	// Convert to 0-100 scale for validation
-	hundred := big.NewRat(100, 1)
-	percentage := new(big.Rat).Mul(percentageRat, hundred)
-	percentageFloat, _ := percentage.Float64()
-
-	if percentageFloat < 0 || percentageFloat > 100 {
+	if percentageRat == nil || percentageRat.Sign() < 0 || percentageRat.Cmp(big.NewRat(1, 1)) > 0 {
+		return nil, ErrInvalidDiscountPercentage
+	}
+	if _, ok := numericString(percentageRat); !ok {
 		return nil, ErrInvalidDiscountPercentage
 	}
 
@@ -84,6 +74,13 @@ func (d *Discount) Percentage() float64 {
 	return result
 }
 
+// PercentageString returns the exact discount percentage (0-100 scale) as a
+// decimal string. For example: "12.345" for 12.345% off.
+func (d *Discount) PercentageString() string {
+	pct := new(big.Rat).Mul(d.percentage, big.NewRat(100, 1))
+	return trimDecimal(pct.FloatString(NumericScale))
+}
+
 // PercentageRat returns the discount percentage as a big.Rat (0.0-1.0 scale).
 // For example: 0.20 for 20% off.
 // Returns a copy to maintain immutability.
@@ -104,20 +101,20 @@ func (d *Discount) EndDate() time.Time {
 // CalculateDiscountAmount calculates the discount amount for a given price.
 // Returns a new Money instance representing the discount amount.
 func (d *Discount) CalculateDiscountAmount(price *Money) *Money {
-	return price.Multiply(NewMoneyFromRat(d.percentage))
+	return price.MultiplyByRat(d.percentage)
 }
 
 // ApplyTo applies the discount to a given price and returns the final price.
-// Returns a new Money instance representing the discounted price.
+// Returns a new Money instance representing the discounted price, in the price's currency.
 func (d *Discount) ApplyTo(price *Money) *Money {
 	discountAmount := d.CalculateDiscountAmount(price)
-	return price.Subtract(discountAmount)
+	return price.sub(discountAmount)
 }
 
 // String returns a string representation of the discount.
 func (d *Discount) String() string {
-	return fmt.Sprintf("%.2f%% off (valid from %s to %s)",
-		d.Percentage(),
+	return fmt.Sprintf("%s%% off (valid from %s to %s)",
+		d.PercentageString(),
 		d.startDate.Format("2006-01-02"),
 		d.endDate.Format("2006-01-02"))
 }
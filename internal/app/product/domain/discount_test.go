@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscountKeepsExactPercentage(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	cases := []struct {
+		name     string
+		fraction string
+		percent  string
+		price    string // discounted price of 100.00 USD
+	}{
+		{"three decimal places", "0.12345", "12.345", "87.655"},
+		{"tiny", "0.000000001", "0.0000001", "99.9999999"},
+		{"one third rounded by the caller", "0.333333333", "33.3333333", "66.6666667"},
+		{"whole", "0.2", "20", "80"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			frac, ok := new(big.Rat).SetString(tc.fraction)
+			require.True(t, ok)
+
+			d, err := NewDiscountFromRat(frac, start, end)
+			require.NoError(t, err)
+			assert.Equal(t, tc.percent, d.PercentageString())
+
+			got, err := d.ApplyTo(NewMoney(100, 1)).Decimal()
+			require.NoError(t, err)
+			assert.Equal(t, tc.price, got)
+		})
+	}
+}
+
+func TestNewDiscountParsesFloatExactly(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	d, err := NewDiscount(12.345, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, "12.345", d.PercentageString())
+	assert.Equal(t, 0, d.PercentageRat().Cmp(big.NewRat(12345, 100000)))
+}
+
+func TestNewDiscountFromRatRejectsInvalidPercentages(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	for _, frac := range []*big.Rat{
+		nil,
+		big.NewRat(-1, 100),
+		big.NewRat(101, 100),
+		big.NewRat(1, 3), // no exact NUMERIC form
+	} {
+		_, err := NewDiscountFromRat(frac, start, end)
+		assert.ErrorIs(t, err, ErrInvalidDiscountPercentage)
+	}
+}
+
+func TestDiscountAppliedEventCarriesExactPercentage(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Widget", "", testCategory("tools"), NewMoney(100, 1), now)
+	require.NoError(t, err)
+	require.NoError(t, p.Activate(now))
+
+	d, err := NewDiscountFromRat(big.NewRat(12345, 100000), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, p.ApplyDiscount(d, now))
+
+	var applied *DiscountAppliedEvent
+	for _, ev := range p.DomainEvents() {
+		if e, ok := ev.(*DiscountAppliedEvent); ok {
+			applied = e
+		}
+	}
+	require.NotNil(t, applied)
+	assert.Equal(t, "12.345", applied.DiscountPercent)
+}
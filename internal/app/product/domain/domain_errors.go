@@ -22,6 +22,12 @@ var (
 
 	// ErrCannotArchiveActiveProduct indicates an attempt to archive an active product.
 	ErrCannotArchiveActiveProduct = errors.New("cannot archive an active product")
+
+	// ErrNoPriceInCurrency indicates the product is not sold in the requested currency.
+	ErrNoPriceInCurrency = errors.New("product has no price in the requested currency")
+
+	// ErrCannotRemovePrimaryPrice indicates an attempt to remove the price in the product's primary currency.
+	ErrCannotRemovePrimaryPrice = errors.New("cannot remove the price in the product's primary currency")
 )
 
 // Domain errors for Discount value object
@@ -46,6 +52,28 @@ var (
 
 	// ErrZeroPrice indicates an attempt to set a zero price.
 	ErrZeroPrice = errors.New("price cannot be zero")
+
+	// ErrMoneyOverflow indicates an amount that cannot be represented exactly in
+	// an int64 fraction or a NUMERIC column; it is never truncated silently.
+	ErrMoneyOverflow = errors.New("money amount exceeds the supported precision")
+
+	// ErrCurrencyMismatch indicates arithmetic or a comparison between amounts in different currencies.
+	ErrCurrencyMismatch = errors.New("money amounts are in different currencies")
+
+	// ErrUnsupportedCurrency indicates a currency code that is not a supported ISO 4217 code.
+	ErrUnsupportedCurrency = errors.New("unsupported ISO 4217 currency code")
+
+	// ErrInvalidExchangeRate indicates a non-positive rate, identical currencies or a missing effective time.
+	ErrInvalidExchangeRate = errors.New("exchange rate must be positive, between two different currencies, with an effective time")
+
+	// ErrExchangeRateNotFound indicates no rate is in effect for a currency pair at the requested time.
+	ErrExchangeRateNotFound = errors.New("no exchange rate in effect for the currency pair")
+
+	// ErrInvalidRoundingMode indicates an unknown rounding mode name.
+	ErrInvalidRoundingMode = errors.New("rounding mode must be one of half_up, half_even, floor, psychological")
+
+	// ErrInvalidRoundingRule indicates a malformed rounding rule, an unknown scope or an empty key.
+	ErrInvalidRoundingRule = errors.New("rounding rule must look like currency:<CODE>=<mode> or category:<name>=<mode>")
 )
 
 // Domain errors for Product validation
@@ -65,3 +93,362 @@ var (
 	// ErrProductCategoryTooLong indicates the product category exceeds maximum length.
 	ErrProductCategoryTooLong = errors.New("product category exceeds maximum length of 100 characters")
 )
+
+// Domain errors for PriceList aggregate
+var (
+	// ErrPriceListNotFound indicates that no price list exists for the given ID or segment.
+	ErrPriceListNotFound = errors.New("price list not found")
+
+	// ErrPriceListSegmentTaken indicates an attempt to create a second price list for a segment.
+	ErrPriceListSegmentTaken = errors.New("a price list already exists for this segment")
+
+	// ErrEmptyPriceListSegment indicates an attempt to create a price list without a segment code.
+	ErrEmptyPriceListSegment = errors.New("price list segment cannot be empty")
+
+	// ErrInvalidPriceListSegment indicates the segment code is not a lowercase slug of at most 50 characters.
+	ErrInvalidPriceListSegment = errors.New("price list segment must be a lowercase slug of at most 50 characters")
+
+	// ErrEmptyPriceListName indicates an attempt to create/update a price list with an empty name.
+	ErrEmptyPriceListName = errors.New("price list name cannot be empty")
+
+	// ErrPriceListNameTooLong indicates the price list name exceeds maximum length.
+	ErrPriceListNameTooLong = errors.New("price list name exceeds maximum length of 255 characters")
+
+	// ErrInvalidPriceListEntry indicates an entry without a product or without a price rule.
+	ErrInvalidPriceListEntry = errors.New("price list entry requires a product and either an override price or an adjustment")
+
+	// ErrInvalidPriceAdjustment indicates an adjustment that would make the price zero or negative.
+	ErrInvalidPriceAdjustment = errors.New("price adjustment must be greater than -100%")
+)
+
+// Domain errors for cost prices and margin guardrails
+var (
+	// ErrMarginViolation indicates a price or discount that would sell below the category's margin floor.
+	ErrMarginViolation = errors.New("price is below the minimum margin over cost")
+
+	// ErrMarginOverrideNotAllowed indicates a margin override requested by a role that may not override margins.
+	ErrMarginOverrideNotAllowed = errors.New("margin override requires the pricing_manager or admin role")
+
+	// ErrInvalidMarginRule indicates a malformed margin rule or a margin outside [0, 1).
+	ErrInvalidMarginRule = errors.New("margin rule must look like default=<fraction> or category:<name>=<fraction>, with 0 <= fraction < 1")
+)
+
+// Domain errors for tax categories and tax rates
+var (
+	// ErrInvalidTaxCategory indicates a tax category that is not a lowercase code of at most 50 characters.
+	ErrInvalidTaxCategory = errors.New("tax category must be a lowercase code of at most 50 characters")
+
+	// ErrInvalidTaxRegion indicates a region that is not an ISO 3166-1 alpha-2 code with an optional subdivision.
+	ErrInvalidTaxRegion = errors.New("tax region must be a country code with an optional subdivision, e.g. DE or US-CA")
+
+	// ErrInvalidTaxRate indicates a rate outside [0, 1], with more than 9 decimal places, or without an effective time.
+	ErrInvalidTaxRate = errors.New("tax rate must be a fraction between 0 and 1 with an effective time")
+
+	// ErrTaxRateNotFound indicates no rate is in effect for a product's tax category in the requested region.
+	ErrTaxRateNotFound = errors.New("no tax rate in effect for the tax category in the region")
+)
+
+// Domain errors for units of measure and package sizes
+var (
+	// ErrUnsupportedUnit indicates a unit of measure that is not supported.
+	ErrUnsupportedUnit = errors.New("unit of measure must be one of mg, g, kg, ml, cl, l, mm, cm, m, pc")
+
+	// ErrInvalidPackageSize indicates a package quantity that is not positive or has no exact representation.
+	ErrInvalidPackageSize = errors.New("package quantity must be positive with at most 9 decimal places")
+)
+
+// Domain errors for scheduled price changes
+var (
+	// ErrInvalidScheduleTime indicates a scheduled price change whose effective time is not in the future.
+	ErrInvalidScheduleTime = errors.New("scheduled price change must take effect in the future")
+
+	// ErrScheduledPriceChangeNotFound indicates no scheduled price change with the given id exists for the product.
+	ErrScheduledPriceChangeNotFound = errors.New("scheduled price change not found")
+
+	// ErrScheduledPriceChangeNotPending indicates a scheduled price change that was already applied or cancelled.
+	ErrScheduledPriceChangeNotPending = errors.New("scheduled price change was already applied or cancelled")
+
+	// ErrScheduledPriceChangeNotDue indicates an attempt to apply a scheduled price change before its effective time.
+	ErrScheduledPriceChangeNotDue = errors.New("scheduled price change is not due yet")
+)
+
+// Domain errors for price change approvals
+var (
+	// ErrInvalidApprovalRule indicates a malformed approval threshold rule.
+	ErrInvalidApprovalRule = errors.New("invalid approval rule: thresholds must be positive fractions, at most 1 for price_drop and discount")
+
+	// ErrPriceChangeRequestNotFound indicates no price change request with the given id exists.
+	ErrPriceChangeRequestNotFound = errors.New("price change request not found")
+
+	// ErrPriceChangeRequestNotPending indicates a price change request that was already approved or rejected.
+	ErrPriceChangeRequestNotPending = errors.New("price change request was already decided")
+
+	// ErrPriceChangeRequestStale indicates the product's price moved after the change was requested.
+	ErrPriceChangeRequestStale = errors.New("product price changed since the request was made")
+
+	// ErrScheduledPriceNeedsApproval indicates a scheduled price change beyond the
+	// approval thresholds; such a change must be requested and approved instead.
+	ErrScheduledPriceNeedsApproval = errors.New("price change needs approval and cannot be scheduled")
+
+	// ErrRequesterRequired indicates a price change held back for approval without an identified requester.
+	ErrRequesterRequired = errors.New("requesting a price change that needs approval requires an identified user")
+
+	// ErrDeciderRequired indicates a decision without an identified actor.
+	ErrDeciderRequired = errors.New("deciding a price change request requires an identified user")
+
+	// ErrSelfApprovalNotAllowed indicates an attempt to approve one's own price change request.
+	ErrSelfApprovalNotAllowed = errors.New("price change requests must be approved by a second user")
+
+	// ErrApprovalNotAllowed indicates a decision by a role that may not approve price changes.
+	ErrApprovalNotAllowed = errors.New("approving price changes requires the pricing_manager or admin role")
+
+	// ErrDecisionReasonTooLong indicates a decision reason exceeding the maximum length.
+	ErrDecisionReasonTooLong = errors.New("decision reason exceeds 1000 characters")
+)
+
+// Domain errors for product variants
+var (
+	// ErrVariantNotFound indicates no variant with the given id exists for the product.
+	ErrVariantNotFound = errors.New("variant not found")
+
+	// ErrInvalidSKU indicates an empty SKU, one with whitespace, or one longer than 64 characters.
+	ErrInvalidSKU = errors.New("SKU must be 1-64 characters without whitespace")
+
+	// ErrInvalidVariantOptions indicates missing, empty, duplicate or too many option values.
+	ErrInvalidVariantOptions = errors.New("variant needs 1-10 option values with non-empty names and values")
+
+	// ErrInvalidVariantStatus indicates a variant status other than active or inactive.
+	ErrInvalidVariantStatus = errors.New("variant status must be active or inactive")
+
+	// ErrInvalidVariantPrice indicates an update that both sets and clears the variant's price.
+	ErrInvalidVariantPrice = errors.New("variant price cannot be set and cleared at once")
+
+	// ErrDuplicateVariantSKU indicates a SKU already used by another variant.
+	ErrDuplicateVariantSKU = errors.New("SKU is already used by another variant")
+
+	// ErrDuplicateVariantOptions indicates option values already used by another variant of the product.
+	ErrDuplicateVariantOptions = errors.New("another variant of the product has the same option values")
+)
+
+// Domain errors for category attribute schemas
+var (
+	// ErrAttributeNotFound indicates the category defines no attribute with the given name.
+	ErrAttributeNotFound = errors.New("attribute definition not found")
+
+	// ErrInvalidAttributeName indicates a name that is not a lowercase identifier of up to 50 characters.
+	ErrInvalidAttributeName = errors.New("attribute name must be a lowercase identifier of 1-50 characters")
+
+	// ErrInvalidAttributeType indicates a type other than string, number, boolean or enum.
+	ErrInvalidAttributeType = errors.New("attribute type must be string, number, boolean or enum")
+
+	// ErrInvalidAttributeDefinition indicates invalid enum values or a unit on a non-number attribute.
+	ErrInvalidAttributeDefinition = errors.New("enum attributes need 1-100 distinct values; only number attributes have a unit")
+
+	// ErrUnknownAttribute indicates a value for an attribute the product's category does not define.
+	ErrUnknownAttribute = errors.New("attribute is not defined for the product's category")
+
+	// ErrInvalidAttributeValue indicates a value that does not match the attribute's type.
+	ErrInvalidAttributeValue = errors.New("attribute value does not match the attribute definition")
+
+	// ErrMissingRequiredAttribute indicates a product without a value for a required attribute.
+	ErrMissingRequiredAttribute = errors.New("a required attribute of the product's category is missing")
+
+	// ErrAttributeSchemaMismatch indicates attributes validated against another category's schema.
+	ErrAttributeSchemaMismatch = errors.New("attribute schema does not belong to the product's category")
+)
+
+// Domain errors for the Category aggregate
+var (
+	// ErrCategoryNotFound indicates that no category exists for the given ID or slug.
+	ErrCategoryNotFound = errors.New("category not found")
+
+	// ErrCategorySlugTaken indicates an attempt to create a second category with the same slug.
+	ErrCategorySlugTaken = errors.New("a category with this slug already exists")
+
+	// ErrInvalidCategorySlug indicates a slug without any letters or digits.
+	ErrInvalidCategorySlug = errors.New("category slug must contain letters or digits")
+
+	// ErrCategoryCycle indicates an attempt to move a category below itself or one of its descendants.
+	ErrCategoryCycle = errors.New("a category cannot be moved below itself or its descendants")
+
+	// ErrCategoryTooDeep indicates a category tree deeper than MaxCategoryDepth levels.
+	ErrCategoryTooDeep = errors.New("category tree exceeds the maximum depth of 8 levels")
+
+	// ErrCategoryNotEmpty indicates an attempt to delete a category that still has child categories or products.
+	ErrCategoryNotEmpty = errors.New("category still has child categories or products")
+)
+
+// Domain errors for product tags
+var (
+	// ErrInvalidTag indicates a tag that is not lowercase words joined by hyphens, of up to 50 characters.
+	ErrInvalidTag = errors.New("tag must be lowercase letters and digits joined by hyphens, of 1-50 characters")
+
+	// ErrTooManyTags indicates a product that would carry more than MaxProductTags tags.
+	ErrTooManyTags = errors.New("product cannot have more than 20 tags")
+)
+
+// Domain errors for product identifiers
+var (
+	// ErrInvalidGTIN indicates a GTIN that is not 8, 12, 13 or 14 digits with a valid check digit.
+	ErrInvalidGTIN = errors.New("GTIN must be 8, 12, 13 or 14 digits with a valid check digit")
+
+	// ErrDuplicateSKU indicates a SKU already used by another product or variant.
+	ErrDuplicateSKU = errors.New("SKU is already used by another product or variant")
+
+	// ErrDuplicateGTIN indicates a GTIN already used by another product.
+	ErrDuplicateGTIN = errors.New("GTIN is already used by another product")
+
+	// ErrProductSlugTaken indicates a product slug that another product uses or used,
+	// even with the product's ID suffix.
+	ErrProductSlugTaken = errors.New("product slug is already used by another product")
+
+	// ErrInvalidLocale indicates a locale that is not a language with an optional region (e.g. "de-CH").
+	ErrInvalidLocale = errors.New("locale must be a language with an optional region, e.g. de or de-CH")
+
+	// ErrDefaultLocaleTranslation indicates a translation into the product's default locale,
+	// whose name and description are the product's own.
+	ErrDefaultLocaleTranslation = errors.New("the default locale's name and description are the product's own; update the product instead")
+)
+
+// Domain errors for product media
+var (
+	// ErrMediaNotFound indicates a media entry the product does not have.
+	ErrMediaNotFound = errors.New("media not found")
+
+	// ErrInvalidMediaURL indicates a media URL that is not an absolute http or https URL of up to 2048 characters.
+	ErrInvalidMediaURL = errors.New("media URL must be an absolute http or https URL of up to 2048 characters")
+
+	// ErrInvalidMediaKind indicates a media kind other than image, video or document.
+	ErrInvalidMediaKind = errors.New("media kind must be image, video or document")
+
+	// ErrInvalidAltText indicates an alt text over 500 characters or two alt texts for the same locale.
+	ErrInvalidAltText = errors.New("alt texts must be at most 500 characters, one per locale")
+
+	// ErrPrimaryMediaNotImage indicates an attempt to make a video or document the primary image.
+	ErrPrimaryMediaNotImage = errors.New("only images can be a product's primary media")
+
+	// ErrInvalidMediaOrder indicates a media order that does not list each of the product's media exactly once.
+	ErrInvalidMediaOrder = errors.New("media order must list each of the product's media exactly once")
+
+	// ErrDuplicateMediaURL indicates a media URL the product already has.
+	ErrDuplicateMediaURL = errors.New("product already has media with this URL")
+
+	// ErrTooManyMedia indicates a product that would have more than MaxProductMedia media entries.
+	ErrTooManyMedia = errors.New("product cannot have more than 50 media entries")
+)
+
+// Domain errors for product relationships
+var (
+	// ErrInvalidRelationType indicates a relation type other than frequently_bought_with, accessory_of or replacement_for.
+	ErrInvalidRelationType = errors.New("relation type must be frequently_bought_with, accessory_of or replacement_for")
+
+	// ErrSelfRelation indicates an attempt to link a product to itself.
+	ErrSelfRelation = errors.New("a product cannot be related to itself")
+
+	// ErrRelatedProductArchived indicates an attempt to link to an archived product.
+	ErrRelatedProductArchived = errors.New("related product is archived")
+
+	// ErrTooManyRelations indicates a product that would have more than MaxProductRelations links.
+	ErrTooManyRelations = errors.New("product cannot have more than 100 related products")
+)
+
+// Domain errors for bundles
+var (
+	// ErrInvalidBundlePricingMode indicates a bundle pricing mode other than components_sum or fixed.
+	ErrInvalidBundlePricingMode = errors.New("bundle pricing mode must be components_sum or fixed")
+
+	// ErrEmptyBundle indicates a bundle without components.
+	ErrEmptyBundle = errors.New("bundle must have at least one component")
+
+	// ErrTooManyBundleComponents indicates a bundle with more than MaxBundleComponents components.
+	ErrTooManyBundleComponents = errors.New("bundle cannot have more than 20 components")
+
+	// ErrInvalidBundleComponent indicates a component without a product, the bundle itself, or a product listed twice.
+	ErrInvalidBundleComponent = errors.New("bundle components must each name a different product other than the bundle")
+
+	// ErrInvalidBundleQuantity indicates a component quantity outside 1 to MaxBundleComponentQuantity.
+	ErrInvalidBundleQuantity = errors.New("bundle component quantity must be between 1 and 99")
+
+	// ErrInvalidBundleDiscount indicates a bundle discount outside [0, 100) percent, or one on a fixed-price bundle.
+	ErrInvalidBundleDiscount = errors.New("bundle discount must be at least 0 and below 100 percent, and only applies to components_sum bundles")
+
+	// ErrNestedBundle indicates a bundle used as a component, or a component of a bundle made a bundle.
+	ErrNestedBundle = errors.New("bundles cannot contain other bundles")
+
+	// ErrBundleComponentArchived indicates an archived component product.
+	ErrBundleComponentArchived = errors.New("bundle component is archived")
+
+	// ErrBundleCurrencyMismatch indicates components priced in different currencies, or in
+	// another currency than their components_sum bundle.
+	ErrBundleCurrencyMismatch = errors.New("bundle components must be priced in one currency, the bundle's for components_sum bundles")
+
+	// ErrBundlePriceDerived indicates an attempt to set the base price of a components_sum bundle.
+	ErrBundlePriceDerived = errors.New("components_sum bundles are priced from their components")
+)
+
+// Domain errors for inventory
+var (
+	// ErrInvalidLocation indicates a stock location ID that is not a lowercase code.
+	ErrInvalidLocation = errors.New("location must be a lowercase code of letters, digits, '-' and '_'")
+
+	// ErrInvalidStockQuantity indicates a stock quantity, adjustment or threshold out of range,
+	// or an adjustment that changes nothing.
+	ErrInvalidStockQuantity = errors.New("stock quantities must be at most 1000000000, reservations positive and adjustments non-empty")
+
+	// ErrInsufficientStock indicates a reservation or decrement beyond the available units.
+	ErrInsufficientStock = errors.New("not enough stock available")
+
+	// ErrStockLevelNotFound indicates a product without stock at the location.
+	ErrStockLevelNotFound = errors.New("stock level not found")
+
+	// ErrReservationNotFound indicates an unknown or already released stock reservation.
+	ErrReservationNotFound = errors.New("stock reservation not found")
+
+	// ErrStockConflict indicates a stock level that changed between reading and committing it.
+	ErrStockConflict = errors.New("stock level changed concurrently")
+)
+
+// Domain errors for sales channels
+var (
+	// ErrInvalidChannel indicates a sales channel that is not a lowercase code.
+	ErrInvalidChannel = errors.New("channel must be a lowercase code of letters, digits, '-' and '_'")
+
+	// ErrChannelNotAssigned indicates a product that has never been assigned to the sales channel.
+	ErrChannelNotAssigned = errors.New("product is not assigned to the channel")
+
+	// ErrChannelAlreadyActive indicates an attempt to activate a product on a channel it is active on.
+	ErrChannelAlreadyActive = errors.New("product is already active on the channel")
+
+	// ErrChannelAlreadyInactive indicates an attempt to deactivate a product on a channel it is inactive on.
+	ErrChannelAlreadyInactive = errors.New("product is already inactive on the channel")
+
+	// ErrNotActiveOnChannel indicates a read for a sales channel the product is not active on.
+	ErrNotActiveOnChannel = errors.New("product is not active on the channel")
+)
+
+// Domain errors for regional availability
+var (
+	// ErrInvalidRegion indicates a region that is not an assigned ISO 3166-1 alpha-2 code.
+	ErrInvalidRegion = errors.New("region must be an ISO 3166-1 alpha-2 country code")
+
+	// ErrRegionAllowedAndBlocked indicates a region both allowed and blocked for a product.
+	ErrRegionAllowedAndBlocked = errors.New("a region cannot be both allowed and blocked")
+
+	// ErrNoPriceInRegionCurrency indicates an allowed region whose currency the product has no price in.
+	ErrNoPriceInRegionCurrency = errors.New("product has no price in the region's currency")
+
+	// ErrPriceNeededByRegion indicates removing the price an allowed region is sold in.
+	ErrPriceNeededByRegion = errors.New("price is needed by an allowed region")
+
+	// ErrNotAvailableInRegion indicates a read for a region the product cannot be shipped to.
+	ErrNotAvailableInRegion = errors.New("product is not available in the region")
+)
+
+// Domain errors for scheduled availability
+var (
+	// ErrInvalidAvailabilityWindow indicates an availability window that ends before it starts.
+	ErrInvalidAvailabilityWindow = errors.New("available_until must be after available_from")
+
+	// ErrAvailabilityEnded indicates activating a product whose availability window has closed.
+	ErrAvailabilityEnded = errors.New("product availability has ended")
+)
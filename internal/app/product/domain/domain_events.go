@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 // DomainEvent is a marker interface for all domain events.
 // Domain events represent facts about things that have happened in the domain.
@@ -12,11 +15,14 @@ type DomainEvent interface {
 
 // ProductCreatedEvent is raised when a new product is created.
 type ProductCreatedEvent struct {
-	ProductID string
-	Name      string
-	Category  string
-	BasePrice *Money
-	CreatedAt time.Time
+	ProductID   string
+	Name        string
+	Category    string // category slug
+	CategoryID  string
+	TaxCategory TaxCategory
+	Slug        string
+	BasePrice   *Money
+	CreatedAt   time.Time
 }
 
 func (e *ProductCreatedEvent) EventType() string {
@@ -34,6 +40,9 @@ func (e *ProductCreatedEvent) OccurredAt() time.Time {
 // ProductUpdatedEvent is raised when product details are updated.
 type ProductUpdatedEvent struct {
 	ProductID string
+	// Locale identifies the locale of changed names and descriptions; empty when
+	// neither changed.
+	Locale    Locale
 	UpdatedAt time.Time
 	Changes   map[string]interface{} // Map of field name to new value
 }
@@ -68,10 +77,18 @@ func (e *ProductActivatedEvent) OccurredAt() time.Time {
 	return e.ActivatedAt
 }
 
+// DeactivationReasonComponentArchived is the reason of a bundle deactivated because
+// one of its components was archived.
+const DeactivationReasonComponentArchived = "component_archived"
+
 // ProductDeactivatedEvent is raised when a product is deactivated.
+// Reason is empty for manual deactivations; ComponentProductID names the archived
+// component of a bundle deactivated with DeactivationReasonComponentArchived.
 type ProductDeactivatedEvent struct {
-	ProductID     string
-	DeactivatedAt time.Time
+	ProductID          string
+	DeactivatedAt      time.Time
+	Reason             string
+	ComponentProductID string
 }
 
 func (e *ProductDeactivatedEvent) EventType() string {
@@ -105,12 +122,15 @@ func (e *ProductArchivedEvent) OccurredAt() time.Time {
 }
 
 // DiscountAppliedEvent is raised when a discount is applied to a product.
+// DiscountPercent is the exact percentage (0-100 scale) as a decimal string, e.g. "12.345".
 type DiscountAppliedEvent struct {
 	ProductID         string
-	DiscountPercent   float64
+	DiscountPercent   string
 	DiscountStartDate time.Time
 	DiscountEndDate   time.Time
 	AppliedAt         time.Time
+	// MarginOverride is true when the discount breached the margin floor under an allowed override.
+	MarginOverride bool
 }
 
 func (e *DiscountAppliedEvent) EventType() string {
@@ -143,12 +163,18 @@ func (e *DiscountRemovedEvent) OccurredAt() time.Time {
 	return e.RemovedAt
 }
 
-// PriceChangedEvent is raised when the base price of a product changes.
+// PriceChangedEvent is raised when the base price of a product changes,
+// in its primary currency or in any additional currency.
+// OldPrice is nil when a price in a new currency is added.
 type PriceChangedEvent struct {
 	ProductID string
 	OldPrice  *Money
 	NewPrice  *Money
 	ChangedAt time.Time
+	// MarginOverride is true when the new price breached the margin floor under an allowed override.
+	MarginOverride bool
+	// ScheduleID is set when the change was scheduled ahead and applied by the scheduler.
+	ScheduleID string
 }
 
 func (e *PriceChangedEvent) EventType() string {
@@ -162,3 +188,582 @@ func (e *PriceChangedEvent) AggregateID() string {
 func (e *PriceChangedEvent) OccurredAt() time.Time {
 	return e.ChangedAt
 }
+
+// CurrencyPriceRemovedEvent is raised when a product stops being sold in an additional currency.
+type CurrencyPriceRemovedEvent struct {
+	ProductID string
+	OldPrice  *Money
+	RemovedAt time.Time
+}
+
+func (e *CurrencyPriceRemovedEvent) EventType() string {
+	return "price.currency_removed"
+}
+
+func (e *CurrencyPriceRemovedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *CurrencyPriceRemovedEvent) OccurredAt() time.Time {
+	return e.RemovedAt
+}
+
+// PriceListCreatedEvent is raised when a new customer-segment price list is created.
+type PriceListCreatedEvent struct {
+	PriceListID string
+	Segment     string
+	Name        string
+	CreatedAt   time.Time
+}
+
+func (e *PriceListCreatedEvent) EventType() string {
+	return "price_list.created"
+}
+
+func (e *PriceListCreatedEvent) AggregateID() string {
+	return e.PriceListID
+}
+
+func (e *PriceListCreatedEvent) OccurredAt() time.Time {
+	return e.CreatedAt
+}
+
+// PriceListUpdatedEvent is raised when price list details are updated.
+type PriceListUpdatedEvent struct {
+	PriceListID string
+	UpdatedAt   time.Time
+	Changes     map[string]interface{} // Map of field name to new value
+}
+
+func (e *PriceListUpdatedEvent) EventType() string {
+	return "price_list.updated"
+}
+
+func (e *PriceListUpdatedEvent) AggregateID() string {
+	return e.PriceListID
+}
+
+func (e *PriceListUpdatedEvent) OccurredAt() time.Time {
+	return e.UpdatedAt
+}
+
+// PriceListDeletedEvent is raised when a price list (and all its entries) is deleted.
+type PriceListDeletedEvent struct {
+	PriceListID string
+	Segment     string
+	DeletedAt   time.Time
+}
+
+func (e *PriceListDeletedEvent) EventType() string {
+	return "price_list.deleted"
+}
+
+func (e *PriceListDeletedEvent) AggregateID() string {
+	return e.PriceListID
+}
+
+func (e *PriceListDeletedEvent) OccurredAt() time.Time {
+	return e.DeletedAt
+}
+
+// PriceListEntrySetEvent is raised when a product's entry in a price list is created or changed.
+type PriceListEntrySetEvent struct {
+	PriceListID string
+	Segment     string
+	ProductID   string
+	Override    *Money   // nil for adjustment entries
+	Adjustment  *big.Rat // nil for override entries
+	SetAt       time.Time
+}
+
+func (e *PriceListEntrySetEvent) EventType() string {
+	return "price_list.entry_set"
+}
+
+func (e *PriceListEntrySetEvent) AggregateID() string {
+	return e.PriceListID
+}
+
+func (e *PriceListEntrySetEvent) OccurredAt() time.Time {
+	return e.SetAt
+}
+
+// PriceListEntryRemovedEvent is raised when a product's entry is removed from a price list.
+type PriceListEntryRemovedEvent struct {
+	PriceListID string
+	Segment     string
+	ProductID   string
+	RemovedAt   time.Time
+}
+
+func (e *PriceListEntryRemovedEvent) EventType() string {
+	return "price_list.entry_removed"
+}
+
+func (e *PriceListEntryRemovedEvent) AggregateID() string {
+	return e.PriceListID
+}
+
+func (e *PriceListEntryRemovedEvent) OccurredAt() time.Time {
+	return e.RemovedAt
+}
+
+// ExchangeRateSetEvent is raised when an exchange rate is imported or replaced.
+type ExchangeRateSetEvent struct {
+	From        Currency
+	To          Currency
+	Rate        *big.Rat
+	EffectiveAt time.Time
+	SetAt       time.Time
+}
+
+func (e *ExchangeRateSetEvent) EventType() string {
+	return "exchange_rate.set"
+}
+
+// AggregateID identifies the currency pair, e.g. "EUR/USD".
+func (e *ExchangeRateSetEvent) AggregateID() string {
+	return e.From.String() + "/" + e.To.String()
+}
+
+func (e *ExchangeRateSetEvent) OccurredAt() time.Time {
+	return e.SetAt
+}
+
+// TaxRateSetEvent is raised when a tax rate is stored or replaced.
+type TaxRateSetEvent struct {
+	Region      TaxRegion
+	Category    TaxCategory
+	Rate        string // exact decimal fraction, e.g. "0.19"
+	EffectiveAt time.Time
+	SetAt       time.Time
+}
+
+func (e *TaxRateSetEvent) EventType() string {
+	return "tax_rate.set"
+}
+
+// AggregateID identifies the region and tax category, e.g. "DE/reduced".
+func (e *TaxRateSetEvent) AggregateID() string {
+	return e.Region.String() + "/" + e.Category.String()
+}
+
+func (e *TaxRateSetEvent) OccurredAt() time.Time {
+	return e.SetAt
+}
+
+// PriceChangeScheduledEvent is raised when a base price change is scheduled for a later time.
+type PriceChangeScheduledEvent struct {
+	ProductID   string
+	ScheduleID  string
+	NewPrice    *Money
+	EffectiveAt time.Time
+	ScheduledAt time.Time
+	// MarginOverride is true when the new price breaches the margin floor under an allowed override.
+	MarginOverride bool
+}
+
+func (e *PriceChangeScheduledEvent) EventType() string {
+	return "price.change_scheduled"
+}
+
+func (e *PriceChangeScheduledEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *PriceChangeScheduledEvent) OccurredAt() time.Time {
+	return e.ScheduledAt
+}
+
+// ScheduledPriceChangeCancelledEvent is raised when a pending scheduled price change is withdrawn.
+type ScheduledPriceChangeCancelledEvent struct {
+	ProductID   string
+	ScheduleID  string
+	CancelledAt time.Time
+	// Reason is set when the scheduler discarded a change it could not apply.
+	Reason string
+}
+
+func (e *ScheduledPriceChangeCancelledEvent) EventType() string {
+	return "price.change_cancelled"
+}
+
+func (e *ScheduledPriceChangeCancelledEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *ScheduledPriceChangeCancelledEvent) OccurredAt() time.Time {
+	return e.CancelledAt
+}
+
+// PriceChangeRequestedEvent is raised when a price change is held back for approval.
+// OldPrice and NewPrice are set for price changes, Discount for discounts;
+// VariantID or Channel name the variant or channel of variant and channel price changes.
+type PriceChangeRequestedEvent struct {
+	RequestID   string
+	ProductID   string
+	Kind        PriceChangeKind
+	VariantID   string
+	Channel     string
+	OldPrice    *Money
+	NewPrice    *Money
+	Discount    *Discount
+	RequestedBy string
+	RequestedAt time.Time
+}
+
+func (e *PriceChangeRequestedEvent) EventType() string {
+	return "price_change_request.requested"
+}
+
+func (e *PriceChangeRequestedEvent) AggregateID() string {
+	return e.RequestID
+}
+
+func (e *PriceChangeRequestedEvent) OccurredAt() time.Time {
+	return e.RequestedAt
+}
+
+// PriceChangeApprovedEvent is raised when a price change request is approved.
+// The product's own event (e.g. price.changed) is published in the same commit.
+type PriceChangeApprovedEvent struct {
+	RequestID  string
+	ProductID  string
+	ApprovedBy string
+	ApprovedAt time.Time
+}
+
+func (e *PriceChangeApprovedEvent) EventType() string {
+	return "price_change_request.approved"
+}
+
+func (e *PriceChangeApprovedEvent) AggregateID() string {
+	return e.RequestID
+}
+
+func (e *PriceChangeApprovedEvent) OccurredAt() time.Time {
+	return e.ApprovedAt
+}
+
+// PriceChangeRejectedEvent is raised when a price change request is rejected or withdrawn.
+type PriceChangeRejectedEvent struct {
+	RequestID  string
+	ProductID  string
+	RejectedBy string
+	Reason     string
+	RejectedAt time.Time
+}
+
+func (e *PriceChangeRejectedEvent) EventType() string {
+	return "price_change_request.rejected"
+}
+
+func (e *PriceChangeRejectedEvent) AggregateID() string {
+	return e.RequestID
+}
+
+func (e *PriceChangeRejectedEvent) OccurredAt() time.Time {
+	return e.RejectedAt
+}
+
+// VariantAddedEvent is raised when a variant is added to a product.
+// Price is nil when the variant inherits the product's base price.
+type VariantAddedEvent struct {
+	ProductID string
+	VariantID string
+	SKU       string
+	Options   VariantOptions
+	Price     *Money
+	AddedAt   time.Time
+	// MarginOverride is true when the variant's price breaches the margin floor under an allowed override.
+	MarginOverride bool
+}
+
+func (e *VariantAddedEvent) EventType() string {
+	return "product.variant_added"
+}
+
+func (e *VariantAddedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *VariantAddedEvent) OccurredAt() time.Time {
+	return e.AddedAt
+}
+
+// VariantUpdatedEvent is raised when a variant's SKU, options, price or status change.
+// Changes maps "sku", "options", "price" (nil when cleared) or "status" to the new value.
+type VariantUpdatedEvent struct {
+	ProductID      string
+	VariantID      string
+	Changes        map[string]interface{}
+	UpdatedAt      time.Time
+	MarginOverride bool
+}
+
+func (e *VariantUpdatedEvent) EventType() string {
+	return "product.variant_updated"
+}
+
+func (e *VariantUpdatedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *VariantUpdatedEvent) OccurredAt() time.Time {
+	return e.UpdatedAt
+}
+
+// VariantRemovedEvent is raised when a variant is removed from a product.
+type VariantRemovedEvent struct {
+	ProductID string
+	VariantID string
+	SKU       string
+	RemovedAt time.Time
+}
+
+func (e *VariantRemovedEvent) EventType() string {
+	return "product.variant_removed"
+}
+
+func (e *VariantRemovedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *VariantRemovedEvent) OccurredAt() time.Time {
+	return e.RemovedAt
+}
+
+// AttributeDefinedEvent is raised when a category attribute is defined or redefined.
+type AttributeDefinedEvent struct {
+	Category   string
+	Name       string
+	Type       AttributeType
+	Required   bool
+	EnumValues []string
+	Unit       string
+	DefinedAt  time.Time
+}
+
+func (e *AttributeDefinedEvent) EventType() string {
+	return "attribute.defined"
+}
+
+// AggregateID is the category the attribute belongs to.
+func (e *AttributeDefinedEvent) AggregateID() string {
+	return e.Category
+}
+
+func (e *AttributeDefinedEvent) OccurredAt() time.Time {
+	return e.DefinedAt
+}
+
+// AttributeRemovedEvent is raised when a category attribute definition is removed.
+type AttributeRemovedEvent struct {
+	Category  string
+	Name      string
+	RemovedAt time.Time
+}
+
+func (e *AttributeRemovedEvent) EventType() string {
+	return "attribute.removed"
+}
+
+// AggregateID is the category the attribute belonged to.
+func (e *AttributeRemovedEvent) AggregateID() string {
+	return e.Category
+}
+
+func (e *AttributeRemovedEvent) OccurredAt() time.Time {
+	return e.RemovedAt
+}
+
+// CategoryCreatedEvent is raised when a category is added to the taxonomy.
+type CategoryCreatedEvent struct {
+	CategoryID string
+	ParentID   string // empty for root categories
+	Name       string
+	Slug       string
+	CreatedAt  time.Time
+}
+
+func (e *CategoryCreatedEvent) EventType() string {
+	return "category.created"
+}
+
+func (e *CategoryCreatedEvent) AggregateID() string {
+	return e.CategoryID
+}
+
+func (e *CategoryCreatedEvent) OccurredAt() time.Time {
+	return e.CreatedAt
+}
+
+// CategoryUpdatedEvent is raised when category details are updated.
+type CategoryUpdatedEvent struct {
+	CategoryID string
+	UpdatedAt  time.Time
+	Changes    map[string]interface{} // Map of field name to new value
+}
+
+func (e *CategoryUpdatedEvent) EventType() string {
+	return "category.updated"
+}
+
+func (e *CategoryUpdatedEvent) AggregateID() string {
+	return e.CategoryID
+}
+
+func (e *CategoryUpdatedEvent) OccurredAt() time.Time {
+	return e.UpdatedAt
+}
+
+// CategoryMovedEvent is raised when a category, with its subtree, gets a new parent.
+type CategoryMovedEvent struct {
+	CategoryID  string
+	OldParentID string // empty when it was a root category
+	NewParentID string // empty when it became a root category
+	MovedAt     time.Time
+}
+
+func (e *CategoryMovedEvent) EventType() string {
+	return "category.moved"
+}
+
+func (e *CategoryMovedEvent) AggregateID() string {
+	return e.CategoryID
+}
+
+func (e *CategoryMovedEvent) OccurredAt() time.Time {
+	return e.MovedAt
+}
+
+// CategoryDeletedEvent is raised when an empty category is deleted.
+type CategoryDeletedEvent struct {
+	CategoryID string
+	Slug       string
+	DeletedAt  time.Time
+}
+
+func (e *CategoryDeletedEvent) EventType() string {
+	return "category.deleted"
+}
+
+func (e *CategoryDeletedEvent) AggregateID() string {
+	return e.CategoryID
+}
+
+func (e *CategoryDeletedEvent) OccurredAt() time.Time {
+	return e.DeletedAt
+}
+
+// StockLevelChangedEvent is raised whenever a product's stock at a location changes.
+// OnHandDelta is the change of the units on hand; reservations leave them unchanged.
+type StockLevelChangedEvent struct {
+	ProductID         string
+	LocationID        string
+	Reason            StockChangeReason
+	OnHandDelta       int64
+	OnHand            int64
+	Reserved          int64
+	Available         int64
+	LowStockThreshold int64
+	ReservationID     string // empty for adjustments
+	ChangedAt         time.Time
+}
+
+func (e *StockLevelChangedEvent) EventType() string {
+	return "stock.level_changed"
+}
+
+// AggregateID is the product, so a product's stock events across locations stay together.
+func (e *StockLevelChangedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *StockLevelChangedEvent) OccurredAt() time.Time {
+	return e.ChangedAt
+}
+
+// LowStockEvent is raised when the available units at a location fall to or below
+// the location's low-stock threshold.
+type LowStockEvent struct {
+	ProductID         string
+	LocationID        string
+	Available         int64
+	LowStockThreshold int64
+	DetectedAt        time.Time
+}
+
+func (e *LowStockEvent) EventType() string {
+	return "stock.low"
+}
+
+func (e *LowStockEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *LowStockEvent) OccurredAt() time.Time {
+	return e.DetectedAt
+}
+
+// ProductChannelActivatedEvent is raised when a product goes on sale on a sales channel.
+type ProductChannelActivatedEvent struct {
+	ProductID   string
+	Channel     string
+	ActivatedAt time.Time
+}
+
+func (e *ProductChannelActivatedEvent) EventType() string {
+	return "product.channel_activated"
+}
+
+func (e *ProductChannelActivatedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *ProductChannelActivatedEvent) OccurredAt() time.Time {
+	return e.ActivatedAt
+}
+
+// ProductChannelDeactivatedEvent is raised when a product is taken off sale on a sales channel.
+type ProductChannelDeactivatedEvent struct {
+	ProductID     string
+	Channel       string
+	DeactivatedAt time.Time
+}
+
+func (e *ProductChannelDeactivatedEvent) EventType() string {
+	return "product.channel_deactivated"
+}
+
+func (e *ProductChannelDeactivatedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *ProductChannelDeactivatedEvent) OccurredAt() time.Time {
+	return e.DeactivatedAt
+}
+
+// ChannelPriceChangedEvent is raised when a product's price on a sales channel is set
+// or cleared. A nil price means the channel sells at the product's base price.
+type ChannelPriceChangedEvent struct {
+	ProductID string
+	Channel   string
+	OldPrice  *Money
+	NewPrice  *Money
+	ChangedAt time.Time
+	// MarginOverride is true when the new price breached the margin floor under an allowed override.
+	MarginOverride bool
+}
+
+func (e *ChannelPriceChangedEvent) EventType() string {
+	return "price.channel_changed"
+}
+
+func (e *ChannelPriceChangedEvent) AggregateID() string {
+	return e.ProductID
+}
+
+func (e *ChannelPriceChangedEvent) OccurredAt() time.Time {
+	return e.ChangedAt
+}
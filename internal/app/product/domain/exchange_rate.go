@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// ExchangeRate is a value object converting amounts from one currency to another.
+// A rate applies from its effective time until a later rate for the same pair takes over.
+// Rate is the number of To units per one From unit (e.g. EUR→USD 1.0850).
+type ExchangeRate struct {
+	from        Currency
+	to          Currency
+	rate        *big.Rat
+	effectiveAt time.Time
+}
+
+// NewExchangeRate creates an ExchangeRate. The rate must be positive and the
+// currencies must be different supported ISO 4217 codes.
+func NewExchangeRate(from, to Currency, rate *big.Rat, effectiveAt time.Time) (*ExchangeRate, error) {
+	if _, ok := minorUnits[from]; !ok {
+		return nil, ErrUnsupportedCurrency
+	}
+	if _, ok := minorUnits[to]; !ok {
+		return nil, ErrUnsupportedCurrency
+	}
+	if from == to {
+		return nil, ErrInvalidExchangeRate
+	}
+	if rate == nil || rate.Sign() <= 0 {
+		return nil, ErrInvalidExchangeRate
+	}
+	if effectiveAt.IsZero() {
+		return nil, ErrInvalidExchangeRate
+	}
+	return &ExchangeRate{
+		from:        from,
+		to:          to,
+		rate:        new(big.Rat).Set(rate),
+		effectiveAt: effectiveAt.UTC(),
+	}, nil
+}
+
+func (r *ExchangeRate) From() Currency {
+	return r.from
+}
+
+func (r *ExchangeRate) To() Currency {
+	return r.to
+}
+
+// Rate returns a copy of the conversion factor.
+func (r *ExchangeRate) Rate() *big.Rat {
+	return new(big.Rat).Set(r.rate)
+}
+
+func (r *ExchangeRate) EffectiveAt() time.Time {
+	return r.effectiveAt
+}
+
+// Inverse returns the rate for the opposite direction, effective at the same time.
+func (r *ExchangeRate) Inverse() *ExchangeRate {
+	return &ExchangeRate{
+		from:        r.to,
+		to:          r.from,
+		rate:        new(big.Rat).Inv(r.rate),
+		effectiveAt: r.effectiveAt,
+	}
+}
+
+// SetEvent returns the event announcing that this rate was stored.
+func (r *ExchangeRate) SetEvent(now time.Time) *ExchangeRateSetEvent {
+	return &ExchangeRateSetEvent{
+		From:        r.from,
+		To:          r.to,
+		Rate:        r.Rate(),
+		EffectiveAt: r.effectiveAt,
+		SetAt:       now,
+	}
+}
+
+// Convert converts m into the target currency with exact rational arithmetic.
+// The result is not rounded; use Money.Round for display.
+// Returns ErrCurrencyMismatch if m is not in the rate's source currency.
+func (r *ExchangeRate) Convert(m *Money) (*Money, error) {
+	if m == nil || m.Currency() != r.from {
+		return nil, ErrCurrencyMismatch
+	}
+	return &Money{amount: new(big.Rat).Mul(m.amount, r.rate), currency: r.to}, nil
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExchangeRateValidation(t *testing.T) {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, rate := range []*big.Rat{nil, big.NewRat(0, 1), big.NewRat(-1085, 1000)} {
+		_, err := NewExchangeRate("EUR", "USD", rate, at)
+		assert.ErrorIs(t, err, ErrInvalidExchangeRate)
+	}
+	_, err := NewExchangeRate("EUR", "EUR", big.NewRat(1, 1), at)
+	assert.ErrorIs(t, err, ErrInvalidExchangeRate)
+	_, err = NewExchangeRate("EUR", "USD", big.NewRat(1085, 1000), time.Time{})
+	assert.ErrorIs(t, err, ErrInvalidExchangeRate)
+	_, err = NewExchangeRate("EUR", "XYZ", big.NewRat(1085, 1000), at)
+	assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+	_, err = NewExchangeRate("XYZ", "USD", big.NewRat(1085, 1000), at)
+	assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+}
+
+func TestExchangeRateConvert(t *testing.T) {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	factor := big.NewRat(1085, 1000)
+	r, err := NewExchangeRate("EUR", "USD", factor, at)
+	require.NoError(t, err)
+
+	// The rate keeps its own copy of the factor.
+	factor.SetInt64(2)
+	assert.Equal(t, 0, r.Rate().Cmp(big.NewRat(1085, 1000)))
+
+	usd, err := r.Convert(NewMoneyIn("EUR", 100, 1))
+	require.NoError(t, err)
+	assert.True(t, usd.Equals(NewMoneyIn("USD", 1085, 10)))
+
+	inverse := r.Inverse()
+	assert.Equal(t, Currency("USD"), inverse.From())
+	assert.Equal(t, Currency("EUR"), inverse.To())
+	assert.Equal(t, at, inverse.EffectiveAt())
+	eur, err := inverse.Convert(usd)
+	require.NoError(t, err)
+	assert.True(t, eur.Equals(NewMoneyIn("EUR", 100, 1)))
+
+	_, err = r.Convert(NewMoneyIn("USD", 100, 1))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = inverse.Convert(NewMoneyIn("EUR", 100, 1))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = r.Convert(nil)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "strings"
+
+// gtinLength is the length of a GTIN-14; shorter GTINs are stored zero-padded to it.
+const gtinLength = 14
+
+// NormalizeGTIN validates a GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) or GTIN-14,
+// ignoring spaces and hyphens, and returns it as a GTIN-14: the same number padded
+// with leading zeros, so an EAN-13 and its GTIN-14 form compare equal.
+func NormalizeGTIN(gtin string) (string, error) {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(gtin))
+	switch len(digits) {
+	case 8, 12, 13, 14:
+	default:
+		return "", ErrInvalidGTIN
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", ErrInvalidGTIN
+		}
+	}
+	digits = strings.Repeat("0", gtinLength-len(digits)) + digits
+
+	// The check digit completes the weighted sum of the other digits, weighted
+	// 3 and 1 alternately from the right, to a multiple of 10.
+	sum := 0
+	for i := 0; i < gtinLength-1; i++ {
+		d := int(digits[i] - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	if (10-sum%10)%10 != int(digits[gtinLength-1]-'0') {
+		return "", ErrInvalidGTIN
+	}
+	return digits, nil
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeGTIN(t *testing.T) {
+	for in, want := range map[string]string{
+		"4006381333931":    "04006381333931", // EAN-13
+		"400-6381-33393-1": "04006381333931",
+		"04006381333931":   "04006381333931", // the same as a GTIN-14
+		"036000291452":     "00036000291452", // UPC-A
+		"96385074":         "00000096385074", // GTIN-8
+		" 10012345000017 ": "10012345000017",
+	} {
+		got, err := NormalizeGTIN(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"", "4006381333932", "400638133393", "40063813339311", "40063813a3931", "123456789"} {
+		_, err := NormalizeGTIN(in)
+		assert.ErrorIs(t, err, ErrInvalidGTIN, in)
+	}
+}
+
+func TestProductIdentifiers(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Widget", "", testCategory("tools"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	p.ClearEvents()
+
+	require.NoError(t, p.SetSKU(" WID-001 ", now))
+	require.NoError(t, p.SetGTIN("4006381333931", now))
+	assert.Equal(t, "WID-001", p.SKU())
+	assert.Equal(t, "04006381333931", p.GTIN())
+	assert.True(t, p.Changes().Dirty(FieldSKU))
+	assert.True(t, p.Changes().Dirty(FieldGTIN))
+	require.Len(t, p.DomainEvents(), 2)
+	assert.Equal(t, map[string]interface{}{"gtin": "04006381333931"}, p.DomainEvents()[1].(*ProductUpdatedEvent).Changes)
+
+	// Setting an equal value changes nothing; the short and long GTIN forms are equal.
+	p.ClearEvents()
+	require.NoError(t, p.SetSKU("WID-001", now))
+	require.NoError(t, p.SetGTIN("04006381333931", now))
+	assert.Empty(t, p.DomainEvents())
+
+	assert.ErrorIs(t, p.SetSKU("WID 001", now), ErrInvalidSKU)
+	assert.ErrorIs(t, p.SetGTIN("4006381333932", now), ErrInvalidGTIN)
+
+	// Product and variant SKUs share a namespace.
+	_, err = p.AddVariant("var-1", "WID-001", map[string]string{"size": "M"}, nil, now)
+	assert.ErrorIs(t, err, ErrDuplicateSKU)
+	_, err = p.AddVariant("var-1", "WID-001-M", map[string]string{"size": "M"}, nil, now)
+	require.NoError(t, err)
+	assert.ErrorIs(t, p.SetSKU("WID-001-M", now), ErrDuplicateSKU)
+
+	require.NoError(t, p.SetSKU("", now))
+	require.NoError(t, p.SetGTIN(" ", now))
+	assert.Equal(t, "", p.SKU())
+	assert.Equal(t, "", p.GTIN())
+	assert.Equal(t, map[string]interface{}{"gtin": nil}, p.DomainEvents()[len(p.DomainEvents())-1].(*ProductUpdatedEvent).Changes)
+}
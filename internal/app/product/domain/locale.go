@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale is a language tag of a language and an optional region, e.g. "de" or "de-CH".
+type Locale string
+
+// DefaultLocale is the locale of a product's name and description unless set otherwise.
+const DefaultLocale Locale = "en"
+
+// localePattern restricts locales to a lowercase ISO 639 language and an optional
+// uppercase ISO 3166 region or UN M.49 area code.
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(?:-(?:[A-Z]{2}|[0-9]{3}))?$`)
+
+// ParseLocale returns the canonical form of a locale ("de_ch" becomes "de-CH").
+func ParseLocale(s string) (Locale, error) {
+	parts := strings.SplitN(strings.ReplaceAll(strings.TrimSpace(s), "_", "-"), "-", 2)
+	parts[0] = strings.ToLower(parts[0])
+	if len(parts) == 2 {
+		parts[1] = strings.ToUpper(parts[1])
+	}
+	l := strings.Join(parts, "-")
+	if !localePattern.MatchString(l) {
+		return "", ErrInvalidLocale
+	}
+	return Locale(l), nil
+}
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+// Language returns the locale without its region ("de-CH" becomes "de").
+func (l Locale) Language() Locale {
+	if i := strings.IndexByte(string(l), '-'); i >= 0 {
+		return l[:i]
+	}
+	return l
+}
+
+// ParseAcceptLanguage parses an Accept-Language style list such as
+// "de-CH, de;q=0.9, en;q=0.5" into locales ordered by preference. Entries with q=0
+// and the "*" wildcard are dropped; a bare locale ("fr") is a list of one.
+func ParseAcceptLanguage(header string) ([]Locale, error) {
+	type weighted struct {
+		locale Locale
+		q      float64
+	}
+	var entries []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" && params == "" {
+			continue
+		}
+		q := 1.0
+		if params != "" {
+			name, value, ok := strings.Cut(strings.TrimSpace(params), "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				return nil, ErrInvalidLocale
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				return nil, ErrInvalidLocale
+			}
+			q = parsed
+		}
+		if strings.TrimSpace(tag) == "*" || q == 0 {
+			continue
+		}
+		l, err := ParseLocale(tag)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, weighted{l, q})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].q > entries[j].q })
+
+	out := make([]Locale, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e.locale)
+	}
+	return out, nil
+}
+
+// LocaleFallbacks returns the locales to try, in order, for a reader preferring
+// preferred: each preferred locale followed by its language when that is not listed
+// itself ("de-CH" falls back to "de"), then defaultLocale. Duplicates are dropped.
+func LocaleFallbacks(preferred []Locale, defaultLocale Locale) []Locale {
+	listed := make(map[Locale]bool, len(preferred))
+	for _, l := range preferred {
+		listed[l] = true
+	}
+
+	out := make([]Locale, 0, 2*len(preferred)+1)
+	seen := make(map[Locale]bool, cap(out))
+	add := func(l Locale) {
+		if l != "" && !seen[l] {
+			seen[l] = true
+			out = append(out, l)
+		}
+	}
+	for _, l := range preferred {
+		add(l)
+		if !listed[l.Language()] {
+			add(l.Language())
+		}
+	}
+	add(defaultLocale)
+	return out
+}
+
+// Translation is a product's name and description in a locale other than its default
+// locale. The description may be empty.
+type Translation struct {
+	name        string
+	description string
+}
+
+// NewTranslation validates a translated name like a product name and trims both texts.
+func NewTranslation(name, description string) (Translation, error) {
+	if err := validateProductName(name); err != nil {
+		return Translation{}, err
+	}
+	return Translation{name: strings.TrimSpace(name), description: strings.TrimSpace(description)}, nil
+}
+
+func (t Translation) Name() string {
+	return t.name
+}
+
+func (t Translation) Description() string {
+	return t.description
+}
+
+// fieldTranslationPrefix prefixes the dirty-field name of a translation, e.g. "translation:de".
+// Use TranslationField to build it.
+const fieldTranslationPrefix = "translation:"
+
+// TranslationField returns the change-tracking field name for the translation into a locale.
+func TranslationField(l Locale) string {
+	return fieldTranslationPrefix + l.String()
+}
+
+// LocaleFromTranslationField returns the locale of a translation field, or false if
+// the field is not a translation field.
+func LocaleFromTranslationField(field string) (Locale, bool) {
+	if !strings.HasPrefix(field, fieldTranslationPrefix) {
+		return "", false
+	}
+	return Locale(strings.TrimPrefix(field, fieldTranslationPrefix)), true
+}
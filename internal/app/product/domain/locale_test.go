@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocale(t *testing.T) {
+	for in, want := range map[string]Locale{
+		"de":     "de",
+		" DE ":   "de",
+		"de_ch":  "de-CH",
+		"pt-br":  "pt-BR",
+		"es-419": "es-419",
+	} {
+		got, err := ParseLocale(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"", "german", "d", "de-", "de-CHE", "*"} {
+		_, err := ParseLocale(in)
+		assert.ErrorIs(t, err, ErrInvalidLocale, in)
+	}
+	assert.Equal(t, Locale("de"), Locale("de-CH").Language())
+	assert.Equal(t, Locale("de"), Locale("de").Language())
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	got, err := ParseAcceptLanguage("fr;q=0.5, de-CH, *;q=0.1, de;q=0.9, it;q=0")
+	require.NoError(t, err)
+	assert.Equal(t, []Locale{"de-CH", "de", "fr"}, got)
+
+	got, err = ParseAcceptLanguage("pl")
+	require.NoError(t, err)
+	assert.Equal(t, []Locale{"pl"}, got)
+
+	for _, in := range []string{"de;q=2", "de;v=1", "deutsch"} {
+		_, err := ParseAcceptLanguage(in)
+		assert.ErrorIs(t, err, ErrInvalidLocale, in)
+	}
+}
+
+func TestLocaleFallbacks(t *testing.T) {
+	assert.Equal(t, []Locale{"de-CH", "de", "fr", "en"}, LocaleFallbacks([]Locale{"de-CH", "fr"}, "en"))
+	assert.Equal(t, []Locale{"de-CH", "fr", "de", "en"}, LocaleFallbacks([]Locale{"de-CH", "fr", "de"}, "en"))
+	assert.Equal(t, []Locale{"en"}, LocaleFallbacks(nil, "en"))
+}
+
+func TestProductTranslations(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-1", "Kettle", "Boils water", "appliances", NewMoney(10, 1), nil,
+		ProductStatusActive, now, now, nil, WithSlug("kettle"))
+	assert.Equal(t, DefaultLocale, p.DefaultLocale())
+
+	assert.ErrorIs(t, p.SetTranslation("en", "Kettle", "", now), ErrDefaultLocaleTranslation)
+	assert.ErrorIs(t, p.SetTranslation("de", " ", "", now), ErrEmptyProductName)
+
+	require.NoError(t, p.SetTranslation("de", " Wasserkocher ", "Kocht Wasser", now))
+	tr, ok := p.Translation("de")
+	require.True(t, ok)
+	assert.Equal(t, "Wasserkocher", tr.Name())
+	assert.True(t, p.Changes().Dirty(TranslationField("de")))
+	ev := p.DomainEvents()[0].(*ProductUpdatedEvent)
+	assert.Equal(t, Locale("de"), ev.Locale)
+	assert.Equal(t, map[string]interface{}{"name": "Wasserkocher", "description": "Kocht Wasser"}, ev.Changes)
+
+	// Unchanged texts emit nothing; only the changed text is reported.
+	require.NoError(t, p.SetTranslation("de", "Wasserkocher", "Kocht Wasser", now))
+	assert.Len(t, p.DomainEvents(), 1)
+	require.NoError(t, p.SetTranslation("de", "Wasserkocher", "", now))
+	assert.Equal(t, map[string]interface{}{"description": nil}, p.DomainEvents()[1].(*ProductUpdatedEvent).Changes)
+
+	require.NoError(t, p.UpdateDetails("Electric Kettle", "", nil, now))
+	assert.Equal(t, DefaultLocale, p.DomainEvents()[2].(*ProductUpdatedEvent).Locale)
+
+	require.NoError(t, p.RemoveTranslation("fr", now))
+	assert.Len(t, p.DomainEvents(), 3)
+	require.NoError(t, p.RemoveTranslation("de", now))
+	_, ok = p.Translation("de")
+	assert.False(t, ok)
+	assert.Empty(t, p.TranslationLocales())
+}
+
+func TestProductSetDefaultLocale(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	de, err := NewTranslation("Wasserkocher", "Kocht Wasser")
+	require.NoError(t, err)
+	p := ReconstructProduct("prod-1", "Kettle", "Boils water", "appliances", NewMoney(10, 1), nil,
+		ProductStatusActive, now, now, nil, WithSlug("kettle"), WithLocalization("en", map[Locale]Translation{"de": de}))
+
+	// The German translation and the English texts swap places.
+	require.NoError(t, p.SetDefaultLocale("de", now))
+	assert.Equal(t, Locale("de"), p.DefaultLocale())
+	assert.Equal(t, "Wasserkocher", p.Name())
+	assert.Equal(t, "wasserkocher", p.Slug())
+	assert.Equal(t, []Locale{"en"}, p.TranslationLocales())
+	en, _ := p.Translation("en")
+	assert.Equal(t, "Kettle", en.Name())
+	assert.Equal(t, "Boils water", en.Description())
+	assert.True(t, p.Changes().Dirty(TranslationField("de")))
+	assert.True(t, p.Changes().Dirty(FieldDefaultLocale))
+
+	// Without a translation the texts are relabeled.
+	require.NoError(t, p.SetDefaultLocale("de-AT", now))
+	assert.Equal(t, "Wasserkocher", p.Name())
+	assert.Equal(t, []Locale{"en"}, p.TranslationLocales())
+	assert.Equal(t, map[string]interface{}{"default_locale": "de-AT"}, p.DomainEvents()[1].(*ProductUpdatedEvent).Changes)
+}
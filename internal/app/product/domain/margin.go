@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Role is the caller's role, as asserted by the transport layer.
+type Role string
+
+const (
+	RoleEditor         Role = "editor"
+	RolePricingManager Role = "pricing_manager"
+	RoleAdmin          Role = "admin"
+)
+
+// CanOverrideMargin reports whether the role may push a price below the margin floor.
+func (r Role) CanOverrideMargin() bool {
+	return r == RolePricingManager || r == RoleAdmin
+}
+
+// MarginPolicy holds the minimum gross margin, (price - cost) / price, per
+// product category. Categories without a rule use the default minimum, which is
+// zero unless configured: a product with a cost price never sells below cost.
+type MarginPolicy struct {
+	defaultMin *big.Rat
+	byCategory map[string]*big.Rat
+}
+
+// NewMarginPolicy builds a policy with the given default minimum margin (nil means zero).
+func NewMarginPolicy(defaultMin *big.Rat) (*MarginPolicy, error) {
+	if defaultMin == nil {
+		defaultMin = new(big.Rat)
+	}
+	if err := validateMargin(defaultMin); err != nil {
+		return nil, err
+	}
+	return &MarginPolicy{
+		defaultMin: new(big.Rat).Set(defaultMin),
+		byCategory: make(map[string]*big.Rat),
+	}, nil
+}
+
+// SetCategoryMinimum sets the minimum margin for a category slug, replacing any earlier rule.
+func (p *MarginPolicy) SetCategoryMinimum(category string, min *big.Rat) error {
+	category = Slugify(category)
+	if category == "" || min == nil {
+		return ErrInvalidMarginRule
+	}
+	if err := validateMargin(min); err != nil {
+		return err
+	}
+	p.byCategory[category] = new(big.Rat).Set(min)
+	return nil
+}
+
+// ParseMarginPolicy parses a rule list such as "default=0.1;category:grocery=0.05".
+// Margins are fractions in [0, 1); an empty spec yields a zero default.
+func ParseMarginPolicy(spec string) (*MarginPolicy, error) {
+	p, err := NewMarginPolicy(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, part := range strings.Split(spec, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		target, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, ErrInvalidMarginRule
+		}
+		min, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok {
+			return nil, ErrInvalidMarginRule
+		}
+		target = strings.TrimSpace(target)
+		if strings.EqualFold(target, "default") {
+			if err := validateMargin(min); err != nil {
+				return nil, err
+			}
+			p.defaultMin = min
+			continue
+		}
+		scope, category, ok := strings.Cut(target, ":")
+		if !ok || !strings.EqualFold(strings.TrimSpace(scope), "category") {
+			return nil, ErrInvalidMarginRule
+		}
+		if err := p.SetCategoryMinimum(category, min); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// MinimumFor returns the minimum margin for a category. A nil policy yields zero.
+func (p *MarginPolicy) MinimumFor(category string) *big.Rat {
+	if p != nil {
+		if min, ok := p.byCategory[category]; ok {
+			return new(big.Rat).Set(min)
+		}
+		return new(big.Rat).Set(p.defaultMin)
+	}
+	return new(big.Rat)
+}
+
+// FloorFor returns the lowest price that keeps the category's minimum margin
+// over the cost: cost / (1 - minimum).
+func (p *MarginPolicy) FloorFor(category string, cost *Money) *Money {
+	keep := new(big.Rat).Sub(big.NewRat(1, 1), p.MinimumFor(category))
+	return NewMoneyFromRatIn(cost.Currency(), new(big.Rat).Quo(cost.Rat(), keep))
+}
+
+func validateMargin(min *big.Rat) error {
+	if min.Sign() < 0 || min.Cmp(big.NewRat(1, 1)) >= 0 {
+		return ErrInvalidMarginRule
+	}
+	return nil
+}
+
+// PriceChangeOption configures the margin guardrail of a single price change.
+type PriceChangeOption func(o *priceChangeOptions)
+
+type priceChangeOptions struct {
+	margins  *MarginPolicy
+	override bool
+	role     Role
+}
+
+// WithMarginPolicy checks the change against the policy's minimum margin
+// instead of the zero default.
+func WithMarginPolicy(policy *MarginPolicy) PriceChangeOption {
+	return func(o *priceChangeOptions) {
+		o.margins = policy
+	}
+}
+
+// WithMarginOverride lets a change breach the margin floor. The role must be
+// allowed to override margins, or the change fails with ErrMarginOverrideNotAllowed.
+func WithMarginOverride(role Role) PriceChangeOption {
+	return func(o *priceChangeOptions) {
+		o.override = true
+		o.role = role
+	}
+}
+
+func newPriceChangeOptions(opts []PriceChangeOption) *priceChangeOptions {
+	o := &priceChangeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// checkMargin verifies that selling at price keeps the minimum margin over cost.
+// It reports whether the floor was breached under an allowed override.
+func (o *priceChangeOptions) checkMargin(category string, cost, price *Money) (bool, error) {
+	if o.override && !o.role.CanOverrideMargin() {
+		return false, ErrMarginOverrideNotAllowed
+	}
+	if cost == nil || price == nil || !cost.SameCurrency(price) {
+		return false, nil
+	}
+	if !price.LessThan(o.margins.FloorFor(category, cost)) {
+		return false, nil
+	}
+	if o.override {
+		return true, nil
+	}
+	return false, ErrMarginViolation
+}
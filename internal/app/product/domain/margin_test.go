@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarginPolicy(t *testing.T) {
+	policy, err := ParseMarginPolicy("default=0.1; category:grocery=0.05")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1/10", policy.MinimumFor("electronics").RatString())
+	assert.Equal(t, "1/20", policy.MinimumFor("grocery").RatString())
+	// 8.00 cost at a 10% margin floors the price at 8.00 / 0.9
+	assert.Equal(t, "80/9", policy.FloorFor("electronics", NewMoney(8, 1)).Rat().RatString())
+
+	var none *MarginPolicy
+	assert.Equal(t, "0", none.MinimumFor("grocery").RatString())
+
+	for _, spec := range []string{"default", "default=1", "default=-0.1", "grocery=0.1", "category:=0.1", "currency:USD=0.1"} {
+		_, err := ParseMarginPolicy(spec)
+		assert.ErrorIs(t, err, ErrInvalidMarginRule, spec)
+	}
+}
+
+func newCostedProduct(t *testing.T, now time.Time) *Product {
+	t.Helper()
+	p, err := NewProduct("prod-1", "Widget", "", testCategory("tools"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	require.NoError(t, p.Activate(now))
+	require.NoError(t, p.SetCostPrice(NewMoney(8, 1), now))
+	return p
+}
+
+func TestApplyDiscountRespectsMargin(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy, err := ParseMarginPolicy("default=0.1")
+	require.NoError(t, err)
+	discount := func(pct int64) *Discount {
+		d, err := NewDiscountFromRat(big.NewRat(pct, 100), now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		return d
+	}
+
+	// 10% off leaves 9.00, above the 8.00 / 0.9 = 8.89 floor.
+	p := newCostedProduct(t, now)
+	require.NoError(t, p.ApplyDiscount(discount(10), now, WithMarginPolicy(policy)))
+
+	// 15% off leaves 8.50: above cost, but below the floor.
+	p = newCostedProduct(t, now)
+	assert.ErrorIs(t, p.ApplyDiscount(discount(15), now, WithMarginPolicy(policy)), ErrMarginViolation)
+	assert.Nil(t, p.Discount())
+
+	// Without a policy the floor is the cost itself.
+	require.NoError(t, p.ApplyDiscount(discount(15), now))
+
+	// Overrides need an elevated role.
+	p = newCostedProduct(t, now)
+	assert.ErrorIs(t, p.ApplyDiscount(discount(50), now, WithMarginPolicy(policy), WithMarginOverride(RoleEditor)), ErrMarginOverrideNotAllowed)
+	require.NoError(t, p.ApplyDiscount(discount(50), now, WithMarginPolicy(policy), WithMarginOverride(RolePricingManager)))
+
+	var applied *DiscountAppliedEvent
+	for _, ev := range p.DomainEvents() {
+		if e, ok := ev.(*DiscountAppliedEvent); ok {
+			applied = e
+		}
+	}
+	require.NotNil(t, applied)
+	assert.True(t, applied.MarginOverride)
+}
+
+func TestUpdatePriceRespectsMargin(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := newCostedProduct(t, now)
+
+	assert.ErrorIs(t, p.UpdatePrice(NewMoney(799, 100), now), ErrMarginViolation)
+	assert.Equal(t, "10.00 USD", p.BasePrice().String())
+
+	// A discount in effect is taken into account.
+	d, err := NewDiscountFromRat(big.NewRat(1, 10), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, p.ApplyDiscount(d, now))
+	assert.ErrorIs(t, p.UpdatePrice(NewMoney(880, 100), now), ErrMarginViolation)
+	require.NoError(t, p.UpdatePrice(NewMoney(900, 100), now))
+
+	require.NoError(t, p.UpdatePrice(NewMoney(5, 1), now, WithMarginOverride(RoleAdmin)))
+}
+
+func TestSetCostPrice(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := newCostedProduct(t, now)
+
+	assert.ErrorIs(t, p.SetCostPrice(NewMoney(11, 1), now), ErrMarginViolation)
+	assert.ErrorIs(t, p.SetCostPrice(NewMoneyIn("EUR", 5, 1), now), ErrCurrencyMismatch)
+
+	require.NoError(t, p.SetCostPrice(nil, now))
+	assert.Nil(t, p.CostPrice())
+	assert.True(t, p.Changes().Dirty(FieldCostPrice))
+	require.NoError(t, p.UpdatePrice(NewMoney(1, 1), now))
+}
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MediaKind is the type of a product media entry.
+type MediaKind string
+
+const (
+	MediaKindImage    MediaKind = "image"
+	MediaKindVideo    MediaKind = "video"
+	MediaKindDocument MediaKind = "document"
+)
+
+// ParseMediaKind validates a media kind.
+func ParseMediaKind(s string) (MediaKind, error) {
+	switch MediaKind(strings.ToLower(strings.TrimSpace(s))) {
+	case MediaKindImage:
+		return MediaKindImage, nil
+	case MediaKindVideo:
+		return MediaKindVideo, nil
+	case MediaKindDocument:
+		return MediaKindDocument, nil
+	}
+	return "", ErrInvalidMediaKind
+}
+
+const (
+	// MaxProductMedia is the most media entries a product can have.
+	MaxProductMedia = 50
+
+	maxMediaURLLength = 2048
+	maxAltTextLength  = 500
+)
+
+// NormalizeMediaURL trims a media URL and validates it: an absolute http or https
+// URL with a host, of up to 2048 characters.
+func NormalizeMediaURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || len(raw) > maxMediaURLLength {
+		return "", ErrInvalidMediaURL
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", ErrInvalidMediaURL
+	}
+	return raw, nil
+}
+
+// NewAltTexts validates alt texts keyed by locale. Locales are canonicalized with
+// ParseLocale and texts trimmed; empty texts are dropped.
+func NewAltTexts(in map[string]string) (map[Locale]string, error) {
+	out := make(map[Locale]string, len(in))
+	for locale, text := range in {
+		l, err := ParseLocale(locale)
+		if err != nil {
+			return nil, err
+		}
+		text = strings.TrimSpace(text)
+		if utf8.RuneCountInString(text) > maxAltTextLength {
+			return nil, ErrInvalidAltText
+		}
+		if _, dup := out[l]; dup {
+			return nil, ErrInvalidAltText
+		}
+		if text != "" {
+			out[l] = text
+		}
+	}
+	return out, nil
+}
+
+// fieldMediaPrefix prefixes the dirty-field name of a media entry, e.g. "media:<id>".
+// Use MediaField to build it.
+const fieldMediaPrefix = "media:"
+
+// MediaField returns the change-tracking field name for a media entry.
+func MediaField(id string) string {
+	return fieldMediaPrefix + id
+}
+
+// MediaIDFromField returns the id of a media field, or false if the field is not a media field.
+func MediaIDFromField(field string) (string, bool) {
+	if !strings.HasPrefix(field, fieldMediaPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(field, fieldMediaPrefix), true
+}
+
+// Media is an image, video or document shown with a product. It is an entity owned
+// by the Product aggregate; the files themselves live in the media service, the
+// catalog keeps their URL and metadata. Media are shown by ascending sort order, and
+// at most one image is the product's primary image.
+type Media struct {
+	id        string
+	url       string
+	kind      MediaKind
+	altTexts  map[Locale]string
+	sortOrder int
+	primary   bool
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// ReconstructMedia reconstructs a media entry from persisted state.
+func ReconstructMedia(id, url string, kind MediaKind, altTexts map[Locale]string, sortOrder int, primary bool, createdAt, updatedAt time.Time) *Media {
+	texts := make(map[Locale]string, len(altTexts))
+	for l, t := range altTexts {
+		texts[l] = t
+	}
+	return &Media{
+		id:        id,
+		url:       url,
+		kind:      kind,
+		altTexts:  texts,
+		sortOrder: sortOrder,
+		primary:   primary,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (m *Media) ID() string {
+	return m.id
+}
+
+func (m *Media) URL() string {
+	return m.url
+}
+
+func (m *Media) Kind() MediaKind {
+	return m.kind
+}
+
+// AltTexts returns a copy of the media's alt texts by locale.
+func (m *Media) AltTexts() map[Locale]string {
+	out := make(map[Locale]string, len(m.altTexts))
+	for l, t := range m.altTexts {
+		out[l] = t
+	}
+	return out
+}
+
+// AltText returns the media's alt text in a locale, if set.
+func (m *Media) AltText(l Locale) (string, bool) {
+	t, ok := m.altTexts[l]
+	return t, ok
+}
+
+func (m *Media) SortOrder() int {
+	return m.sortOrder
+}
+
+// IsPrimary reports whether the media is the product's primary image.
+func (m *Media) IsPrimary() bool {
+	return m.primary
+}
+
+func (m *Media) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m *Media) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+// sortMedia orders media by sort order, then id.
+func sortMedia(media []*Media) {
+	sort.Slice(media, func(i, j int) bool {
+		if media[i].sortOrder != media[j].sortOrder {
+			return media[i].sortOrder < media[j].sortOrder
+		}
+		return media[i].id < media[j].id
+	})
+}
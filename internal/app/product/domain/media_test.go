@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeMediaURL(t *testing.T) {
+	got, err := NormalizeMediaURL(" https://media.example.com/p/1.jpg ")
+	require.NoError(t, err)
+	assert.Equal(t, "https://media.example.com/p/1.jpg", got)
+
+	for _, in := range []string{"", "/p/1.jpg", "ftp://media.example.com/1.jpg", "https:///1.jpg", "media.example.com/1.jpg"} {
+		_, err := NormalizeMediaURL(in)
+		assert.ErrorIs(t, err, ErrInvalidMediaURL, in)
+	}
+}
+
+func TestNewAltTexts(t *testing.T) {
+	got, err := NewAltTexts(map[string]string{"en": " A red kettle ", "de_ch": "Ein roter Wasserkocher", "fr": " "})
+	require.NoError(t, err)
+	assert.Equal(t, map[Locale]string{"en": "A red kettle", "de-CH": "Ein roter Wasserkocher"}, got)
+
+	_, err = NewAltTexts(map[string]string{"de-CH": "a", "de_ch": "b"})
+	assert.ErrorIs(t, err, ErrInvalidAltText)
+	_, err = NewAltTexts(map[string]string{"german": "a"})
+	assert.ErrorIs(t, err, ErrInvalidLocale)
+}
+
+func TestProductMedia(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-1", "Kettle", "", "appliances", NewMoney(10, 1), nil, ProductStatusActive, now, now, nil)
+
+	// Videos cannot be primary; the first image becomes primary on its own.
+	_, err := p.AddMedia("m1", "https://cdn.example.com/kettle.mp4", MediaKindVideo, nil, true, now)
+	assert.ErrorIs(t, err, ErrPrimaryMediaNotImage)
+	_, err = p.AddMedia("m1", "https://cdn.example.com/kettle.mp4", MediaKindVideo, nil, false, now)
+	require.NoError(t, err)
+	assert.Nil(t, p.PrimaryMedia())
+	_, err = p.AddMedia("m2", "https://cdn.example.com/front.jpg", MediaKindImage, map[string]string{"en": "Front"}, false, now)
+	require.NoError(t, err)
+	assert.Equal(t, "m2", p.PrimaryMedia().ID())
+	_, err = p.AddMedia("m3", "https://cdn.example.com/side.jpg", "IMAGE", nil, true, now)
+	require.NoError(t, err)
+	assert.Equal(t, "m3", p.PrimaryMedia().ID())
+	assert.True(t, p.Changes().Dirty(MediaField("m2")))
+	ev := p.DomainEvents()[2].(*ProductUpdatedEvent)
+	assert.Equal(t, map[string]interface{}{"media_added": "m3", "primary_media": "m3", "media": []string{"m1", "m2", "m3"}}, ev.Changes)
+
+	_, err = p.AddMedia("m4", "https://cdn.example.com/side.jpg", MediaKindImage, nil, false, now)
+	assert.ErrorIs(t, err, ErrDuplicateMediaURL)
+	_, err = p.AddMedia("m4", "https://cdn.example.com/manual.pdf", "pdf", nil, false, now)
+	assert.ErrorIs(t, err, ErrInvalidMediaKind)
+
+	assert.ErrorIs(t, p.ReorderMedia([]string{"m3", "m1"}, "", now), ErrInvalidMediaOrder)
+	assert.ErrorIs(t, p.ReorderMedia([]string{"m3", "m1", "m1"}, "", now), ErrInvalidMediaOrder)
+	assert.ErrorIs(t, p.ReorderMedia(nil, "m1", now), ErrPrimaryMediaNotImage)
+	assert.ErrorIs(t, p.ReorderMedia(nil, "m9", now), ErrMediaNotFound)
+	require.NoError(t, p.ReorderMedia([]string{"m3", "m1", "m2"}, "", now))
+	assert.Equal(t, []string{"m3", "m1", "m2"}, p.mediaIDs())
+	require.NoError(t, p.ReorderMedia([]string{"m3", "m1", "m2"}, "m3", now))
+	assert.Len(t, p.DomainEvents(), 4)
+
+	// Removing the primary image promotes the next image.
+	require.NoError(t, p.RemoveMedia("m3", now))
+	assert.Equal(t, "m2", p.PrimaryMedia().ID())
+	assert.Equal(t, []string{"m1", "m2"}, p.mediaIDs())
+	assert.ErrorIs(t, p.RemoveMedia("m3", now), ErrMediaNotFound)
+}
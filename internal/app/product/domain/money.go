@@ -3,68 +3,131 @@ package domain
 import (
 	"fmt"
 	"math/big"
+	"strings"
 )
 
-// Money represents a monetary value with precise decimal arithmetic.
+// Money represents a monetary value with precise decimal arithmetic in a single currency.
 // It uses big.Rat internally to avoid floating-point precision issues.
 // Money is immutable - all operations return new instances.
+// Arithmetic and comparisons between different currencies are refused.
 type Money struct {
-	amount *big.Rat
+	amount   *big.Rat
+	currency Currency
 }
 
-// NewMoney creates a new Money instance from numerator and denominator.
+// NewMoney creates a new Money instance from numerator and denominator in DefaultCurrency.
 // For example: NewMoney(1999, 100) represents $19.99
 func NewMoney(numerator, denominator int64) *Money {
+	return NewMoneyIn(DefaultCurrency, numerator, denominator)
+}
+
+// NewMoneyIn creates a new Money instance from numerator and denominator in the given currency.
+// For example: NewMoneyIn("EUR", 1999, 100) represents €19.99
+func NewMoneyIn(currency Currency, numerator, denominator int64) *Money {
 	if denominator == 0 {
 		panic("money: denominator cannot be zero")
 	}
 	return &Money{
-		amount: big.NewRat(numerator, denominator),
+		amount:   big.NewRat(numerator, denominator),
+		currency: currency,
 	}
 }
 
-// NewMoneyFromDecimal creates Money from a decimal string.
+// NewMoneyFromDecimal creates Money in DefaultCurrency from a decimal string.
 // For example: "19.99", "100.00", "0.01"
 func NewMoneyFromDecimal(decimal string) (*Money, error) {
+	return NewMoneyFromDecimalIn(DefaultCurrency, decimal)
+}
+
+// NewMoneyFromDecimalIn creates Money in the given currency from a decimal string,
+// such as a NUMERIC column value.
+func NewMoneyFromDecimalIn(currency Currency, decimal string) (*Money, error) {
 	rat := new(big.Rat)
 	if _, ok := rat.SetString(decimal); !ok {
 		return nil, fmt.Errorf("invalid decimal format: %s", decimal)
 	}
-	return &Money{amount: rat}, nil
+	return &Money{amount: rat, currency: currency}, nil
 }
 
-// NewMoneyFromRat creates Money from an existing big.Rat.
+// NewMoneyFromRat creates Money in DefaultCurrency from an existing big.Rat.
 // The rat is copied to ensure immutability.
 func NewMoneyFromRat(rat *big.Rat) *Money {
+	return NewMoneyFromRatIn(DefaultCurrency, rat)
+}
+
+// NewMoneyFromRatIn creates Money in the given currency from an existing big.Rat.
+// The rat is copied to ensure immutability.
+func NewMoneyFromRatIn(currency Currency, rat *big.Rat) *Money {
 	if rat == nil {
-		return &Money{amount: big.NewRat(0, 1)}
+		return &Money{amount: big.NewRat(0, 1), currency: currency}
 	}
 	return &Money{
-		amount: new(big.Rat).Set(rat),
+		amount:   new(big.Rat).Set(rat),
+		currency: currency,
 	}
 }
 
-// Zero returns a Money instance representing zero.
+// Zero returns a Money instance representing zero in DefaultCurrency.
 func Zero() *Money {
-	return &Money{amount: big.NewRat(0, 1)}
+	return ZeroIn(DefaultCurrency)
+}
+
+// ZeroIn returns a Money instance representing zero in the given currency.
+func ZeroIn(currency Currency) *Money {
+	return &Money{amount: big.NewRat(0, 1), currency: currency}
+}
+
+// Currency returns the ISO 4217 currency of the amount.
+func (m *Money) Currency() Currency {
+	return m.currency
+}
+
+// SameCurrency returns true if m and other are in the same currency.
+func (m *Money) SameCurrency(other *Money) bool {
+	return other != nil && m.currency == other.currency
 }
 
 // Add returns a new Money that is the sum of m and other.
-func (m *Money) Add(other *Money) *Money {
-	result := new(big.Rat).Add(m.amount, other.amount)
-	return &Money{amount: result}
+// Returns ErrCurrencyMismatch if the currencies differ.
+func (m *Money) Add(other *Money) (*Money, error) {
+	if !m.SameCurrency(other) {
+		return nil, ErrCurrencyMismatch
+	}
+	return m.add(other), nil
 }
 
 // Subtract returns a new Money that is the difference of m and other.
-func (m *Money) Subtract(other *Money) *Money {
+// Returns ErrCurrencyMismatch if the currencies differ.
+func (m *Money) Subtract(other *Money) (*Money, error) {
+	if !m.SameCurrency(other) {
+		return nil, ErrCurrencyMismatch
+	}
+	return m.sub(other), nil
+}
+
+// add and sub skip the currency check for amounts derived from m itself
+// (e.g. a discount amount computed as a fraction of the price).
+func (m *Money) add(other *Money) *Money {
+	result := new(big.Rat).Add(m.amount, other.amount)
+	return &Money{amount: result, currency: m.currency}
+}
+
+func (m *Money) sub(other *Money) *Money {
 	result := new(big.Rat).Sub(m.amount, other.amount)
-	return &Money{amount: result}
+	return &Money{amount: result, currency: m.currency}
 }
 
-// Multiply returns a new Money that is the product of m and other.
+// Multiply returns a new Money that is m scaled by the amount of other.
+// other is treated as a dimensionless factor; the result keeps m's currency.
+// Prefer MultiplyByRat for new code.
 func (m *Money) Multiply(other *Money) *Money {
-	result := new(big.Rat).Mul(m.amount, other.amount)
-	return &Money{amount: result}
+	return m.MultiplyByRat(other.amount)
+}
+
+// MultiplyByRat returns a new Money that is m scaled by the given factor.
+func (m *Money) MultiplyByRat(factor *big.Rat) *Money {
+	result := new(big.Rat).Mul(m.amount, factor)
+	return &Money{amount: result, currency: m.currency}
 }
 
 // MultiplyByDecimal multiplies Money by a decimal value (e.g., for percentage calculations).
@@ -72,7 +135,7 @@ func (m *Money) Multiply(other *Money) *Money {
 func (m *Money) MultiplyByDecimal(decimal float64) *Money {
 	multiplier := new(big.Rat).SetFloat64(decimal)
 	result := new(big.Rat).Mul(m.amount, multiplier)
-	return &Money{amount: result}
+	return &Money{amount: result, currency: m.currency}
 }
 
 // MultiplyByFraction multiplies Money by a fraction (numerator/denominator).
@@ -80,7 +143,7 @@ func (m *Money) MultiplyByDecimal(decimal float64) *Money {
 func (m *Money) MultiplyByFraction(numerator, denominator int64) *Money {
 	multiplier := big.NewRat(numerator, denominator)
 	result := new(big.Rat).Mul(m.amount, multiplier)
-	return &Money{amount: result}
+	return &Money{amount: result, currency: m.currency}
 }
 
 // IsZero returns true if the money amount is zero.
@@ -98,34 +161,88 @@ func (m *Money) IsPositive() bool {
 	return m.amount.Cmp(big.NewRat(0, 1)) > 0
 }
 
+// Compare compares m and other, returning -1, 0 or +1.
+// Returns ErrCurrencyMismatch if the currencies differ.
+func (m *Money) Compare(other *Money) (int, error) {
+	if !m.SameCurrency(other) {
+		return 0, ErrCurrencyMismatch
+	}
+	return m.amount.Cmp(other.amount), nil
+}
+
 // GreaterThan returns true if m is greater than other.
+// Amounts in different currencies are never ordered; use Compare to detect that case.
 func (m *Money) GreaterThan(other *Money) bool {
-	return m.amount.Cmp(other.amount) > 0
+	c, err := m.Compare(other)
+	return err == nil && c > 0
 }
 
 // LessThan returns true if m is less than other.
+// Amounts in different currencies are never ordered; use Compare to detect that case.
 func (m *Money) LessThan(other *Money) bool {
-	return m.amount.Cmp(other.amount) < 0
+	c, err := m.Compare(other)
+	return err == nil && c < 0
 }
 
-// Equals returns true if m equals other.
+// Equals returns true if m equals other (same amount and currency).
 func (m *Money) Equals(other *Money) bool {
 	if other == nil {
 		return false
 	}
-	return m.amount.Cmp(other.amount) == 0
+	return m.currency == other.currency && m.amount.Cmp(other.amount) == 0
+}
+
+// NumericScale and NumericIntegerDigits describe the fixed-point range of the
+// Spanner NUMERIC columns that store prices.
+const (
+	NumericScale         = 9
+	NumericIntegerDigits = 29
+)
+
+// Fraction returns the reduced numerator and denominator of the amount.
+// Returns ErrMoneyOverflow if either part does not fit in an int64; callers
+// must not fall back to a truncated value.
+func (m *Money) Fraction() (num, den int64, err error) {
+	n, d := m.amount.Num(), m.amount.Denom()
+	if !n.IsInt64() || !d.IsInt64() {
+		return 0, 0, ErrMoneyOverflow
+	}
+	return n.Int64(), d.Int64(), nil
 }
 
-// Numerator returns the numerator of the internal rational representation.
-// Used for database persistence.
-func (m *Money) Numerator() int64 {
-	return m.amount.Num().Int64()
+// Decimal returns the amount as an exact decimal string suitable for a NUMERIC
+// column. Returns ErrMoneyOverflow if the amount needs more than NumericScale
+// fractional digits or NumericIntegerDigits integer digits, since storing it
+// would round the value.
+func (m *Money) Decimal() (string, error) {
+	s, ok := numericString(m.amount)
+	if !ok {
+		return "", ErrMoneyOverflow
+	}
+	return s, nil
 }
 
-// Denominator returns the denominator of the internal rational representation.
-// Used for database persistence.
-func (m *Money) Denominator() int64 {
-	return m.amount.Denom().Int64()
+// numericString renders r as an exact NUMERIC decimal without trailing zeros.
+// It reports false when r has no exact NUMERIC representation.
+func numericString(r *big.Rat) (string, bool) {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(NumericScale), nil)
+	if new(big.Int).Rem(scale, r.Denom()).Sign() != 0 {
+		return "", false
+	}
+	s := trimDecimal(r.FloatString(NumericScale))
+	whole := strings.TrimPrefix(strings.SplitN(s, ".", 2)[0], "-")
+	if len(whole) > NumericIntegerDigits {
+		return "", false
+	}
+	return s, true
+}
+
+// trimDecimal strips trailing fractional zeros (and a dangling point) from a decimal string.
+func trimDecimal(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
 }
 
 // Rat returns a copy of the internal big.Rat.
@@ -141,10 +258,10 @@ func (m *Money) Float64() float64 {
 	return f
 }
 
-// String returns a string representation of the money amount.
-// Format: "numerator/denominator" (e.g., "1999/100" for $19.99)
+// String returns a string representation of the money amount in the currency's minor units.
+// Format: "<amount> <currency>" (e.g., "19.99 USD", "1999 JPY")
 func (m *Money) String() string {
-	return m.amount.FloatString(2)
+	return m.amount.FloatString(m.currency.MinorUnits()) + " " + m.currency.String()
 }
 
 // FloatString returns a decimal string representation with the specified precision.
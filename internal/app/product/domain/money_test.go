@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyFraction(t *testing.T) {
+	num, den, err := NewMoney(3998, 200).Fraction()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1999), num)
+	assert.Equal(t, int64(100), den)
+
+	// Repeated discounting of an awkward price grows the denominator past int64.
+	price := NewMoney(1999, 100)
+	for i := 0; i < 12; i++ {
+		price = price.MultiplyByRat(big.NewRat(877, 1000))
+	}
+	_, _, err = price.Fraction()
+	assert.ErrorIs(t, err, ErrMoneyOverflow)
+}
+
+func TestMoneyDecimal(t *testing.T) {
+	cases := []struct {
+		name     string
+		money    *Money
+		expected string
+		err      error
+	}{
+		{"cents", NewMoney(1999, 100), "19.99", nil},
+		{"whole amount", NewMoney(20, 1), "20", nil},
+		{"negative", NewMoney(-5, 4), "-1.25", nil},
+		{"nine fractional digits", NewMoney(1, 1_000_000_000), "0.000000001", nil},
+		{"ten fractional digits", NewMoney(1, 10_000_000_000), "", ErrMoneyOverflow},
+		{"non-terminating", NewMoney(200, 3), "", ErrMoneyOverflow},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.money.Decimal()
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+
+	huge, ok := new(big.Rat).SetString("123456789012345678901234567890")
+	require.True(t, ok)
+	_, err := NewMoneyFromRat(huge).Decimal()
+	assert.ErrorIs(t, err, ErrMoneyOverflow)
+}
+
+func TestProductRejectsUnstorablePrice(t *testing.T) {
+	now := time.Now().UTC()
+
+	_, err := NewProduct("prod-1", "Widget", "", testCategory("tools"), NewMoney(200, 3), now)
+	assert.ErrorIs(t, err, ErrMoneyOverflow)
+
+	p, err := NewProduct("prod-1", "Widget", "", testCategory("tools"), NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	assert.ErrorIs(t, p.UpdatePrice(NewMoney(1, 3), now), ErrMoneyOverflow)
+	assert.Equal(t, "19.99 USD", p.BasePrice().String())
+}
+
+func TestMoneyArithmeticRequiresSameCurrency(t *testing.T) {
+	usd := NewMoney(1050, 100)
+	eur := NewMoneyIn("EUR", 1050, 100)
+	assert.Equal(t, DefaultCurrency, usd.Currency())
+
+	_, err := usd.Add(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = usd.Subtract(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = usd.Compare(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = usd.Add(nil)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	// Amounts in different currencies are neither ordered nor equal.
+	assert.False(t, usd.GreaterThan(eur))
+	assert.False(t, usd.LessThan(eur))
+	assert.False(t, usd.Equals(eur))
+
+	sum, err := eur.Add(NewMoneyIn("EUR", 50, 100))
+	require.NoError(t, err)
+	assert.True(t, sum.Equals(NewMoneyIn("EUR", 11, 1)))
+	diff, err := eur.Subtract(NewMoneyIn("EUR", 1100, 100))
+	require.NoError(t, err)
+	assert.True(t, diff.IsNegative())
+	assert.Equal(t, Currency("EUR"), diff.Currency())
+	c, err := usd.Compare(NewMoney(21, 2))
+	require.NoError(t, err)
+	assert.Equal(t, 0, c)
+
+	// Scaling keeps the currency.
+	assert.Equal(t, Currency("EUR"), eur.MultiplyByRat(big.NewRat(1, 2)).Currency())
+}
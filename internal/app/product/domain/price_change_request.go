@@ -0,0 +1,362 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// PriceChangeKind is the kind of change a PriceChangeRequest holds.
+type PriceChangeKind string
+
+const (
+	PriceChangeKindBasePrice    PriceChangeKind = "base_price"
+	PriceChangeKindDiscount     PriceChangeKind = "discount"
+	PriceChangeKindVariantPrice PriceChangeKind = "variant_price"
+	PriceChangeKindChannelPrice PriceChangeKind = "channel_price"
+)
+
+// PriceChangeRequestStatus is the lifecycle state of a PriceChangeRequest.
+type PriceChangeRequestStatus string
+
+const (
+	PriceChangeRequestPending  PriceChangeRequestStatus = "pending"
+	PriceChangeRequestApproved PriceChangeRequestStatus = "approved"
+	PriceChangeRequestRejected PriceChangeRequestStatus = "rejected"
+)
+
+// Field constants for price change request change tracking. A decision sets the
+// status together with the decider, reason and decision time.
+const (
+	FieldChangeRequestDecision = "decision"
+)
+
+// maxDecisionReasonLength bounds the free-text reason recorded with a decision.
+const maxDecisionReasonLength = 1000
+
+// PriceChangeRequest is the aggregate root for a price change held back for approval.
+// It records the requested change and who asked for it; only approval by a second
+// actor applies the change to the product.
+type PriceChangeRequest struct {
+	id        string
+	productID string
+	kind      PriceChangeKind
+	// variantID and channel name the variant or sales channel whose own price changes.
+	variantID string
+	channel   string
+	// oldPrice is the price in newPrice's currency when the change was requested; approval fails if it moved since.
+	oldPrice *Money
+	newPrice *Money
+	discount *Discount
+	// overrideMargin and requestedRole carry the requester's margin override to approval time.
+	overrideMargin bool
+	requestedBy    string
+	requestedRole  Role
+	status         PriceChangeRequestStatus
+	decidedBy      string
+	reason         string
+	createdAt      time.Time
+	decidedAt      *time.Time
+	changes        *ChangeTracker
+	events         []DomainEvent
+}
+
+// NewBasePriceChangeRequest holds back a change of the product's base price in one currency from
+// oldPrice to newPrice.
+// requestedBy must identify the requester, who may not approve the change.
+func NewBasePriceChangeRequest(id, productID string, oldPrice, newPrice *Money, overrideMargin bool, requestedBy string, role Role, now time.Time) (*PriceChangeRequest, error) {
+	return newPriceUpdateRequest(id, productID, PriceChangeKindBasePrice, "", "", oldPrice, newPrice, overrideMargin, requestedBy, role, now)
+}
+
+// NewVariantPriceChangeRequest holds back setting a variant's own price from oldPrice,
+// the price the variant sells at now, to newPrice.
+func NewVariantPriceChangeRequest(id, productID, variantID string, oldPrice, newPrice *Money, overrideMargin bool, requestedBy string, role Role, now time.Time) (*PriceChangeRequest, error) {
+	if variantID == "" {
+		return nil, ErrVariantNotFound
+	}
+	return newPriceUpdateRequest(id, productID, PriceChangeKindVariantPrice, variantID, "", oldPrice, newPrice, overrideMargin, requestedBy, role, now)
+}
+
+// NewChannelPriceChangeRequest holds back setting the product's price on a sales
+// channel from oldPrice, the price it sells at there now, to newPrice.
+func NewChannelPriceChangeRequest(id, productID, channel string, oldPrice, newPrice *Money, overrideMargin bool, requestedBy string, role Role, now time.Time) (*PriceChangeRequest, error) {
+	channel, err := NormalizeChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+	return newPriceUpdateRequest(id, productID, PriceChangeKindChannelPrice, "", channel, oldPrice, newPrice, overrideMargin, requestedBy, role, now)
+}
+
+// newPriceUpdateRequest starts a pending request replacing oldPrice with newPrice.
+func newPriceUpdateRequest(id, productID string, kind PriceChangeKind, variantID, channel string, oldPrice, newPrice *Money,
+	overrideMargin bool, requestedBy string, role Role, now time.Time) (*PriceChangeRequest, error) {
+	if err := validatePrice(newPrice); err != nil {
+		return nil, err
+	}
+	if !newPrice.SameCurrency(oldPrice) {
+		return nil, ErrCurrencyMismatch
+	}
+	r, err := newPriceChangeRequest(id, productID, kind, overrideMargin, requestedBy, role, now)
+	if err != nil {
+		return nil, err
+	}
+	r.variantID, r.channel = variantID, channel
+	r.oldPrice, r.newPrice = oldPrice, newPrice
+	r.events = append(r.events, r.requestedEvent())
+	return r, nil
+}
+
+// NewDiscountChangeRequest holds back applying a discount to the product.
+// requestedBy must identify the requester, who may not approve the change.
+func NewDiscountChangeRequest(id, productID string, discount *Discount, overrideMargin bool, requestedBy string, role Role, now time.Time) (*PriceChangeRequest, error) {
+	if discount == nil {
+		return nil, ErrInvalidDiscountPercentage
+	}
+	r, err := newPriceChangeRequest(id, productID, PriceChangeKindDiscount, overrideMargin, requestedBy, role, now)
+	if err != nil {
+		return nil, err
+	}
+	r.discount = discount
+	r.events = append(r.events, r.requestedEvent())
+	return r, nil
+}
+
+// newPriceChangeRequest starts a pending request. Without a requester the
+// self-approval check could not tell the requester from an approver.
+func newPriceChangeRequest(id, productID string, kind PriceChangeKind, overrideMargin bool, requestedBy string, role Role, now time.Time) (*PriceChangeRequest, error) {
+	requestedBy = strings.TrimSpace(requestedBy)
+	if requestedBy == "" {
+		return nil, ErrRequesterRequired
+	}
+	return &PriceChangeRequest{
+		id:             id,
+		productID:      productID,
+		kind:           kind,
+		overrideMargin: overrideMargin,
+		requestedBy:    requestedBy,
+		requestedRole:  role,
+		status:         PriceChangeRequestPending,
+		createdAt:      now,
+		changes:        NewChangeTracker(),
+		events:         make([]DomainEvent, 0),
+	}, nil
+}
+
+// ReconstructPriceChangeRequest reconstructs a PriceChangeRequest from persisted state.
+// oldPrice and newPrice are set for price changes, discount for discounts; variantID
+// and channel name the variant or channel of variant and channel price changes.
+func ReconstructPriceChangeRequest(id, productID string, kind PriceChangeKind, variantID, channel string, oldPrice, newPrice *Money, discount *Discount,
+	overrideMargin bool, requestedBy string, requestedRole Role, status PriceChangeRequestStatus,
+	decidedBy, reason string, createdAt time.Time, decidedAt *time.Time) *PriceChangeRequest {
+	return &PriceChangeRequest{
+		id:             id,
+		productID:      productID,
+		kind:           kind,
+		variantID:      variantID,
+		channel:        channel,
+		oldPrice:       oldPrice,
+		newPrice:       newPrice,
+		discount:       discount,
+		overrideMargin: overrideMargin,
+		requestedBy:    requestedBy,
+		requestedRole:  requestedRole,
+		status:         status,
+		decidedBy:      decidedBy,
+		reason:         reason,
+		createdAt:      createdAt,
+		decidedAt:      decidedAt,
+		changes:        NewChangeTracker(),
+		events:         make([]DomainEvent, 0),
+	}
+}
+
+// Getters
+
+func (r *PriceChangeRequest) ID() string {
+	return r.id
+}
+
+func (r *PriceChangeRequest) ProductID() string {
+	return r.productID
+}
+
+func (r *PriceChangeRequest) Kind() PriceChangeKind {
+	return r.kind
+}
+
+// VariantID returns the variant whose own price a variant price change sets.
+func (r *PriceChangeRequest) VariantID() string {
+	return r.variantID
+}
+
+// Channel returns the sales channel whose price a channel price change sets.
+func (r *PriceChangeRequest) Channel() string {
+	return r.channel
+}
+
+func (r *PriceChangeRequest) OldPrice() *Money {
+	return r.oldPrice
+}
+
+func (r *PriceChangeRequest) NewPrice() *Money {
+	return r.newPrice
+}
+
+func (r *PriceChangeRequest) Discount() *Discount {
+	return r.discount
+}
+
+func (r *PriceChangeRequest) OverrideMargin() bool {
+	return r.overrideMargin
+}
+
+func (r *PriceChangeRequest) RequestedBy() string {
+	return r.requestedBy
+}
+
+func (r *PriceChangeRequest) RequestedRole() Role {
+	return r.requestedRole
+}
+
+func (r *PriceChangeRequest) Status() PriceChangeRequestStatus {
+	return r.status
+}
+
+func (r *PriceChangeRequest) DecidedBy() string {
+	return r.decidedBy
+}
+
+func (r *PriceChangeRequest) Reason() string {
+	return r.reason
+}
+
+func (r *PriceChangeRequest) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *PriceChangeRequest) DecidedAt() *time.Time {
+	return r.decidedAt
+}
+
+func (r *PriceChangeRequest) Changes() *ChangeTracker {
+	return r.changes
+}
+
+func (r *PriceChangeRequest) DomainEvents() []DomainEvent {
+	return r.events
+}
+
+// Approve records the approval. The approver must be a second actor allowed to
+// approve price changes. The caller then applies the change to the product with
+// ApplyTo in the same commit.
+func (r *PriceChangeRequest) Approve(approver string, role Role, now time.Time) error {
+	if err := r.checkDecider(approver); err != nil {
+		return err
+	}
+	if approver == r.requestedBy {
+		return ErrSelfApprovalNotAllowed
+	}
+	if !role.CanApprovePriceChanges() {
+		return ErrApprovalNotAllowed
+	}
+
+	r.decide(PriceChangeRequestApproved, approver, "", now)
+	r.events = append(r.events, &PriceChangeApprovedEvent{
+		RequestID:  r.id,
+		ProductID:  r.productID,
+		ApprovedBy: approver,
+		ApprovedAt: now,
+	})
+	return nil
+}
+
+// Reject records the rejection. The requester may withdraw their own request;
+// anyone else needs a role allowed to approve price changes.
+func (r *PriceChangeRequest) Reject(decider string, role Role, reason string, now time.Time) error {
+	if err := r.checkDecider(decider); err != nil {
+		return err
+	}
+	if decider != r.requestedBy && !role.CanApprovePriceChanges() {
+		return ErrApprovalNotAllowed
+	}
+	reason = strings.TrimSpace(reason)
+	if len(reason) > maxDecisionReasonLength {
+		return ErrDecisionReasonTooLong
+	}
+
+	r.decide(PriceChangeRequestRejected, decider, reason, now)
+	r.events = append(r.events, &PriceChangeRejectedEvent{
+		RequestID:  r.id,
+		ProductID:  r.productID,
+		RejectedBy: decider,
+		Reason:     reason,
+		RejectedAt: now,
+	})
+	return nil
+}
+
+// ApplyTo applies the approved change to the product, re-checking it against the
+// product's current state. A price that moved or was removed since the request fails
+// with ErrPriceChangeRequestStale.
+func (r *PriceChangeRequest) ApplyTo(p *Product, now time.Time, opts ...PriceChangeOption) error {
+	if r.status != PriceChangeRequestApproved {
+		return ErrPriceChangeRequestNotPending
+	}
+	if p.ID() != r.productID {
+		return ErrProductNotFound
+	}
+	switch r.kind {
+	case PriceChangeKindBasePrice:
+		current, ok := p.PriceIn(r.newPrice.Currency())
+		if !ok || !current.Equals(r.oldPrice) {
+			return ErrPriceChangeRequestStale
+		}
+		return p.SetCurrencyPrice(r.newPrice, now, opts...)
+	case PriceChangeKindVariantPrice:
+		current, ok := p.VariantPrice(r.variantID)
+		if !ok || !current.Equals(r.oldPrice) {
+			return ErrPriceChangeRequestStale
+		}
+		return p.UpdateVariant(r.variantID, VariantUpdate{Price: r.newPrice}, now, opts...)
+	case PriceChangeKindChannelPrice:
+		if !p.ChannelPrice(r.channel).Equals(r.oldPrice) {
+			return ErrPriceChangeRequestStale
+		}
+		return p.SetChannelPrice(r.channel, r.newPrice, now, opts...)
+	case PriceChangeKindDiscount:
+		return p.ApplyDiscount(r.discount, now, opts...)
+	}
+	return ErrPriceChangeRequestNotFound
+}
+
+func (r *PriceChangeRequest) checkDecider(decider string) error {
+	if r.status != PriceChangeRequestPending {
+		return ErrPriceChangeRequestNotPending
+	}
+	if strings.TrimSpace(decider) == "" {
+		return ErrDeciderRequired
+	}
+	return nil
+}
+
+func (r *PriceChangeRequest) decide(status PriceChangeRequestStatus, decider, reason string, now time.Time) {
+	r.status = status
+	r.decidedBy = decider
+	r.reason = reason
+	r.decidedAt = &now
+	r.changes.MarkDirty(FieldChangeRequestDecision)
+}
+
+func (r *PriceChangeRequest) requestedEvent() *PriceChangeRequestedEvent {
+	return &PriceChangeRequestedEvent{
+		RequestID:   r.id,
+		ProductID:   r.productID,
+		Kind:        r.kind,
+		VariantID:   r.variantID,
+		Channel:     r.channel,
+		OldPrice:    r.oldPrice,
+		NewPrice:    r.newPrice,
+		Discount:    r.discount,
+		RequestedBy: r.requestedBy,
+		RequestedAt: r.createdAt,
+	}
+}
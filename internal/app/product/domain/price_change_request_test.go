@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseApprovalPolicy(t *testing.T) {
+	p, err := ParseApprovalPolicy("price_drop=0.3; price_increase=1 ;discount=0.5")
+	require.NoError(t, err)
+
+	assert.False(t, p.PriceChangeNeedsApproval(NewMoney(100, 1), NewMoney(70, 1)))
+	assert.True(t, p.PriceChangeNeedsApproval(NewMoney(100, 1), NewMoney(6999, 100)))
+	assert.False(t, p.PriceChangeNeedsApproval(NewMoney(100, 1), NewMoney(200, 1)))
+	assert.True(t, p.PriceChangeNeedsApproval(NewMoney(100, 1), NewMoney(201, 1)))
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	half, err := NewDiscountFromRat(big.NewRat(1, 2), now, now.Add(time.Hour))
+	require.NoError(t, err)
+	deep, err := NewDiscountFromRat(big.NewRat(51, 100), now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, p.DiscountNeedsApproval(half))
+	assert.True(t, p.DiscountNeedsApproval(deep))
+
+	for _, spec := range []string{"price_drop", "price_drop=0", "price_drop=1.5", "discount=-0.1", "margin=0.1"} {
+		_, err := ParseApprovalPolicy(spec)
+		assert.ErrorIs(t, err, ErrInvalidApprovalRule, spec)
+	}
+
+	// Thresholds that are not configured, and a nil policy, never require approval.
+	empty, err := ParseApprovalPolicy("")
+	require.NoError(t, err)
+	assert.False(t, empty.PriceChangeNeedsApproval(NewMoney(100, 1), NewMoney(1, 1)))
+	var none *ApprovalPolicy
+	assert.False(t, none.DiscountNeedsApproval(deep))
+}
+
+func TestApprovePriceChangeRequest(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(100, 1), now)
+	require.NoError(t, err)
+	p.Changes().Clear()
+	p.ClearEvents()
+
+	_, err = NewBasePriceChangeRequest("req-1", "prod-1", NewMoney(100, 1), NewMoney(10, 1), false, " ", RoleEditor, now)
+	assert.ErrorIs(t, err, ErrRequesterRequired)
+
+	r, err := NewBasePriceChangeRequest("req-1", "prod-1", NewMoney(100, 1), NewMoney(10, 1), false, "alice", RoleEditor, now)
+	require.NoError(t, err)
+	require.Len(t, r.DomainEvents(), 1)
+	assert.IsType(t, &PriceChangeRequestedEvent{}, r.DomainEvents()[0])
+	assert.Equal(t, PriceChangeRequestPending, r.Status())
+
+	assert.ErrorIs(t, r.Approve("", RoleAdmin, now), ErrDeciderRequired)
+	assert.ErrorIs(t, r.Approve("alice", RoleAdmin, now), ErrSelfApprovalNotAllowed)
+	assert.ErrorIs(t, r.Approve("bob", RoleEditor, now), ErrApprovalNotAllowed)
+
+	require.NoError(t, r.Approve("bob", RolePricingManager, now))
+	assert.Equal(t, PriceChangeRequestApproved, r.Status())
+	assert.Equal(t, "bob", r.DecidedBy())
+	assert.True(t, r.Changes().Dirty(FieldChangeRequestDecision))
+	require.NoError(t, r.ApplyTo(p, now))
+	assert.True(t, p.BasePrice().Equals(NewMoney(10, 1)))
+
+	assert.ErrorIs(t, r.Approve("carol", RoleAdmin, now), ErrPriceChangeRequestNotPending)
+	assert.ErrorIs(t, r.Reject("carol", RoleAdmin, "", now), ErrPriceChangeRequestNotPending)
+}
+
+func TestApprovedPriceChangeRequestIsStale(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(100, 1), now)
+	require.NoError(t, err)
+
+	r, err := NewBasePriceChangeRequest("req-1", "prod-1", NewMoney(90, 1), NewMoney(10, 1), false, "alice", RoleEditor, now)
+	require.NoError(t, err)
+	require.NoError(t, r.Approve("bob", RoleAdmin, now))
+	assert.ErrorIs(t, r.ApplyTo(p, now), ErrPriceChangeRequestStale)
+}
+
+func TestApprovePriceChangeRequestInOtherCurrency(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(100, 1), now)
+	require.NoError(t, err)
+	require.NoError(t, p.SetCurrencyPrice(NewMoneyIn("EUR", 90, 1), now))
+
+	r, err := NewBasePriceChangeRequest("req-1", "prod-1", NewMoneyIn("EUR", 90, 1), NewMoneyIn("EUR", 20, 1), false, "alice", RoleEditor, now)
+	require.NoError(t, err)
+	require.NoError(t, r.Approve("bob", RoleAdmin, now))
+	require.NoError(t, r.ApplyTo(p, now))
+
+	eur, ok := p.PriceIn("EUR")
+	require.True(t, ok)
+	assert.True(t, eur.Equals(NewMoneyIn("EUR", 20, 1)))
+	assert.True(t, p.BasePrice().Equals(NewMoney(100, 1)))
+
+	// A price that was removed since the request cannot be approved.
+	r, err = NewBasePriceChangeRequest("req-2", "prod-1", NewMoneyIn("GBP", 80, 1), NewMoneyIn("GBP", 20, 1), false, "alice", RoleEditor, now)
+	require.NoError(t, err)
+	require.NoError(t, r.Approve("bob", RoleAdmin, now))
+	assert.ErrorIs(t, r.ApplyTo(p, now), ErrPriceChangeRequestStale)
+}
+
+func TestApproveVariantAndChannelPriceChangeRequests(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "T-Shirt", "", testCategory("apparel"), NewMoney(100, 1), now)
+	require.NoError(t, err)
+	_, err = p.AddVariant("var-1", "TEE-M", map[string]string{"size": "M"}, nil, now)
+	require.NoError(t, err)
+
+	// A variant inheriting the base price is requested from the base price.
+	current, ok := p.VariantPrice("var-1")
+	require.True(t, ok)
+	r, err := NewVariantPriceChangeRequest("req-1", "prod-1", "var-1", current, NewMoney(20, 1), false, "alice", RoleEditor, now)
+	require.NoError(t, err)
+	assert.Equal(t, "var-1", r.VariantID())
+	require.NoError(t, r.Approve("bob", RoleAdmin, now))
+	require.NoError(t, r.ApplyTo(p, now))
+	v, _ := p.Variant("var-1")
+	assert.True(t, v.Price().Equals(NewMoney(20, 1)))
+	assert.True(t, p.Changes().Dirty(VariantField("var-1")))
+
+	_, err = NewChannelPriceChangeRequest("req-2", "prod-1", "not a channel!", p.BasePrice(), NewMoney(20, 1), false, "alice", RoleEditor, now)
+	assert.ErrorIs(t, err, ErrInvalidChannel)
+	r, err = NewChannelPriceChangeRequest("req-2", "prod-1", " Web ", p.ChannelPrice("web"), NewMoney(30, 1), false, "alice", RoleEditor, now)
+	require.NoError(t, err)
+	assert.Equal(t, "web", r.Channel())
+	require.NoError(t, r.Approve("bob", RoleAdmin, now))
+
+	// The base price moved since the channel price was requested.
+	require.NoError(t, p.UpdatePrice(NewMoney(110, 1), now))
+	assert.ErrorIs(t, r.ApplyTo(p, now), ErrPriceChangeRequestStale)
+
+	r, err = NewChannelPriceChangeRequest("req-3", "prod-1", "web", p.ChannelPrice("web"), NewMoney(30, 1), false, "alice", RoleEditor, now)
+	require.NoError(t, err)
+	require.NoError(t, r.Approve("bob", RoleAdmin, now))
+	require.NoError(t, r.ApplyTo(p, now))
+	assert.True(t, p.ChannelPrice("web").Equals(NewMoney(30, 1)))
+}
+
+func TestRejectPriceChangeRequest(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	d, err := NewDiscountFromRat(big.NewRat(9, 10), now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = NewDiscountChangeRequest("req-1", "prod-1", d, false, "", RoleEditor, now)
+	assert.ErrorIs(t, err, ErrRequesterRequired)
+
+	r, err := NewDiscountChangeRequest("req-1", "prod-1", d, false, "alice", RoleEditor, now)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, r.Reject("bob", RoleEditor, "", now), ErrApprovalNotAllowed)
+	assert.ErrorIs(t, r.Reject("bob", RoleAdmin, string(make([]byte, 1001)), now), ErrDecisionReasonTooLong)
+
+	// The requester may withdraw their own request.
+	require.NoError(t, r.Reject("alice", RoleEditor, " typo ", now))
+	assert.Equal(t, PriceChangeRequestRejected, r.Status())
+	assert.Equal(t, "typo", r.Reason())
+	require.Len(t, r.DomainEvents(), 2)
+	assert.IsType(t, &PriceChangeRejectedEvent{}, r.DomainEvents()[1])
+}
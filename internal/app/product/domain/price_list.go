@@ -0,0 +1,290 @@
+package domain
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Field constants for price list change tracking
+const (
+	FieldPriceListName        = "name"
+	FieldPriceListDescription = "description"
+)
+
+// segmentPattern restricts segment codes to lowercase slugs (e.g. "wholesale", "employee-eu").
+var segmentPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// PriceList is the aggregate root for customer-segment pricing.
+// A price list is identified by a segment code (e.g. "wholesale") and holds
+// per-product entries that override or adjust the product's base price.
+type PriceList struct {
+	id          string
+	segment     string
+	name        string
+	description string
+	createdAt   time.Time
+	updatedAt   time.Time
+	changes     *ChangeTracker
+	events      []DomainEvent
+}
+
+// NewPriceList creates a new PriceList for the given segment.
+func NewPriceList(id, segment, name, description string, now time.Time) (*PriceList, error) {
+	normalized, err := NormalizeSegment(segment)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePriceListName(name); err != nil {
+		return nil, err
+	}
+
+	pl := &PriceList{
+		id:          id,
+		segment:     normalized,
+		name:        strings.TrimSpace(name),
+		description: strings.TrimSpace(description),
+		createdAt:   now,
+		updatedAt:   now,
+		changes:     NewChangeTracker(),
+		events:      make([]DomainEvent, 0),
+	}
+
+	pl.events = append(pl.events, &PriceListCreatedEvent{
+		PriceListID: pl.id,
+		Segment:     pl.segment,
+		Name:        pl.name,
+		CreatedAt:   now,
+	})
+
+	return pl, nil
+}
+
+// ReconstructPriceList reconstructs a PriceList from persisted state.
+func ReconstructPriceList(id, segment, name, description string, createdAt, updatedAt time.Time) *PriceList {
+	return &PriceList{
+		id:          id,
+		segment:     segment,
+		name:        name,
+		description: description,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		changes:     NewChangeTracker(),
+		events:      make([]DomainEvent, 0),
+	}
+}
+
+// Getters
+
+func (pl *PriceList) ID() string {
+	return pl.id
+}
+
+func (pl *PriceList) Segment() string {
+	return pl.segment
+}
+
+func (pl *PriceList) Name() string {
+	return pl.name
+}
+
+func (pl *PriceList) Description() string {
+	return pl.description
+}
+
+func (pl *PriceList) CreatedAt() time.Time {
+	return pl.createdAt
+}
+
+func (pl *PriceList) UpdatedAt() time.Time {
+	return pl.updatedAt
+}
+
+func (pl *PriceList) Changes() *ChangeTracker {
+	return pl.changes
+}
+
+func (pl *PriceList) DomainEvents() []DomainEvent {
+	return pl.events
+}
+
+// Business Methods
+
+// UpdateDetails updates the price list name and/or description.
+// Only updates fields that are provided (non-empty). The segment code is immutable.
+func (pl *PriceList) UpdateDetails(name, description string, now time.Time) error {
+	changes := make(map[string]interface{})
+
+	if name != "" {
+		if err := validatePriceListName(name); err != nil {
+			return err
+		}
+		trimmed := strings.TrimSpace(name)
+		if trimmed != pl.name {
+			pl.name = trimmed
+			pl.changes.MarkDirty(FieldPriceListName)
+			changes["name"] = pl.name
+		}
+	}
+
+	if description != "" {
+		trimmed := strings.TrimSpace(description)
+		if trimmed != pl.description {
+			pl.description = trimmed
+			pl.changes.MarkDirty(FieldPriceListDescription)
+			changes["description"] = pl.description
+		}
+	}
+
+	if len(changes) > 0 {
+		pl.updatedAt = now
+		pl.events = append(pl.events, &PriceListUpdatedEvent{
+			PriceListID: pl.id,
+			UpdatedAt:   now,
+			Changes:     changes,
+		})
+	}
+
+	return nil
+}
+
+// Delete marks the price list as deleted. Entries are removed together with the list.
+func (pl *PriceList) Delete(now time.Time) {
+	pl.updatedAt = now
+	pl.events = append(pl.events, &PriceListDeletedEvent{
+		PriceListID: pl.id,
+		Segment:     pl.segment,
+		DeletedAt:   now,
+	})
+}
+
+// SetEntry records a price override or adjustment for a product in this list.
+// The entry replaces any previous entry for the same product.
+func (pl *PriceList) SetEntry(entry *PriceListEntry, now time.Time) error {
+	if entry == nil {
+		return ErrInvalidPriceListEntry
+	}
+
+	pl.updatedAt = now
+	pl.events = append(pl.events, &PriceListEntrySetEvent{
+		PriceListID: pl.id,
+		Segment:     pl.segment,
+		ProductID:   entry.ProductID(),
+		Override:    entry.Override(),
+		Adjustment:  entry.Adjustment(),
+		SetAt:       now,
+	})
+
+	return nil
+}
+
+// RemoveEntry removes the entry for a product from this list.
+func (pl *PriceList) RemoveEntry(productID string, now time.Time) error {
+	if strings.TrimSpace(productID) == "" {
+		return ErrInvalidPriceListEntry
+	}
+
+	pl.updatedAt = now
+	pl.events = append(pl.events, &PriceListEntryRemovedEvent{
+		PriceListID: pl.id,
+		Segment:     pl.segment,
+		ProductID:   productID,
+		RemovedAt:   now,
+	})
+
+	return nil
+}
+
+// ClearEvents clears the accumulated domain events.
+func (pl *PriceList) ClearEvents() {
+	pl.events = make([]DomainEvent, 0)
+}
+
+// PriceListEntry is a value object describing how a price list prices one product.
+// Exactly one of override (absolute price) or adjustment (fraction of the base
+// price, e.g. -0.15 for 15% off, 0.05 for a 5% markup) is set.
+type PriceListEntry struct {
+	productID  string
+	override   *Money
+	adjustment *big.Rat
+}
+
+// NewPriceListOverride creates an entry that replaces the product's base price.
+func NewPriceListOverride(productID string, price *Money) (*PriceListEntry, error) {
+	if strings.TrimSpace(productID) == "" {
+		return nil, ErrInvalidPriceListEntry
+	}
+	if err := validatePrice(price); err != nil {
+		return nil, err
+	}
+	return &PriceListEntry{productID: productID, override: price}, nil
+}
+
+// NewPriceListAdjustment creates an entry that adjusts the product's base price
+// by the given fraction. The adjusted price must stay positive, so the fraction
+// must be greater than -1.
+func NewPriceListAdjustment(productID string, fraction *big.Rat) (*PriceListEntry, error) {
+	if strings.TrimSpace(productID) == "" {
+		return nil, ErrInvalidPriceListEntry
+	}
+	if fraction == nil || fraction.Cmp(big.NewRat(-1, 1)) <= 0 {
+		return nil, ErrInvalidPriceAdjustment
+	}
+	return &PriceListEntry{productID: productID, adjustment: new(big.Rat).Set(fraction)}, nil
+}
+
+// ProductID returns the product this entry applies to.
+func (e *PriceListEntry) ProductID() string {
+	return e.productID
+}
+
+// Override returns the absolute override price, or nil for adjustment entries.
+func (e *PriceListEntry) Override() *Money {
+	return e.override
+}
+
+// Adjustment returns a copy of the adjustment fraction, or nil for override entries.
+func (e *PriceListEntry) Adjustment() *big.Rat {
+	if e.adjustment == nil {
+		return nil
+	}
+	return new(big.Rat).Set(e.adjustment)
+}
+
+// ApplyTo resolves the segment price for the given base price.
+// Overrides only cover prices in their own currency; for a base price in another
+// currency the base price is returned unchanged. Adjustments apply to any currency.
+func (e *PriceListEntry) ApplyTo(basePrice *Money) *Money {
+	if e.override != nil {
+		if !e.override.SameCurrency(basePrice) {
+			return basePrice
+		}
+		return e.override
+	}
+	factor := new(big.Rat).Add(big.NewRat(1, 1), e.adjustment)
+	return basePrice.MultiplyByRat(factor)
+}
+
+// NormalizeSegment trims and lowercases a segment code and validates its format.
+func NormalizeSegment(segment string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(segment))
+	if normalized == "" {
+		return "", ErrEmptyPriceListSegment
+	}
+	if !segmentPattern.MatchString(normalized) {
+		return "", ErrInvalidPriceListSegment
+	}
+	return normalized, nil
+}
+
+func validatePriceListName(name string) error {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		return ErrEmptyPriceListName
+	}
+	if len(trimmed) > 255 {
+		return ErrPriceListNameTooLong
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSegment(t *testing.T) {
+	s, err := NormalizeSegment("  Employee-EU ")
+	require.NoError(t, err)
+	assert.Equal(t, "employee-eu", s)
+
+	_, err = NormalizeSegment("   ")
+	assert.ErrorIs(t, err, ErrEmptyPriceListSegment)
+	for _, segment := range []string{"-wholesale", "whole sale", "vip!", strings.Repeat("a", 51)} {
+		_, err := NormalizeSegment(segment)
+		assert.ErrorIs(t, err, ErrInvalidPriceListSegment, segment)
+	}
+}
+
+func TestNewPriceList(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := NewPriceList("pl-1", "wholesale", " ", "", now)
+	assert.ErrorIs(t, err, ErrEmptyPriceListName)
+	_, err = NewPriceList("pl-1", "wholesale", strings.Repeat("n", 256), "", now)
+	assert.ErrorIs(t, err, ErrPriceListNameTooLong)
+
+	pl, err := NewPriceList("pl-1", "Wholesale", " Wholesale ", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, "wholesale", pl.Segment())
+	assert.Equal(t, "Wholesale", pl.Name())
+	require.Len(t, pl.DomainEvents(), 1)
+	assert.Equal(t, "wholesale", pl.DomainEvents()[0].(*PriceListCreatedEvent).Segment)
+
+	// Unchanged details record nothing.
+	require.NoError(t, pl.UpdateDetails("Wholesale", "", now))
+	assert.Len(t, pl.DomainEvents(), 1)
+	require.NoError(t, pl.UpdateDetails("Trade", "B2B customers", now))
+	assert.True(t, pl.Changes().Dirty(FieldPriceListName))
+	assert.True(t, pl.Changes().Dirty(FieldPriceListDescription))
+	assert.Len(t, pl.DomainEvents(), 2)
+}
+
+func TestPriceListEntryValidation(t *testing.T) {
+	_, err := NewPriceListOverride(" ", NewMoney(10, 1))
+	assert.ErrorIs(t, err, ErrInvalidPriceListEntry)
+	_, err = NewPriceListOverride("prod-1", NewMoney(0, 1))
+	assert.ErrorIs(t, err, ErrZeroPrice)
+	_, err = NewPriceListOverride("prod-1", NewMoney(-5, 1))
+	assert.ErrorIs(t, err, ErrNegativePrice)
+
+	_, err = NewPriceListAdjustment("", big.NewRat(-1, 10))
+	assert.ErrorIs(t, err, ErrInvalidPriceListEntry)
+	for _, fraction := range []*big.Rat{nil, big.NewRat(-1, 1), big.NewRat(-3, 2)} {
+		_, err := NewPriceListAdjustment("prod-1", fraction)
+		assert.ErrorIs(t, err, ErrInvalidPriceAdjustment)
+	}
+
+	pl := ReconstructPriceList("pl-1", "wholesale", "Wholesale", "", time.Now(), time.Now())
+	assert.ErrorIs(t, pl.SetEntry(nil, time.Now()), ErrInvalidPriceListEntry)
+	assert.ErrorIs(t, pl.RemoveEntry(" ", time.Now()), ErrInvalidPriceListEntry)
+}
+
+func TestPriceListEntryApplyTo(t *testing.T) {
+	base := NewMoney(100, 1)
+
+	// An override replaces the base price in its own currency only.
+	override, err := NewPriceListOverride("prod-1", NewMoney(80, 1))
+	require.NoError(t, err)
+	assert.Nil(t, override.Adjustment())
+	assert.True(t, override.ApplyTo(base).Equals(NewMoney(80, 1)))
+	euros := NewMoneyIn("EUR", 95, 1)
+	assert.True(t, override.ApplyTo(euros).Equals(euros))
+
+	// An adjustment scales the base price in any currency.
+	markdown, err := NewPriceListAdjustment("prod-1", big.NewRat(-15, 100))
+	require.NoError(t, err)
+	assert.Nil(t, markdown.Override())
+	assert.True(t, markdown.ApplyTo(base).Equals(NewMoney(85, 1)))
+	assert.True(t, markdown.ApplyTo(euros).Equals(NewMoneyIn("EUR", 8075, 100)))
+	markup, err := NewPriceListAdjustment("prod-1", big.NewRat(5, 100))
+	require.NoError(t, err)
+	assert.True(t, markup.ApplyTo(base).Equals(NewMoney(105, 1)))
+
+	// The entry resolves the segment price before a product discount applies on top.
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	discount, err := NewDiscountFromRat(big.NewRat(1, 10), start, start.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, discount.ApplyTo(override.ApplyTo(base)).Equals(NewMoney(72, 1)))
+}
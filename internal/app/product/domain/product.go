@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"errors"
+	"math/big"
+	"sort"
 	"strings"
 	"time"
 )
@@ -11,11 +14,43 @@ const (
 	FieldDescription = "description"
 	FieldCategory    = "category"
 	FieldBasePrice   = "base_price"
-	FieldDiscount    = "discount"
-	FieldStatus      = "status"
-	FieldArchivedAt  = "archived_at"
+	FieldCostPrice   = "cost_price"
+	FieldTaxCategory = "tax_category"
+	FieldPackageSize = "package_size"
+	FieldSKU         = "sku"
+	FieldGTIN        = "gtin"
+	FieldSlug        = "slug"
+	// FieldDefaultLocale tracks a change of the locale of name and description.
+	FieldDefaultLocale = "default_locale"
+	FieldDiscount      = "discount"
+	FieldStatus        = "status"
+	FieldArchivedAt    = "archived_at"
+	// FieldBundle tracks a change of the product type, bundle pricing mode or bundle discount.
+	FieldBundle = "bundle"
+	// FieldRegions tracks a change of the allowed or blocked regions.
+	FieldRegions = "regions"
+	// FieldAvailability tracks a change of the availability window.
+	FieldAvailability = "availability"
 )
 
+// fieldCurrencyPricePrefix prefixes the dirty-field name of a per-currency price,
+// e.g. "currency_price:EUR". Use CurrencyPriceField to build it.
+const fieldCurrencyPricePrefix = "currency_price:"
+
+// CurrencyPriceField returns the change-tracking field name for the price in the given currency.
+func CurrencyPriceField(c Currency) string {
+	return fieldCurrencyPricePrefix + c.String()
+}
+
+// CurrencyFromPriceField returns the currency of a per-currency price field,
+// or false if the field is not a per-currency price field.
+func CurrencyFromPriceField(field string) (Currency, bool) {
+	if !strings.HasPrefix(field, fieldCurrencyPricePrefix) {
+		return "", false
+	}
+	return Currency(strings.TrimPrefix(field, fieldCurrencyPricePrefix)), true
+}
+
 // ProductStatus represents the lifecycle state of a product.
 type ProductStatus string
 
@@ -39,220 +74,2260 @@ type Product struct {
 	id          string
 	name        string
 	description string
+	// categoryID references the product's Category; category holds that category's slug.
+	categoryID  string
 	category    string
+	taxCategory TaxCategory
 	basePrice   *Money
-	discount    *Discount
-	status      ProductStatus
-	createdAt   time.Time
-	updatedAt   time.Time
-	archivedAt  *time.Time
-	changes     *ChangeTracker
-	events      []DomainEvent
-}
-
-// NewProduct creates a new Product with the given details.
-// The product starts in Draft status.
-func NewProduct(id, name, description, category string, basePrice *Money, now time.Time) (*Product, error) {
+	// currencyPrices holds base prices in currencies other than basePrice's currency.
+	currencyPrices map[Currency]*Money
+	// costPrice is optional; when set, price changes must keep the minimum margin over it.
+	costPrice *Money
+	// packageSize is optional; when set, read models quote a unit price (e.g. per kg).
+	packageSize *PackageSize
+	// scheduledPrices holds the loaded or newly scheduled base price changes by id.
+	scheduledPrices map[string]*ScheduledPriceChange
+	// variants holds the product's variants by id.
+	variants map[string]*Variant
+	// attributes holds the product's custom attribute values by name, valid for its category's schema.
+	attributes map[string]AttributeValue
+	// tags holds the product's normalized tags.
+	tags map[string]bool
+	// media holds the product's images, videos and documents by id.
+	media map[string]*Media
+	// relations holds the product's links to related products.
+	relations map[relationKey]*ProductRelation
+	// channels holds the product's sales channel assignments by channel.
+	channels map[string]*ChannelAssignment
+	// allowedRegions, when not empty, lists the only regions the product ships to;
+	// blockedRegions lists regions it never ships to.
+	allowedRegions map[Region]bool
+	blockedRegions map[Region]bool
+	// availableFrom and availableUntil bound when the product is on sale; the scheduler
+	// activates it at availableFrom and deactivates it at availableUntil. Nil is open.
+	availableFrom  *time.Time
+	availableUntil *time.Time
+	// productType says whether the product is a bundle; bundles hold their components
+	// by product ID and a discount fraction that only applies to components_sum pricing.
+	productType      ProductType
+	bundlePricing    BundlePricingMode
+	bundleDiscount   *big.Rat
+	bundleComponents map[string]*BundleComponent
+	// sku and gtin are optional external identifiers ("" when unset); gtin is a GTIN-14.
+	sku  string
+	gtin string
+	// slug is the product's unique URL slug, derived from its name; loadedSlug is the
+	// persisted slug, which moves to the slug history when the slug changes.
+	slug       string
+	loadedSlug string
+	// defaultLocale is the locale of name and description; translations holds them
+	// in other locales.
+	defaultLocale Locale
+	translations  map[Locale]Translation
+	discount      *Discount
+	status        ProductStatus
+	createdAt     time.Time
+	updatedAt     time.Time
+	archivedAt    *time.Time
+	changes       *ChangeTracker
+	events        []DomainEvent
+}
+
+// NewProduct creates a new Product in the given category.
+// The product starts in Draft status with DefaultTaxCategory; use SetTaxCategory to change it.
+func NewProduct(id, name, description string, category *Category, basePrice *Money, now time.Time) (*Product, error) {
 	// Validate inputs
 	if err := validateProductName(name); err != nil {
 		return nil, err
 	}
-	if err := validateProductCategory(category); err != nil {
+	if category == nil {
+		return nil, ErrEmptyProductCategory
+	}
+	if err := validatePrice(basePrice); err != nil {
+		return nil, err
+	}
+
+	p := &Product{
+		id:          id,
+		name:        strings.TrimSpace(name),
+		description: strings.TrimSpace(description),
+		categoryID:  category.ID(),
+		category:    category.Slug(),
+		taxCategory: DefaultTaxCategory,
+		slug:        ProductSlug(name),
+		basePrice:   basePrice,
+		status:      ProductStatusDraft,
+		createdAt:   now,
+		updatedAt:   now,
+		changes:     NewChangeTracker(),
+		events:      make([]DomainEvent, 0),
+
+		currencyPrices:  make(map[Currency]*Money),
+		scheduledPrices: make(map[string]*ScheduledPriceChange),
+		variants:        make(map[string]*Variant),
+		attributes:      make(map[string]AttributeValue),
+		tags:            make(map[string]bool),
+		media:           make(map[string]*Media),
+		relations:       make(map[relationKey]*ProductRelation),
+		channels:        make(map[string]*ChannelAssignment),
+		allowedRegions:  make(map[Region]bool),
+		blockedRegions:  make(map[Region]bool),
+		defaultLocale:   DefaultLocale,
+		translations:    make(map[Locale]Translation),
+
+		productType:      ProductTypeSimple,
+		bundleComponents: make(map[string]*BundleComponent),
+	}
+
+	// Capture creation event
+	p.events = append(p.events, &ProductCreatedEvent{
+		ProductID:   p.id,
+		Name:        p.name,
+		Category:    p.category,
+		CategoryID:  p.categoryID,
+		TaxCategory: p.taxCategory,
+		Slug:        p.slug,
+		BasePrice:   p.basePrice,
+		CreatedAt:   now,
+	})
+
+	return p, nil
+}
+
+// ReconstructOption sets optional persisted state when reconstructing a Product.
+type ReconstructOption func(p *Product)
+
+// WithCurrencyPrices restores base prices in additional currencies.
+func WithCurrencyPrices(prices ...*Money) ReconstructOption {
+	return func(p *Product) {
+		for _, price := range prices {
+			if price != nil && price.Currency() != p.basePrice.Currency() {
+				p.currencyPrices[price.Currency()] = price
+			}
+		}
+	}
+}
+
+// WithCategoryID restores the ID of the product's category.
+func WithCategoryID(id string) ReconstructOption {
+	return func(p *Product) {
+		p.categoryID = id
+	}
+}
+
+// WithCostPrice restores the product's cost price (nil means none).
+func WithCostPrice(cost *Money) ReconstructOption {
+	return func(p *Product) {
+		p.costPrice = cost
+	}
+}
+
+// WithTaxCategory restores the product's tax category; empty means DefaultTaxCategory.
+func WithTaxCategory(category TaxCategory) ReconstructOption {
+	return func(p *Product) {
+		if category != "" {
+			p.taxCategory = category
+		}
+	}
+}
+
+// WithIdentifiers restores the product's SKU and GTIN ("" means none).
+func WithIdentifiers(sku, gtin string) ReconstructOption {
+	return func(p *Product) {
+		p.sku = sku
+		p.gtin = gtin
+	}
+}
+
+// WithSlug restores the product's current URL slug.
+func WithSlug(slug string) ReconstructOption {
+	return func(p *Product) {
+		p.slug = slug
+		p.loadedSlug = slug
+	}
+}
+
+// WithLocalization restores the locale of the product's name and description and
+// their translations into other locales. An empty defaultLocale means DefaultLocale.
+func WithLocalization(defaultLocale Locale, translations map[Locale]Translation) ReconstructOption {
+	return func(p *Product) {
+		if defaultLocale != "" {
+			p.defaultLocale = defaultLocale
+		}
+		for l, t := range translations {
+			p.translations[l] = t
+		}
+	}
+}
+
+// WithPackageSize restores the product's package size (nil means none).
+func WithPackageSize(size *PackageSize) ReconstructOption {
+	return func(p *Product) {
+		p.packageSize = size
+	}
+}
+
+// WithScheduledPriceChanges restores scheduled base price changes.
+func WithScheduledPriceChanges(changes ...*ScheduledPriceChange) ReconstructOption {
+	return func(p *Product) {
+		for _, c := range changes {
+			if c != nil {
+				p.scheduledPrices[c.ID()] = c
+			}
+		}
+	}
+}
+
+// WithVariants restores the product's variants.
+func WithVariants(variants ...*Variant) ReconstructOption {
+	return func(p *Product) {
+		for _, v := range variants {
+			if v != nil {
+				p.variants[v.ID()] = v
+			}
+		}
+	}
+}
+
+// WithAttributes restores the product's custom attribute values.
+func WithAttributes(values map[string]AttributeValue) ReconstructOption {
+	return func(p *Product) {
+		for name, v := range values {
+			p.attributes[name] = v
+		}
+	}
+}
+
+// WithTags restores the product's tags, which are stored normalized.
+func WithTags(tags ...string) ReconstructOption {
+	return func(p *Product) {
+		for _, t := range tags {
+			p.tags[t] = true
+		}
+	}
+}
+
+// WithMedia restores the product's media entries.
+func WithMedia(media ...*Media) ReconstructOption {
+	return func(p *Product) {
+		for _, m := range media {
+			if m != nil {
+				p.media[m.ID()] = m
+			}
+		}
+	}
+}
+
+// WithRelations restores the product's links to related products.
+func WithRelations(relations ...*ProductRelation) ReconstructOption {
+	return func(p *Product) {
+		for _, r := range relations {
+			if r != nil {
+				p.relations[relationKey{r.relType, r.productID}] = r
+			}
+		}
+	}
+}
+
+// WithChannels restores the product's sales channel assignments.
+func WithChannels(channels ...*ChannelAssignment) ReconstructOption {
+	return func(p *Product) {
+		for _, c := range channels {
+			if c != nil {
+				p.channels[c.channel] = c
+			}
+		}
+	}
+}
+
+// WithAvailability restores the product's availability window.
+func WithAvailability(from, until *time.Time) ReconstructOption {
+	return func(p *Product) {
+		p.availableFrom, p.availableUntil = from, until
+	}
+}
+
+// WithRegions restores the product's allowed and blocked regions.
+func WithRegions(allowed, blocked []Region) ReconstructOption {
+	return func(p *Product) {
+		for _, r := range allowed {
+			p.allowedRegions[r] = true
+		}
+		for _, r := range blocked {
+			p.blockedRegions[r] = true
+		}
+	}
+}
+
+// WithBundle restores a bundle's pricing and components; a nil discount means none.
+func WithBundle(pricing BundlePricingMode, discount *big.Rat, components ...*BundleComponent) ReconstructOption {
+	return func(p *Product) {
+		p.productType = ProductTypeBundle
+		p.bundlePricing = pricing
+		p.bundleDiscount = discount
+		for _, c := range components {
+			if c != nil {
+				p.bundleComponents[c.productID] = c
+			}
+		}
+	}
+}
+
+// ReconstructProduct reconstructs a Product from persisted state.
+// Used by repositories when loading from the database.
+func ReconstructProduct(
+	id, name, description, category string,
+	basePrice *Money,
+	discount *Discount,
+	status ProductStatus,
+	createdAt, updatedAt time.Time,
+	archivedAt *time.Time,
+	opts ...ReconstructOption,
+) *Product {
+	p := &Product{
+		id:          id,
+		name:        name,
+		description: description,
+		category:    category,
+		taxCategory: DefaultTaxCategory,
+		basePrice:   basePrice,
+		discount:    discount,
+		status:      status,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		archivedAt:  archivedAt,
+		changes:     NewChangeTracker(),
+		events:      make([]DomainEvent, 0),
+
+		currencyPrices:  make(map[Currency]*Money),
+		scheduledPrices: make(map[string]*ScheduledPriceChange),
+		variants:        make(map[string]*Variant),
+		attributes:      make(map[string]AttributeValue),
+		tags:            make(map[string]bool),
+		media:           make(map[string]*Media),
+		relations:       make(map[relationKey]*ProductRelation),
+		channels:        make(map[string]*ChannelAssignment),
+		allowedRegions:  make(map[Region]bool),
+		blockedRegions:  make(map[Region]bool),
+		defaultLocale:   DefaultLocale,
+		translations:    make(map[Locale]Translation),
+
+		productType:      ProductTypeSimple,
+		bundleComponents: make(map[string]*BundleComponent),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Getters
+
+func (p *Product) ID() string {
+	return p.id
+}
+
+func (p *Product) Name() string {
+	return p.name
+}
+
+func (p *Product) Description() string {
+	return p.description
+}
+
+// Category returns the slug of the product's category.
+func (p *Product) Category() string {
+	return p.category
+}
+
+// CategoryID returns the ID of the product's category.
+func (p *Product) CategoryID() string {
+	return p.categoryID
+}
+
+func (p *Product) TaxCategory() TaxCategory {
+	return p.taxCategory
+}
+
+func (p *Product) BasePrice() *Money {
+	return p.basePrice
+}
+
+// Currency returns the currency of the product's primary base price.
+func (p *Product) Currency() Currency {
+	return p.basePrice.Currency()
+}
+
+// PriceIn returns the base price in the given currency, if the product is sold in it.
+func (p *Product) PriceIn(currency Currency) (*Money, bool) {
+	if currency == p.basePrice.Currency() {
+		return p.basePrice, true
+	}
+	price, ok := p.currencyPrices[currency]
+	return price, ok
+}
+
+// CurrencyPrices returns the base prices in additional currencies, ordered by currency code.
+func (p *Product) CurrencyPrices() []*Money {
+	out := make([]*Money, 0, len(p.currencyPrices))
+	for _, price := range p.currencyPrices {
+		out = append(out, price)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Currency() < out[j].Currency() })
+	return out
+}
+
+// ScheduledPriceChanges returns the loaded scheduled price changes ordered by effective time.
+func (p *Product) ScheduledPriceChanges() []*ScheduledPriceChange {
+	out := make([]*ScheduledPriceChange, 0, len(p.scheduledPrices))
+	for _, c := range p.scheduledPrices {
+		out = append(out, c)
+	}
+	sortScheduledPriceChanges(out)
+	return out
+}
+
+// ScheduledPriceChange returns the scheduled price change with the given id, if loaded.
+func (p *Product) ScheduledPriceChange(id string) (*ScheduledPriceChange, bool) {
+	c, ok := p.scheduledPrices[id]
+	return c, ok
+}
+
+// BasePriceAt returns the primary base price in effect at the given time,
+// honouring pending scheduled changes that are already due.
+func (p *Product) BasePriceAt(at time.Time) *Money {
+	return BasePriceAt(p.basePrice, p.ScheduledPriceChanges(), at)
+}
+
+// SKU returns the product's stock keeping unit, or "" when unset.
+func (p *Product) SKU() string {
+	return p.sku
+}
+
+// Slug returns the product's current URL slug.
+func (p *Product) Slug() string {
+	return p.slug
+}
+
+// PreviousSlug returns the slug the product was loaded with, "" for new products.
+// It joins the product's slug history when the slug changes.
+func (p *Product) PreviousSlug() string {
+	return p.loadedSlug
+}
+
+// DefaultLocale returns the locale of the product's name and description.
+func (p *Product) DefaultLocale() Locale {
+	return p.defaultLocale
+}
+
+// Translation returns the product's name and description in a locale other than its default locale.
+func (p *Product) Translation(l Locale) (Translation, bool) {
+	t, ok := p.translations[l]
+	return t, ok
+}
+
+// TranslationLocales returns the locales the product is translated into, sorted.
+func (p *Product) TranslationLocales() []Locale {
+	out := make([]Locale, 0, len(p.translations))
+	for l := range p.translations {
+		out = append(out, l)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// GTIN returns the product's GTIN-14 (e.g. an EAN-13 with a leading zero), or "" when unset.
+func (p *Product) GTIN() string {
+	return p.gtin
+}
+
+// Variants returns the product's variants ordered by SKU.
+func (p *Product) Variants() []*Variant {
+	out := make([]*Variant, 0, len(p.variants))
+	for _, v := range p.variants {
+		out = append(out, v)
+	}
+	sortVariants(out)
+	return out
+}
+
+// Variant returns the variant with the given id, if loaded.
+func (p *Product) Variant(id string) (*Variant, bool) {
+	v, ok := p.variants[id]
+	return v, ok
+}
+
+// Attributes returns the product's custom attribute values by name.
+func (p *Product) Attributes() map[string]string {
+	out := make(map[string]string, len(p.attributes))
+	for name, v := range p.attributes {
+		out[name] = v.String()
+	}
+	return out
+}
+
+// Tags returns the product's tags in alphabetical order.
+func (p *Product) Tags() []string {
+	out := make([]string, 0, len(p.tags))
+	for t := range p.tags {
+		out = append(out, t)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// HasTag reports whether the product carries the tag.
+func (p *Product) HasTag(tag string) bool {
+	return p.tags[tag]
+}
+
+// Media returns the product's media entries by ascending sort order.
+func (p *Product) Media() []*Media {
+	out := make([]*Media, 0, len(p.media))
+	for _, m := range p.media {
+		out = append(out, m)
+	}
+	sortMedia(out)
+	return out
+}
+
+// MediaByID returns the media entry with the given id, if loaded.
+func (p *Product) MediaByID(id string) (*Media, bool) {
+	m, ok := p.media[id]
+	return m, ok
+}
+
+// PrimaryMedia returns the product's primary image, or nil when it has none.
+func (p *Product) PrimaryMedia() *Media {
+	for _, m := range p.media {
+		if m.primary {
+			return m
+		}
+	}
+	return nil
+}
+
+// Relations returns the product's links to related products ordered by type, then
+// by the time they were added.
+func (p *Product) Relations() []*ProductRelation {
+	out := make([]*ProductRelation, 0, len(p.relations))
+	for _, r := range p.relations {
+		out = append(out, r)
+	}
+	sortRelations(out)
+	return out
+}
+
+// Relation returns the product's link of relType to the related product, if any.
+func (p *Product) Relation(relType RelationType, relatedID string) (*ProductRelation, bool) {
+	r, ok := p.relations[relationKey{relType, relatedID}]
+	return r, ok
+}
+
+// Channels returns the product's sales channel assignments ordered by channel.
+func (p *Product) Channels() []*ChannelAssignment {
+	out := make([]*ChannelAssignment, 0, len(p.channels))
+	for _, c := range p.channels {
+		out = append(out, c)
+	}
+	sortChannels(out)
+	return out
+}
+
+// VariantPrice returns the base price the variant sells at: its own, or the product's
+// it inherits. ok is false for an unknown variant.
+func (p *Product) VariantPrice(id string) (*Money, bool) {
+	v, ok := p.variants[id]
+	if !ok {
+		return nil, false
+	}
+	if v.price != nil {
+		return v.price, true
+	}
+	return p.basePrice, true
+}
+
+// ChannelPrice returns the base price the product sells at on the (normalized) channel:
+// the channel's own, or the product's base price.
+func (p *Product) ChannelPrice(channel string) *Money {
+	if c, ok := p.channels[channel]; ok && c.price != nil {
+		return c.price
+	}
+	return p.basePrice
+}
+
+// Channel returns the product's assignment to the channel, if any.
+func (p *Product) Channel(channel string) (*ChannelAssignment, bool) {
+	c, ok := p.channels[channel]
+	return c, ok
+}
+
+// AvailableFrom returns when the product launches, or nil when it is not scheduled to.
+func (p *Product) AvailableFrom() *time.Time {
+	return p.availableFrom
+}
+
+// AvailableUntil returns when the product is discontinued, or nil when it is not scheduled to be.
+func (p *Product) AvailableUntil() *time.Time {
+	return p.availableUntil
+}
+
+// OnSaleAt reports whether the product is on sale at the given time (see OnSaleAt).
+// A pending launch Activate would refuse for a missing region price is not.
+func (p *Product) OnSaleAt(at time.Time) bool {
+	if !OnSaleAt(p.status, p.availableFrom, p.availableUntil, at) {
+		return false
+	}
+	return p.status == ProductStatusActive || p.checkRegionPrices(p.allowedRegions) == nil
+}
+
+// AllowedRegions returns the only regions the product ships to, ordered by code;
+// empty means every region that is not blocked.
+func (p *Product) AllowedRegions() []Region {
+	return sortedRegions(p.allowedRegions)
+}
+
+// BlockedRegions returns the regions the product never ships to, ordered by code.
+func (p *Product) BlockedRegions() []Region {
+	return sortedRegions(p.blockedRegions)
+}
+
+// AvailableIn reports whether the product ships to the region.
+func (p *Product) AvailableIn(region Region) bool {
+	if p.blockedRegions[region] {
+		return false
+	}
+	return len(p.allowedRegions) == 0 || p.allowedRegions[region]
+}
+
+// Type returns whether the product is sold on its own or as a bundle.
+func (p *Product) Type() ProductType {
+	return p.productType
+}
+
+// IsBundle reports whether the product is a bundle of component products.
+func (p *Product) IsBundle() bool {
+	return p.productType == ProductTypeBundle
+}
+
+// BundlePricing returns how a bundle is priced, or "" for simple products.
+func (p *Product) BundlePricing() BundlePricingMode {
+	return p.bundlePricing
+}
+
+// BundleDiscount returns a copy of a components_sum bundle's discount as a fraction
+// (0.1 for 10% off), or nil when it has none.
+func (p *Product) BundleDiscount() *big.Rat {
+	if p.bundleDiscount == nil {
+		return nil
+	}
+	return new(big.Rat).Set(p.bundleDiscount)
+}
+
+// BundleComponents returns a bundle's components ordered by product ID.
+func (p *Product) BundleComponents() []*BundleComponent {
+	out := make([]*BundleComponent, 0, len(p.bundleComponents))
+	for _, c := range p.bundleComponents {
+		out = append(out, c)
+	}
+	sortBundleComponents(out)
+	return out
+}
+
+// BundleComponent returns the bundle's component with the given product ID, if any.
+func (p *Product) BundleComponent(productID string) (*BundleComponent, bool) {
+	c, ok := p.bundleComponents[productID]
+	return c, ok
+}
+
+// Attribute returns the value of the named custom attribute, if set.
+func (p *Product) Attribute(name string) (AttributeValue, bool) {
+	v, ok := p.attributes[name]
+	return v, ok
+}
+
+// CostPrice returns the product's cost in its primary currency, or nil if unknown.
+func (p *Product) CostPrice() *Money {
+	return p.costPrice
+}
+
+func (p *Product) PackageSize() *PackageSize {
+	return p.packageSize
+}
+
+func (p *Product) Discount() *Discount {
+	return p.discount
+}
+
+func (p *Product) Status() ProductStatus {
+	return p.status
+}
+
+func (p *Product) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Product) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+func (p *Product) ArchivedAt() *time.Time {
+	return p.archivedAt
+}
+
+func (p *Product) Changes() *ChangeTracker {
+	return p.changes
+}
+
+func (p *Product) DomainEvents() []DomainEvent {
+	return p.events
+}
+
+// Business Methods
+
+// UpdateDetails updates the product's name, description, and/or category.
+// Only updates fields that are provided (non-empty name and description, non-nil category).
+func (p *Product) UpdateDetails(name, description string, category *Category, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+
+	changes := make(map[string]interface{})
+
+	// Update name if provided
+	if name != "" {
+		if err := validateProductName(name); err != nil {
+			return err
+		}
+		trimmedName := strings.TrimSpace(name)
+		if trimmedName != p.name {
+			p.name = trimmedName
+			p.changes.MarkDirty(FieldName)
+			changes["name"] = p.name
+			p.renameSlug(changes)
+		}
+	}
+
+	// Update description if provided
+	if description != "" {
+		trimmedDesc := strings.TrimSpace(description)
+		if trimmedDesc != p.description {
+			p.description = trimmedDesc
+			p.changes.MarkDirty(FieldDescription)
+			changes["description"] = p.description
+		}
+	}
+
+	// Update category if provided
+	if category != nil && category.ID() != p.categoryID {
+		p.categoryID = category.ID()
+		p.category = category.Slug()
+		p.changes.MarkDirty(FieldCategory)
+		changes["category"] = p.category
+		changes["category_id"] = p.categoryID
+	}
+
+	// Only emit event if something changed
+	if len(changes) > 0 {
+		p.updatedAt = now
+		ev := &ProductUpdatedEvent{
+			ProductID: p.id,
+			UpdatedAt: now,
+			Changes:   changes,
+		}
+		_, renamed := changes["name"]
+		if _, described := changes["description"]; renamed || described {
+			ev.Locale = p.defaultLocale
+		}
+		p.events = append(p.events, ev)
+	}
+
+	return nil
+}
+
+// SetTranslation sets the product's name and description in a locale other than its
+// default locale; UpdateDetails changes the default locale's texts.
+func (p *Product) SetTranslation(locale Locale, name, description string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if locale == p.defaultLocale {
+		return ErrDefaultLocaleTranslation
+	}
+	t, err := NewTranslation(name, description)
+	if err != nil {
+		return err
+	}
+
+	old, existed := p.translations[locale]
+	changes := make(map[string]interface{})
+	if !existed || t.name != old.name {
+		changes["name"] = t.name
+	}
+	if !existed || t.description != old.description {
+		changes["description"] = optionalString(t.description)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	p.translations[locale] = t
+	p.changes.MarkDirty(TranslationField(locale))
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		Locale:    locale,
+		UpdatedAt: now,
+		Changes:   changes,
+	})
+
+	return nil
+}
+
+// RemoveTranslation removes the product's name and description in a locale; readers
+// preferring it fall back to other locales. Removing a missing translation changes nothing.
+func (p *Product) RemoveTranslation(locale Locale, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if _, ok := p.translations[locale]; !ok {
+		return nil
+	}
+
+	delete(p.translations, locale)
+	p.changes.MarkDirty(TranslationField(locale))
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		Locale:    locale,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"name": nil, "description": nil},
+	})
+
+	return nil
+}
+
+// SetDefaultLocale changes the locale of the product's name and description. When
+// the product has a translation into the new default locale, the two swap places:
+// the translation becomes the name and description, which are kept as the
+// translation into the former default locale. Otherwise the name and description
+// are simply declared to be in the new locale.
+func (p *Product) SetDefaultLocale(locale Locale, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if locale == p.defaultLocale {
+		return nil
+	}
+
+	changes := map[string]interface{}{"default_locale": locale.String()}
+	if t, ok := p.translations[locale]; ok {
+		p.translations[p.defaultLocale] = Translation{name: p.name, description: p.description}
+		p.changes.MarkDirty(TranslationField(p.defaultLocale))
+		delete(p.translations, locale)
+		p.changes.MarkDirty(TranslationField(locale))
+
+		if t.name != p.name {
+			p.name = t.name
+			p.changes.MarkDirty(FieldName)
+			changes["name"] = p.name
+			p.renameSlug(changes)
+		}
+		if t.description != p.description {
+			p.description = t.description
+			p.changes.MarkDirty(FieldDescription)
+			changes["description"] = optionalString(p.description)
+		}
+	}
+
+	p.defaultLocale = locale
+	p.changes.MarkDirty(FieldDefaultLocale)
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		Locale:    locale,
+		UpdatedAt: now,
+		Changes:   changes,
+	})
+
+	return nil
+}
+
+// SetTaxCategory changes the tax category used to compute gross prices.
+func (p *Product) SetTaxCategory(category TaxCategory, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if !taxCategoryPattern.MatchString(string(category)) {
+		return ErrInvalidTaxCategory
+	}
+	if category == p.taxCategory {
+		return nil
+	}
+
+	p.taxCategory = category
+	p.changes.MarkDirty(FieldTaxCategory)
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"tax_category": category.String()},
+	})
+
+	return nil
+}
+
+// SetSKU sets the product's SKU after normalizing it with NormalizeSKU; "" clears it.
+// Product and variant SKUs share one namespace, so the SKU cannot be one of the
+// product's own variant SKUs; uniqueness across products is up to the caller.
+func (p *Product) SetSKU(sku string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if strings.TrimSpace(sku) != "" {
+		normalized, err := NormalizeSKU(sku)
+		if err != nil {
+			return err
+		}
+		for _, v := range p.variants {
+			if v.SKU() == normalized {
+				return ErrDuplicateSKU
+			}
+		}
+		sku = normalized
+	} else {
+		sku = ""
+	}
+	if sku == p.sku {
+		return nil
+	}
+
+	p.sku = sku
+	p.changes.MarkDirty(FieldSKU)
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"sku": optionalString(sku)},
+	})
+
+	return nil
+}
+
+// SetGTIN sets the product's GTIN after validating and normalizing it with
+// NormalizeGTIN; "" clears it.
+func (p *Product) SetGTIN(gtin string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if strings.TrimSpace(gtin) != "" {
+		normalized, err := NormalizeGTIN(gtin)
+		if err != nil {
+			return err
+		}
+		gtin = normalized
+	} else {
+		gtin = ""
+	}
+	if gtin == p.gtin {
+		return nil
+	}
+
+	p.gtin = gtin
+	p.changes.MarkDirty(FieldGTIN)
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"gtin": optionalString(gtin)},
+	})
+
+	return nil
+}
+
+// optionalString records an optional value in event changes: nil when empty.
+func optionalString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// SetPackageSize sets how much one product contains (e.g. 500 g); nil clears it.
+func (p *Product) SetPackageSize(size *PackageSize, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if size.Equals(p.packageSize) {
+		return nil
+	}
+
+	p.packageSize = size
+	p.changes.MarkDirty(FieldPackageSize)
+	p.updatedAt = now
+
+	var recorded interface{}
+	if size != nil {
+		recorded = map[string]interface{}{"quantity": size.QuantityString(), "unit": size.Unit().String()}
+	}
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"package_size": recorded},
+	})
+
+	return nil
+}
+
+// SetAttributes replaces the product's custom attribute values. The values are
+// validated against schema, which must be the schema of the product's category;
+// revalidate with the current values after changing the category.
+func (p *Product) SetAttributes(values map[string]string, schema *AttributeSchema, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if schema == nil || schema.Category() != p.category {
+		return ErrAttributeSchemaMismatch
+	}
+	validated, err := schema.Validate(values)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for name, v := range validated {
+		if old, ok := p.attributes[name]; !ok || !old.equals(v) {
+			p.changes.MarkDirty(AttributeField(name))
+			changed = true
+		}
+	}
+	for name := range p.attributes {
+		if _, ok := validated[name]; !ok {
+			p.changes.MarkDirty(AttributeField(name))
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	p.attributes = validated
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"attributes": p.Attributes()},
+	})
+
+	return nil
+}
+
+// AddTags adds tags to the product after normalizing them with NormalizeTag.
+// Tags the product already carries are ignored; the product may end up with at
+// most MaxProductTags tags.
+func (p *Product) AddTags(tags []string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	normalized, err := normalizeTags(tags)
+	if err != nil {
+		return err
+	}
+
+	added := make([]string, 0, len(normalized))
+	for _, t := range normalized {
+		if !p.tags[t] {
+			added = append(added, t)
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+	if len(p.tags)+len(added) > MaxProductTags {
+		return ErrTooManyTags
+	}
+
+	for _, t := range added {
+		p.tags[t] = true
+		p.changes.MarkDirty(TagField(t))
+	}
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"tags": p.Tags(), "tags_added": added},
+	})
+
+	return nil
+}
+
+// RemoveTags removes tags from the product after normalizing them with NormalizeTag.
+// Tags the product does not carry are ignored.
+func (p *Product) RemoveTags(tags []string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	normalized, err := normalizeTags(tags)
+	if err != nil {
+		return err
+	}
+
+	removed := make([]string, 0, len(normalized))
+	for _, t := range normalized {
+		if p.tags[t] {
+			delete(p.tags, t)
+			p.changes.MarkDirty(TagField(t))
+			removed = append(removed, t)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"tags": p.Tags(), "tags_removed": removed},
+	})
+
+	return nil
+}
+
+// AddMedia adds an image, video or document at the end of the product's media.
+// The URL must be unique within the product, and alt texts are keyed by locale.
+// primary makes an image the product's primary image; the first image added
+// becomes primary anyway.
+func (p *Product) AddMedia(id, rawURL string, kind MediaKind, altTexts map[string]string, primary bool, now time.Time) (*Media, error) {
+	if p.status == ProductStatusArchived {
+		return nil, ErrProductArchived
+	}
+	kind, err := ParseMediaKind(string(kind))
+	if err != nil {
+		return nil, err
+	}
+	mediaURL, err := NormalizeMediaURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	texts, err := NewAltTexts(altTexts)
+	if err != nil {
+		return nil, err
+	}
+	if primary && kind != MediaKindImage {
+		return nil, ErrPrimaryMediaNotImage
+	}
+	if len(p.media) >= MaxProductMedia {
+		return nil, ErrTooManyMedia
+	}
+
+	sortOrder := 0
+	for _, m := range p.media {
+		if m.url == mediaURL {
+			return nil, ErrDuplicateMediaURL
+		}
+		if m.sortOrder >= sortOrder {
+			sortOrder = m.sortOrder + 1
+		}
+	}
+
+	m := &Media{
+		id:        id,
+		url:       mediaURL,
+		kind:      kind,
+		altTexts:  texts,
+		sortOrder: sortOrder,
+		createdAt: now,
+		updatedAt: now,
+	}
+	p.media[id] = m
+	p.changes.MarkDirty(MediaField(id))
+	p.updatedAt = now
+
+	changes := map[string]interface{}{"media_added": id}
+	if kind == MediaKindImage && (primary || p.PrimaryMedia() == nil) {
+		p.setPrimaryMedia(id, now)
+		changes["primary_media"] = id
+	}
+	changes["media"] = p.mediaIDs()
+
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   changes,
+	})
+
+	return m, nil
+}
+
+// ReorderMedia sets the order of the product's media and, when primaryID is not
+// empty, its primary image. ids lists every media entry of the product exactly once;
+// an empty list keeps the order.
+func (p *Product) ReorderMedia(ids []string, primaryID string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if len(ids) > 0 {
+		if len(ids) != len(p.media) {
+			return ErrInvalidMediaOrder
+		}
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			if _, ok := p.media[id]; !ok || seen[id] {
+				return ErrInvalidMediaOrder
+			}
+			seen[id] = true
+		}
+	}
+	if primaryID != "" {
+		m, ok := p.media[primaryID]
+		if !ok {
+			return ErrMediaNotFound
+		}
+		if m.kind != MediaKindImage {
+			return ErrPrimaryMediaNotImage
+		}
+	}
+
+	reordered := false
+	for i, id := range ids {
+		if m := p.media[id]; m.sortOrder != i {
+			m.sortOrder = i
+			m.updatedAt = now
+			p.changes.MarkDirty(MediaField(id))
+			reordered = true
+		}
+	}
+	changes := make(map[string]interface{})
+	if primaryID != "" && p.setPrimaryMedia(primaryID, now) {
+		changes["primary_media"] = primaryID
+	}
+	if !reordered && len(changes) == 0 {
+		return nil
+	}
+	changes["media"] = p.mediaIDs()
+
+	p.updatedAt = now
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   changes,
+	})
+
+	return nil
+}
+
+// RemoveMedia deletes a media entry from the product. When it was the primary image,
+// the next image in sort order becomes primary.
+func (p *Product) RemoveMedia(id string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	m, ok := p.media[id]
+	if !ok {
+		return ErrMediaNotFound
+	}
+
+	delete(p.media, id)
+	p.changes.MarkDirty(MediaField(id))
+	p.updatedAt = now
+
+	changes := map[string]interface{}{"media_removed": id}
+	if m.primary {
+		changes["primary_media"] = nil
+		for _, next := range p.Media() {
+			if next.kind == MediaKindImage {
+				p.setPrimaryMedia(next.id, now)
+				changes["primary_media"] = next.id
+				break
+			}
+		}
+	}
+	changes["media"] = p.mediaIDs()
+
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   changes,
+	})
+
+	return nil
+}
+
+// setPrimaryMedia makes the media entry the only primary one and reports whether
+// that changed anything.
+func (p *Product) setPrimaryMedia(id string, now time.Time) bool {
+	changed := false
+	for _, m := range p.media {
+		if primary := m.id == id; m.primary != primary {
+			m.primary = primary
+			m.updatedAt = now
+			p.changes.MarkDirty(MediaField(m.id))
+			changed = true
+		}
+	}
+	return changed
+}
+
+// mediaIDs returns the ids of the product's media by ascending sort order.
+func (p *Product) mediaIDs() []string {
+	media := p.Media()
+	out := make([]string, 0, len(media))
+	for _, m := range media {
+		out = append(out, m.id)
+	}
+	return out
+}
+
+// AddRelation links the product to the related product relatedID, whose status
+// relatedStatus the caller has loaded: archived products cannot be linked. A product
+// cannot link to itself and has at most MaxProductRelations links; an existing link
+// is left as it is.
+func (p *Product) AddRelation(relType RelationType, relatedID string, relatedStatus ProductStatus, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	relType, err := ParseRelationType(string(relType))
+	if err != nil {
+		return err
+	}
+	if relatedID == p.id {
+		return ErrSelfRelation
+	}
+	if relatedStatus == ProductStatusArchived {
+		return ErrRelatedProductArchived
+	}
+	key := relationKey{relType, relatedID}
+	if _, ok := p.relations[key]; ok {
+		return nil
+	}
+	if len(p.relations) >= MaxProductRelations {
+		return ErrTooManyRelations
+	}
+
+	p.relations[key] = &ProductRelation{relType: relType, productID: relatedID, createdAt: now}
+	p.changes.MarkDirty(RelationField(relType, relatedID))
+	p.updatedAt = now
+
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"relation_type": string(relType), "related_product_added": relatedID},
+	})
+
+	return nil
+}
+
+// RemoveRelation removes the product's link of relType to the related product.
+// A missing link is ignored.
+func (p *Product) RemoveRelation(relType RelationType, relatedID string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	relType, err := ParseRelationType(string(relType))
+	if err != nil {
+		return err
+	}
+	key := relationKey{relType, relatedID}
+	if _, ok := p.relations[key]; !ok {
+		return nil
+	}
+
+	delete(p.relations, key)
+	p.changes.MarkDirty(RelationField(relType, relatedID))
+	p.updatedAt = now
+
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"relation_type": string(relType), "related_product_removed": relatedID},
+	})
+
+	return nil
+}
+
+// ActivateOnChannel puts the product on sale on a sales channel, assigning it to the
+// channel first if needed. The product itself must be active for shoppers on the
+// channel to see it; channels can be activated ahead of that.
+func (p *Product) ActivateOnChannel(channel string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	channel, err := NormalizeChannel(channel)
+	if err != nil {
+		return err
+	}
+	c, ok := p.channels[channel]
+	if ok && c.active {
+		return ErrChannelAlreadyActive
+	}
+	if !ok {
+		c = &ChannelAssignment{channel: channel}
+		p.channels[channel] = c
+	}
+
+	c.active = true
+	c.updatedAt = now
+	p.changes.MarkDirty(ChannelField(channel))
+	p.updatedAt = now
+
+	p.events = append(p.events, &ProductChannelActivatedEvent{
+		ProductID:   p.id,
+		Channel:     channel,
+		ActivatedAt: now,
+	})
+
+	return nil
+}
+
+// DeactivateOnChannel takes the product off sale on a sales channel it is assigned to.
+// The assignment, and its price, is kept for a later reactivation.
+func (p *Product) DeactivateOnChannel(channel string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	channel, err := NormalizeChannel(channel)
+	if err != nil {
+		return err
+	}
+	c, ok := p.channels[channel]
+	if !ok {
+		return ErrChannelNotAssigned
+	}
+	if !c.active {
+		return ErrChannelAlreadyInactive
+	}
+
+	c.active = false
+	c.updatedAt = now
+	p.changes.MarkDirty(ChannelField(channel))
+	p.updatedAt = now
+
+	p.events = append(p.events, &ProductChannelDeactivatedEvent{
+		ProductID:     p.id,
+		Channel:       channel,
+		DeactivatedAt: now,
+	})
+
+	return nil
+}
+
+// SetChannelPrice sets the product's price on a sales channel, assigning it to the
+// channel (inactive) first if needed; a nil price clears it, so the channel sells at
+// the base price. The price is in the primary currency and, after any discount in
+// effect, must keep the minimum margin over the cost price like a variant's price.
+// components_sum bundles take their price from their components on every channel.
+func (p *Product) SetChannelPrice(channel string, price *Money, now time.Time, opts ...PriceChangeOption) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if p.hasDerivedPrice() {
+		return ErrBundlePriceDerived
+	}
+	channel, err := NormalizeChannel(channel)
+	if err != nil {
+		return err
+	}
+	c, ok := p.channels[channel]
+	if !ok {
+		if price == nil {
+			return nil
+		}
+		c = &ChannelAssignment{channel: channel}
+	}
+	if (price == nil && c.price == nil) || (price != nil && price.Equals(c.price)) {
+		return nil
+	}
+	overridden, err := p.checkVariantPrice(price, now, opts)
+	if err != nil {
+		return err
+	}
+
+	oldPrice := c.price
+	c.price = price
+	c.updatedAt = now
+	p.channels[channel] = c
+	p.changes.MarkDirty(ChannelField(channel))
+	p.updatedAt = now
+
+	p.events = append(p.events, &ChannelPriceChangedEvent{
+		ProductID:      p.id,
+		Channel:        channel,
+		OldPrice:       oldPrice,
+		NewPrice:       price,
+		ChangedAt:      now,
+		MarginOverride: overridden,
+	})
+
+	return nil
+}
+
+// SetAvailability schedules the product's launch at from and its discontinuation at
+// until, to the second; nil leaves that side open. Reads honour the window straight away, and the
+// scheduler performs the activation and deactivation when they fall due.
+func (p *Product) SetAvailability(from, until *time.Time, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if from != nil {
+		f := from.UTC().Truncate(time.Second)
+		from = &f
+	}
+	if until != nil {
+		u := until.UTC().Truncate(time.Second)
+		until = &u
+	}
+	if from != nil && until != nil && !until.After(*from) {
+		return ErrInvalidAvailabilityWindow
+	}
+	if sameTimePtr(from, p.availableFrom) && sameTimePtr(until, p.availableUntil) {
+		return nil
+	}
+
+	p.availableFrom, p.availableUntil = from, until
+	p.changes.MarkDirty(FieldAvailability)
+	p.updatedAt = now
+
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes: map[string]interface{}{
+			"available_from":  timeOrNil(from),
+			"available_until": timeOrNil(until),
+		},
+	})
+
+	return nil
+}
+
+// SetRegions replaces the regions the product ships to: only the allowed regions
+// when there are any, never the blocked ones. The product must have a price in the
+// currency of each allowed region, so it can be sold there.
+func (p *Product) SetRegions(allowed, blocked []Region, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	allowedSet, err := regionSet(allowed)
+	if err != nil {
+		return err
+	}
+	blockedSet, err := regionSet(blocked)
+	if err != nil {
+		return err
+	}
+	for r := range allowedSet {
+		if blockedSet[r] {
+			return ErrRegionAllowedAndBlocked
+		}
+	}
+	if err := p.checkRegionPrices(allowedSet); err != nil {
+		return err
+	}
+	if sameRegions(allowedSet, p.allowedRegions) && sameRegions(blockedSet, p.blockedRegions) {
+		return nil
+	}
+
+	p.allowedRegions, p.blockedRegions = allowedSet, blockedSet
+	p.changes.MarkDirty(FieldRegions)
+	p.updatedAt = now
+
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes: map[string]interface{}{
+			"allowed_regions": regionStrings(p.AllowedRegions()),
+			"blocked_regions": regionStrings(p.BlockedRegions()),
+		},
+	})
+
+	return nil
+}
+
+// checkRegionPrices reports ErrNoPriceInRegionCurrency unless the product has a
+// price in the currency of each of the regions.
+func (p *Product) checkRegionPrices(regions map[Region]bool) error {
+	for r := range regions {
+		if r.Currency() == "" {
+			continue // e.g. Antarctica has no currency of its own
+		}
+		if _, ok := p.PriceIn(r.Currency()); !ok {
+			return ErrNoPriceInRegionCurrency
+		}
+	}
+	return nil
+}
+
+// SetBundle makes the product a bundle of components, replacing any components it
+// had. products holds the caller-loaded component products by ID: components must
+// not be archived or bundles themselves, and be priced in one currency. A
+// components_sum bundle takes its base price from its components, which must be
+// priced in its currency, less discount (a fraction in [0, 1); nil means none);
+// the price must keep the minimum margin over the cost price like UpdatePrice.
+// Fixed bundles keep their base price and take no discount.
+func (p *Product) SetBundle(pricing BundlePricingMode, discount *big.Rat, components []*BundleComponent,
+	products map[string]ComponentProduct, now time.Time, opts ...PriceChangeOption) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	pricing, err := ParseBundlePricingMode(string(pricing))
+	if err != nil {
+		return err
+	}
+	if err := validateBundleDiscount(discount); err != nil {
+		return err
+	}
+	if discount != nil && discount.Sign() == 0 {
+		discount = nil
+	}
+	if pricing == BundlePricingFixed && discount != nil {
+		return ErrInvalidBundleDiscount
+	}
+	if len(components) == 0 {
+		return ErrEmptyBundle
+	}
+	if len(components) > MaxBundleComponents {
+		return ErrTooManyBundleComponents
+	}
+
+	next := make(map[string]*BundleComponent, len(components))
+	lines := make([]BundleLine, 0, len(components))
+	for _, c := range components {
+		if c.productID == p.id || next[c.productID] != nil {
+			return ErrInvalidBundleComponent
+		}
+		cp, ok := products[c.productID]
+		if !ok {
+			return ErrProductNotFound
+		}
+		if cp.Type == ProductTypeBundle {
+			return ErrNestedBundle
+		}
+		if cp.Status == ProductStatusArchived {
+			return ErrBundleComponentArchived
+		}
+		if currency := lineCurrency(pricing, p.basePrice, lines); !currency.SameCurrency(cp.BasePrice) {
+			return ErrBundleCurrencyMismatch
+		}
+		next[c.productID] = c
+		lines = append(lines, BundleLine{ProductID: c.productID, Quantity: c.quantity, UnitPrice: cp.BasePrice})
+	}
+
+	// Price a components_sum bundle before changing anything, so a price that breaks
+	// the margin guardrail or does not fit a NUMERIC column leaves the product as it was.
+	var (
+		price      *Money
+		overridden bool
+	)
+	if pricing == BundlePricingComponentsSum {
+		if price, err = BundlePrice(lines, discount); err != nil {
+			return err
+		}
+		if err := validatePrice(price); err != nil {
+			return err
+		}
+		if !price.Equals(p.basePrice) {
+			if overridden, err = newPriceChangeOptions(opts).checkMargin(p.category, p.costPrice, p.priceAfterDiscount(price, now)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for id, old := range p.bundleComponents {
+		if c, ok := next[id]; !ok || c.quantity != old.quantity {
+			p.changes.MarkDirty(BundleComponentField(id))
+		}
+	}
+	for id := range next {
+		if _, ok := p.bundleComponents[id]; !ok {
+			p.changes.MarkDirty(BundleComponentField(id))
+		}
+	}
+	p.productType = ProductTypeBundle
+	p.bundlePricing = pricing
+	p.bundleDiscount = discount
+	p.bundleComponents = next
+	p.changes.MarkDirty(FieldBundle)
+	p.updatedAt = now
+
+	quantities := make(map[string]int64, len(next))
+	for id, c := range next {
+		quantities[id] = c.quantity
+	}
+	changes := map[string]interface{}{
+		"product_type":      string(ProductTypeBundle),
+		"bundle_pricing":    string(pricing),
+		"bundle_components": quantities,
+	}
+	if discount != nil {
+		pct, _ := numericString(new(big.Rat).Mul(discount, big.NewRat(100, 1)))
+		changes["bundle_discount_percent"] = pct
+	}
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   changes,
+	})
+
+	if price != nil && !price.Equals(p.basePrice) {
+		oldPrice := p.basePrice
+		p.basePrice = price
+		p.changes.MarkDirty(FieldBasePrice)
+
+		p.events = append(p.events, &PriceChangedEvent{
+			ProductID:      p.id,
+			OldPrice:       oldPrice,
+			NewPrice:       price,
+			ChangedAt:      now,
+			MarginOverride: overridden,
+		})
+	}
+
+	return nil
+}
+
+// lineCurrency returns the price whose currency every component of a bundle must be
+// priced in: the bundle's own for components_sum bundles, else the first component's,
+// so the bundle price can be allocated across them.
+func lineCurrency(pricing BundlePricingMode, basePrice *Money, lines []BundleLine) *Money {
+	if pricing == BundlePricingComponentsSum || len(lines) == 0 {
+		return basePrice
+	}
+	return lines[0].UnitPrice
+}
+
+// hasDerivedPrice reports whether the product's base price comes from its components.
+func (p *Product) hasDerivedPrice() bool {
+	return p.productType == ProductTypeBundle && p.bundlePricing == BundlePricingComponentsSum
+}
+
+// UpdatePrice changes the base price of the product.
+// The new price, after any discount in effect, must keep the minimum margin over the cost price.
+// components_sum bundles take their price from their components instead.
+func (p *Product) UpdatePrice(newPrice *Money, now time.Time, opts ...PriceChangeOption) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if p.hasDerivedPrice() {
+		return ErrBundlePriceDerived
+	}
+
+	if err := validatePrice(newPrice); err != nil {
+		return err
+	}
+
+	if !newPrice.SameCurrency(p.basePrice) {
+		return ErrCurrencyMismatch
+	}
+
+	if !newPrice.Equals(p.basePrice) {
+		overridden, err := newPriceChangeOptions(opts).checkMargin(p.category, p.costPrice, p.priceAfterDiscount(newPrice, now))
+		if err != nil {
+			return err
+		}
+
+		oldPrice := p.basePrice
+		p.basePrice = newPrice
+		p.changes.MarkDirty(FieldBasePrice)
+		p.updatedAt = now
+
+		p.events = append(p.events, &PriceChangedEvent{
+			ProductID:      p.id,
+			OldPrice:       oldPrice,
+			NewPrice:       newPrice,
+			ChangedAt:      now,
+			MarginOverride: overridden,
+		})
+	}
+
+	return nil
+}
+
+// SetCurrencyPrice sets the base price in a currency other than the primary one,
+// so the product can be sold in several currencies. Setting a price in the
+// primary currency is equivalent to UpdatePrice, including its margin check;
+// the cost price is kept in the primary currency only.
+func (p *Product) SetCurrencyPrice(price *Money, now time.Time, opts ...PriceChangeOption) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+
+	if err := validatePrice(price); err != nil {
+		return err
+	}
+
+	if price.SameCurrency(p.basePrice) {
+		return p.UpdatePrice(price, now, opts...)
+	}
+
+	oldPrice := p.currencyPrices[price.Currency()]
+	if price.Equals(oldPrice) {
+		return nil
+	}
+
+	p.currencyPrices[price.Currency()] = price
+	p.changes.MarkDirty(CurrencyPriceField(price.Currency()))
+	p.updatedAt = now
+
+	p.events = append(p.events, &PriceChangedEvent{
+		ProductID: p.id,
+		OldPrice:  oldPrice,
+		NewPrice:  price,
+		ChangedAt: now,
+	})
+
+	return nil
+}
+
+// SetCostPrice sets the product's cost in its primary currency; nil clears it.
+// The current price, after any discount in effect, must keep the minimum margin over the new cost.
+func (p *Product) SetCostPrice(cost *Money, now time.Time, opts ...PriceChangeOption) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+
+	if cost != nil {
+		if err := validatePrice(cost); err != nil {
+			return err
+		}
+		if !cost.SameCurrency(p.basePrice) {
+			return ErrCurrencyMismatch
+		}
+		if cost.Equals(p.costPrice) {
+			return nil
+		}
+		if _, err := newPriceChangeOptions(opts).checkMargin(p.category, cost, p.priceAfterDiscount(p.basePrice, now)); err != nil {
+			return err
+		}
+	} else if p.costPrice == nil {
+		return nil
+	}
+
+	p.costPrice = cost
+	p.changes.MarkDirty(FieldCostPrice)
+	p.updatedAt = now
+
+	var recorded interface{}
+	if cost != nil {
+		recorded, _ = cost.Decimal()
+	}
+	p.events = append(p.events, &ProductUpdatedEvent{
+		ProductID: p.id,
+		UpdatedAt: now,
+		Changes:   map[string]interface{}{"cost_price": recorded},
+	})
+
+	return nil
+}
+
+// AddVariant adds a variant with its own SKU and option values. price is the variant's
+// own base price in the product's currency, or nil to inherit the product's base price;
+// after the discount in effect it must keep the minimum margin over the cost price.
+// SKUs and option combinations are unique within the product. New variants are active.
+func (p *Product) AddVariant(id, sku string, options map[string]string, price *Money, now time.Time, opts ...PriceChangeOption) (*Variant, error) {
+	if p.status == ProductStatusArchived {
+		return nil, ErrProductArchived
+	}
+
+	sku, err := NormalizeSKU(sku)
+	if err != nil {
+		return nil, err
+	}
+	values, err := NewVariantOptions(options)
+	if err != nil {
 		return nil, err
 	}
-	if err := validatePrice(basePrice); err != nil {
+	overridden, err := p.checkVariantPrice(price, now, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkVariantUnique(id, sku, values); err != nil {
 		return nil, err
 	}
 
-	p := &Product{
-		id:          id,
-		name:        strings.TrimSpace(name),
-		description: strings.TrimSpace(description),
-		category:    strings.TrimSpace(category),
-		basePrice:   basePrice,
-		status:      ProductStatusDraft,
-		createdAt:   now,
-		updatedAt:   now,
-		changes:     NewChangeTracker(),
-		events:      make([]DomainEvent, 0),
+	v := &Variant{
+		id:        id,
+		sku:       sku,
+		options:   values,
+		price:     price,
+		status:    VariantStatusActive,
+		createdAt: now,
+		updatedAt: now,
 	}
+	p.variants[id] = v
+	p.changes.MarkDirty(VariantField(id))
+	p.updatedAt = now
 
-	// Capture creation event
-	p.events = append(p.events, &ProductCreatedEvent{
-		ProductID: p.id,
-		Name:      p.name,
-		Category:  p.category,
-		BasePrice: p.basePrice,
-		CreatedAt: now,
+	p.events = append(p.events, &VariantAddedEvent{
+		ProductID:      p.id,
+		VariantID:      id,
+		SKU:            sku,
+		Options:        values.clone(),
+		Price:          price,
+		AddedAt:        now,
+		MarginOverride: overridden,
 	})
 
-	return p, nil
+	return v, nil
 }
 
-// ReconstructProduct reconstructs a Product from persisted state.
-// Used by repositories when loading from the database.
-func ReconstructProduct(
-	id, name, description, category string,
-	basePrice *Money,
-	discount *Discount,
-	status ProductStatus,
-	createdAt, updatedAt time.Time,
-	archivedAt *time.Time,
-) *Product {
-	return &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice,
-		discount:    discount,
-		status:      status,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
-		archivedAt:  archivedAt,
-		changes:     NewChangeTracker(),
-		events:      make([]DomainEvent, 0),
+// UpdateVariant changes a variant's SKU, option values, own price or status.
+// Changed prices are margin-checked like AddVariant.
+func (p *Product) UpdateVariant(id string, update VariantUpdate, now time.Time, opts ...PriceChangeOption) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	v, ok := p.variants[id]
+	if !ok {
+		return ErrVariantNotFound
 	}
-}
 
-// Getters
+	sku, values, price, status := v.sku, v.options, v.price, v.status
+	changes := make(map[string]interface{})
 
-func (p *Product) ID() string {
-	return p.id
-}
+	if update.SKU != nil {
+		normalized, err := NormalizeSKU(*update.SKU)
+		if err != nil {
+			return err
+		}
+		if normalized != sku {
+			sku = normalized
+			changes["sku"] = sku
+		}
+	}
+	if update.Options != nil {
+		normalized, err := NewVariantOptions(update.Options)
+		if err != nil {
+			return err
+		}
+		if !normalized.Equal(values) {
+			values = normalized
+			changes["options"] = values.clone()
+		}
+	}
 
-func (p *Product) Name() string {
-	return p.name
-}
+	overridden := false
+	switch {
+	case update.Price != nil && update.ClearPrice:
+		return ErrInvalidVariantPrice
+	case update.Price != nil:
+		if price == nil || !price.Equals(update.Price) {
+			var err error
+			if overridden, err = p.checkVariantPrice(update.Price, now, opts); err != nil {
+				return err
+			}
+			price = update.Price
+			changes["price"] = price
+		}
+	case update.ClearPrice:
+		if price != nil {
+			price = nil
+			changes["price"] = nil
+		}
+	}
 
-func (p *Product) Description() string {
-	return p.description
-}
+	if update.Status != nil {
+		if _, err := ParseVariantStatus(string(*update.Status)); err != nil {
+			return err
+		}
+		if *update.Status != status {
+			status = *update.Status
+			changes["status"] = string(status)
+		}
+	}
 
-func (p *Product) Category() string {
-	return p.category
-}
+	if len(changes) == 0 {
+		return nil
+	}
+	if err := p.checkVariantUnique(id, sku, values); err != nil {
+		return err
+	}
 
-func (p *Product) BasePrice() *Money {
-	return p.basePrice
-}
+	v.sku, v.options, v.price, v.status = sku, values, price, status
+	v.updatedAt = now
+	p.changes.MarkDirty(VariantField(id))
+	p.updatedAt = now
 
-func (p *Product) Discount() *Discount {
-	return p.discount
-}
+	p.events = append(p.events, &VariantUpdatedEvent{
+		ProductID:      p.id,
+		VariantID:      id,
+		Changes:        changes,
+		UpdatedAt:      now,
+		MarginOverride: overridden,
+	})
 
-func (p *Product) Status() ProductStatus {
-	return p.status
+	return nil
 }
 
-func (p *Product) CreatedAt() time.Time {
-	return p.createdAt
-}
+// RemoveVariant deletes a variant from the product.
+func (p *Product) RemoveVariant(id string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	v, ok := p.variants[id]
+	if !ok {
+		return ErrVariantNotFound
+	}
 
-func (p *Product) UpdatedAt() time.Time {
-	return p.updatedAt
+	delete(p.variants, id)
+	p.changes.MarkDirty(VariantField(id))
+	p.updatedAt = now
+
+	p.events = append(p.events, &VariantRemovedEvent{
+		ProductID: p.id,
+		VariantID: id,
+		SKU:       v.sku,
+		RemovedAt: now,
+	})
+
+	return nil
 }
 
-func (p *Product) ArchivedAt() *time.Time {
-	return p.archivedAt
+// checkVariantPrice validates a variant's or channel's own price (nil means inherited)
+// and its margin after the discount in effect at now.
+func (p *Product) checkVariantPrice(price *Money, now time.Time, opts []PriceChangeOption) (bool, error) {
+	if price == nil {
+		return false, nil
+	}
+	if err := validatePrice(price); err != nil {
+		return false, err
+	}
+	if !price.SameCurrency(p.basePrice) {
+		return false, ErrCurrencyMismatch
+	}
+	return newPriceChangeOptions(opts).checkMargin(p.category, p.costPrice, p.priceAfterDiscount(price, now))
 }
 
-func (p *Product) Changes() *ChangeTracker {
-	return p.changes
+// checkVariantUnique rejects a SKU or option combination already used by another
+// variant, and the product's own SKU.
+func (p *Product) checkVariantUnique(id, sku string, options VariantOptions) error {
+	if sku == p.sku {
+		return ErrDuplicateSKU
+	}
+	key := options.Key()
+	for _, other := range p.variants {
+		if other.id == id {
+			continue
+		}
+		if other.sku == sku {
+			return ErrDuplicateVariantSKU
+		}
+		if other.options.Key() == key {
+			return ErrDuplicateVariantOptions
+		}
+	}
+	return nil
 }
 
-func (p *Product) DomainEvents() []DomainEvent {
-	return p.events
+// lowestBasePrice returns the lowest base price the product sells at: its own or
+// that of an active variant with its own price.
+func (p *Product) lowestBasePrice() *Money {
+	lowest := p.basePrice
+	for _, v := range p.variants {
+		if v.IsActive() && v.price != nil && v.price.SameCurrency(lowest) && v.price.LessThan(lowest) {
+			lowest = v.price
+		}
+	}
+	return lowest
 }
 
-// Business Methods
+// priceAfterDiscount returns price with the product's discount applied if it is in effect at now.
+func (p *Product) priceAfterDiscount(price *Money, now time.Time) *Money {
+	if p.discount != nil && p.discount.IsValidAt(now) {
+		return p.discount.ApplyTo(price)
+	}
+	return price
+}
 
-// UpdateDetails updates the product's name, description, and/or category.
-// Only updates fields that are provided (non-empty).
-func (p *Product) UpdateDetails(name, description, category string, now time.Time) error {
+// SchedulePriceChange plans a base price change for a later time. The new price,
+// after any discount in effect at that time, must keep the minimum margin over the cost price.
+func (p *Product) SchedulePriceChange(id string, price *Money, effectiveAt, now time.Time, opts ...PriceChangeOption) (*ScheduledPriceChange, error) {
 	if p.status == ProductStatusArchived {
-		return ErrProductArchived
+		return nil, ErrProductArchived
+	}
+	if p.hasDerivedPrice() {
+		return nil, ErrBundlePriceDerived
 	}
 
-	changes := make(map[string]interface{})
+	if err := validatePrice(price); err != nil {
+		return nil, err
+	}
+	if !price.SameCurrency(p.basePrice) {
+		return nil, ErrCurrencyMismatch
+	}
+	if !effectiveAt.After(now) {
+		return nil, ErrInvalidScheduleTime
+	}
 
-	// Update name if provided
-	if name != "" {
-		if err := validateProductName(name); err != nil {
-			return err
-		}
-		trimmedName := strings.TrimSpace(name)
-		if trimmedName != p.name {
-			p.name = trimmedName
-			p.changes.MarkDirty(FieldName)
-			changes["name"] = p.name
-		}
+	o := newPriceChangeOptions(opts)
+	overridden, err := o.checkMargin(p.category, p.costPrice, p.priceAfterDiscount(price, effectiveAt))
+	if err != nil {
+		return nil, err
 	}
 
-	// Update description if provided
-	if description != "" {
-		trimmedDesc := strings.TrimSpace(description)
-		if trimmedDesc != p.description {
-			p.description = trimmedDesc
-			p.changes.MarkDirty(FieldDescription)
-			changes["description"] = p.description
-		}
+	change := &ScheduledPriceChange{
+		id:             id,
+		price:          price,
+		effectiveAt:    effectiveAt.UTC(),
+		status:         ScheduledPricePending,
+		marginOverride: o.override,
+		createdAt:      now,
+		updatedAt:      now,
 	}
+	p.scheduledPrices[id] = change
+	p.changes.MarkDirty(ScheduledPriceField(id))
+	p.updatedAt = now
 
-	// Update category if provided
-	if category != "" {
-		if err := validateProductCategory(category); err != nil {
-			return err
-		}
-		trimmedCat := strings.TrimSpace(category)
-		if trimmedCat != p.category {
-			p.category = trimmedCat
-			p.changes.MarkDirty(FieldCategory)
-			changes["category"] = p.category
-		}
+	p.events = append(p.events, &PriceChangeScheduledEvent{
+		ProductID:      p.id,
+		ScheduleID:     id,
+		NewPrice:       price,
+		EffectiveAt:    change.effectiveAt,
+		ScheduledAt:    now,
+		MarginOverride: overridden,
+	})
+
+	return change, nil
+}
+
+// CancelScheduledPriceChange withdraws a pending scheduled price change.
+func (p *Product) CancelScheduledPriceChange(id string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
 	}
 
-	// Only emit event if something changed
-	if len(changes) > 0 {
-		p.updatedAt = now
-		p.events = append(p.events, &ProductUpdatedEvent{
-			ProductID: p.id,
-			UpdatedAt: now,
-			Changes:   changes,
-		})
+	change, ok := p.scheduledPrices[id]
+	if !ok {
+		return ErrScheduledPriceChangeNotFound
+	}
+	if !change.IsPending() {
+		return ErrScheduledPriceChangeNotPending
 	}
 
+	p.cancelScheduledPriceChange(change, "", now)
 	return nil
 }
 
-// UpdatePrice changes the base price of the product.
-func (p *Product) UpdatePrice(newPrice *Money, now time.Time) error {
+// DiscardScheduledPriceChange cancels a pending change the scheduler cannot apply,
+// recording why on the event, so it stops showing up as due. Unlike
+// CancelScheduledPriceChange it also works on archived products.
+func (p *Product) DiscardScheduledPriceChange(id string, reason error, now time.Time) error {
+	change, ok := p.scheduledPrices[id]
+	if !ok {
+		return ErrScheduledPriceChangeNotFound
+	}
+	if !change.IsPending() {
+		return ErrScheduledPriceChangeNotPending
+	}
+
+	p.cancelScheduledPriceChange(change, reason.Error(), now)
+	return nil
+}
+
+func (p *Product) cancelScheduledPriceChange(change *ScheduledPriceChange, reason string, now time.Time) {
+	change.status = ScheduledPriceCancelled
+	change.updatedAt = now
+	p.changes.MarkDirty(ScheduledPriceField(change.id))
+	p.updatedAt = now
+
+	p.events = append(p.events, &ScheduledPriceChangeCancelledEvent{
+		ProductID:   p.id,
+		ScheduleID:  change.id,
+		CancelledAt: now,
+		Reason:      reason,
+	})
+}
+
+// ApplyScheduledPriceChange makes a due scheduled change the product's base price.
+// The product may have changed since the change was scheduled, so the new price,
+// after any discount in effect, is checked against the margin floor again; a change
+// scheduled under an allowed override may still breach it. components_sum bundles
+// take their price from their components, so no change applies to them.
+func (p *Product) ApplyScheduledPriceChange(id string, now time.Time, opts ...PriceChangeOption) error {
 	if p.status == ProductStatusArchived {
 		return ErrProductArchived
 	}
 
-	if err := validatePrice(newPrice); err != nil {
+	change, ok := p.scheduledPrices[id]
+	if !ok {
+		return ErrScheduledPriceChangeNotFound
+	}
+	if !change.IsPending() {
+		return ErrScheduledPriceChangeNotPending
+	}
+	if change.effectiveAt.After(now) {
+		return ErrScheduledPriceChangeNotDue
+	}
+	if p.hasDerivedPrice() {
+		return ErrBundlePriceDerived
+	}
+
+	overridden, err := newPriceChangeOptions(opts).checkMargin(p.category, p.costPrice, p.priceAfterDiscount(change.price, now))
+	if errors.Is(err, ErrMarginViolation) && change.marginOverride {
+		overridden, err = true, nil
+	}
+	if err != nil {
 		return err
 	}
 
-	if !newPrice.Equals(p.basePrice) {
+	change.status = ScheduledPriceApplied
+	change.updatedAt = now
+	p.changes.MarkDirty(ScheduledPriceField(id))
+	p.updatedAt = now
+
+	if !change.price.Equals(p.basePrice) {
 		oldPrice := p.basePrice
-		p.basePrice = newPrice
+		p.basePrice = change.price
 		p.changes.MarkDirty(FieldBasePrice)
-		p.updatedAt = now
 
 		p.events = append(p.events, &PriceChangedEvent{
-			ProductID: p.id,
-			OldPrice:  oldPrice,
-			NewPrice:  newPrice,
-			ChangedAt: now,
+			ProductID:      p.id,
+			OldPrice:       oldPrice,
+			NewPrice:       change.price,
+			ChangedAt:      now,
+			MarginOverride: overridden,
+			ScheduleID:     id,
 		})
 	}
 
 	return nil
 }
 
+// RemoveCurrencyPrice stops selling the product in a secondary currency.
+// The primary currency price cannot be removed.
+func (p *Product) RemoveCurrencyPrice(currency Currency, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+
+	if currency == p.basePrice.Currency() {
+		return ErrCannotRemovePrimaryPrice
+	}
+
+	oldPrice, ok := p.currencyPrices[currency]
+	if !ok {
+		return nil // Not sold in this currency
+	}
+	for r := range p.allowedRegions {
+		if r.Currency() == currency {
+			return ErrPriceNeededByRegion
+		}
+	}
+
+	delete(p.currencyPrices, currency)
+	p.changes.MarkDirty(CurrencyPriceField(currency))
+	p.updatedAt = now
+
+	p.events = append(p.events, &CurrencyPriceRemovedEvent{
+		ProductID: p.id,
+		OldPrice:  oldPrice,
+		RemovedAt: now,
+	})
+
+	return nil
+}
+
 // Activate transitions the product to Active status, making it available for sale.
+// The product must have a price in the currency of each region it is allowed in.
 func (p *Product) Activate(now time.Time) error {
 	if p.status == ProductStatusArchived {
 		return ErrProductArchived
@@ -261,11 +2336,24 @@ func (p *Product) Activate(now time.Time) error {
 	if p.status == ProductStatusActive {
 		return ErrProductAlreadyActive
 	}
+	if p.availableUntil != nil && !now.Before(*p.availableUntil) {
+		return ErrAvailabilityEnded
+	}
+	if err := p.checkRegionPrices(p.allowedRegions); err != nil {
+		return err
+	}
 
 	p.status = ProductStatusActive
 	p.changes.MarkDirty(FieldStatus)
 	p.updatedAt = now
 
+	// A launch that has passed is done, so the scheduler does not activate the
+	// product again after a later deactivation.
+	if p.availableFrom != nil && !now.Before(*p.availableFrom) {
+		p.availableFrom = nil
+		p.changes.MarkDirty(FieldAvailability)
+	}
+
 	p.events = append(p.events, &ProductActivatedEvent{
 		ProductID:   p.id,
 		ActivatedAt: now,
@@ -288,6 +2376,8 @@ func (p *Product) Deactivate(now time.Time) error {
 	p.changes.MarkDirty(FieldStatus)
 	p.updatedAt = now
 
+	p.clearPassedAvailability(now)
+
 	p.events = append(p.events, &ProductDeactivatedEvent{
 		ProductID:     p.id,
 		DeactivatedAt: now,
@@ -296,6 +2386,47 @@ func (p *Product) Deactivate(now time.Time) error {
 	return nil
 }
 
+// clearPassedAvailability drops window bounds that have already passed when the
+// product is taken off sale. A past launch would otherwise make the scheduler
+// activate the product again, and a past discontinuation would block a later
+// activation without a new window.
+func (p *Product) clearPassedAvailability(now time.Time) {
+	if p.availableFrom != nil && !now.Before(*p.availableFrom) {
+		p.availableFrom = nil
+		p.changes.MarkDirty(FieldAvailability)
+	}
+	if p.availableUntil != nil && !now.Before(*p.availableUntil) {
+		p.availableUntil = nil
+		p.changes.MarkDirty(FieldAvailability)
+	}
+}
+
+// DeactivateBundle takes an active bundle off sale because its component
+// componentID was archived. Bundles that are not active or do not contain the
+// component are left as they are.
+func (p *Product) DeactivateBundle(componentID string, now time.Time) error {
+	if p.status != ProductStatusActive {
+		return nil
+	}
+	if _, ok := p.bundleComponents[componentID]; !ok {
+		return nil
+	}
+
+	p.status = ProductStatusInactive
+	p.changes.MarkDirty(FieldStatus)
+	p.updatedAt = now
+	p.clearPassedAvailability(now)
+
+	p.events = append(p.events, &ProductDeactivatedEvent{
+		ProductID:          p.id,
+		DeactivatedAt:      now,
+		Reason:             DeactivationReasonComponentArchived,
+		ComponentProductID: componentID,
+	})
+
+	return nil
+}
+
 // Archive soft-deletes the product.
 // Active products cannot be archived.
 func (p *Product) Archive(now time.Time) error {
@@ -324,7 +2455,8 @@ func (p *Product) Archive(now time.Time) error {
 // ApplyDiscount applies a discount to the product.
 // Only active products can have discounts applied.
 // Only one discount can be active at a time.
-func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
+// The discounted price must keep the minimum margin over the cost price.
+func (p *Product) ApplyDiscount(discount *Discount, now time.Time, opts ...PriceChangeOption) error {
 	if p.status != ProductStatusActive {
 		return ErrProductNotActive
 	}
@@ -337,16 +2469,23 @@ func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 		return ErrDiscountAlreadyExists
 	}
 
+	// Variants with their own price get the discount too, so the cheapest price is checked.
+	overridden, err := newPriceChangeOptions(opts).checkMargin(p.category, p.costPrice, discount.ApplyTo(p.lowestBasePrice()))
+	if err != nil {
+		return err
+	}
+
 	p.discount = discount
 	p.changes.MarkDirty(FieldDiscount)
 	p.updatedAt = now
 
 	p.events = append(p.events, &DiscountAppliedEvent{
 		ProductID:         p.id,
-		DiscountPercent:   discount.Percentage(),
+		DiscountPercent:   discount.PercentageString(),
 		DiscountStartDate: discount.StartDate(),
 		DiscountEndDate:   discount.EndDate(),
 		AppliedAt:         now,
+		MarginOverride:    overridden,
 	})
 
 	return nil
@@ -382,6 +2521,19 @@ func (p *Product) CalculateEffectivePrice(now time.Time) *Money {
 	return p.basePrice
 }
 
+// CalculateEffectivePriceIn calculates the effective price in the given currency.
+// Returns ErrNoPriceInCurrency if the product is not sold in that currency.
+func (p *Product) CalculateEffectivePriceIn(currency Currency, now time.Time) (*Money, error) {
+	price, ok := p.PriceIn(currency)
+	if !ok {
+		return nil, ErrNoPriceInCurrency
+	}
+	if p.discount != nil && p.discount.IsValidAt(now) {
+		return p.discount.ApplyTo(price), nil
+	}
+	return price, nil
+}
+
 // IsActive returns true if the product is in Active status.
 func (p *Product) IsActive() bool {
 	return p.status == ProductStatusActive
@@ -437,5 +2589,8 @@ func validatePrice(price *Money) error {
 	if price.IsZero() {
 		return ErrZeroPrice
 	}
+	if _, err := price.Decimal(); err != nil {
+		return err
+	}
 	return nil
 }
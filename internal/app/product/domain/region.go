@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// Region is an ISO 3166-1 alpha-2 country code (e.g. "DE", "US") a product can be
+// shipped to.
+type Region string
+
+// regionCurrencies maps every assigned ISO 3166-1 alpha-2 code to the ISO 4217
+// currency prices are quoted in there; "" for Antarctica, which has none. Some
+// currencies are not supported by the catalog, so no product can be sold there.
+var regionCurrencies = map[Region]Currency{
+	"AD": "EUR", "AE": "AED", "AF": "AFN", "AG": "XCD", "AI": "XCD", "AL": "ALL", "AM": "AMD",
+	"AO": "AOA", "AQ": "", "AR": "ARS", "AS": "USD", "AT": "EUR", "AU": "AUD", "AW": "AWG",
+	"AX": "EUR", "AZ": "AZN", "BA": "BAM", "BB": "BBD", "BD": "BDT", "BE": "EUR", "BF": "XOF",
+	"BG": "EUR", "BH": "BHD", "BI": "BIF", "BJ": "XOF", "BL": "EUR", "BM": "BMD", "BN": "BND",
+	"BO": "BOB", "BQ": "USD", "BR": "BRL", "BS": "BSD", "BT": "BTN", "BV": "NOK", "BW": "BWP",
+	"BY": "BYN", "BZ": "BZD", "CA": "CAD", "CC": "AUD", "CD": "CDF", "CF": "XAF", "CG": "XAF",
+	"CH": "CHF", "CI": "XOF", "CK": "NZD", "CL": "CLP", "CM": "XAF", "CN": "CNY", "CO": "COP",
+	"CR": "CRC", "CU": "CUP", "CV": "CVE", "CW": "XCG", "CX": "AUD", "CY": "EUR", "CZ": "CZK",
+	"DE": "EUR", "DJ": "DJF", "DK": "DKK", "DM": "XCD", "DO": "DOP", "DZ": "DZD", "EC": "USD",
+	"EE": "EUR", "EG": "EGP", "EH": "MAD", "ER": "ERN", "ES": "EUR", "ET": "ETB", "FI": "EUR",
+	"FJ": "FJD", "FK": "FKP", "FM": "USD", "FO": "DKK", "FR": "EUR", "GA": "XAF", "GB": "GBP",
+	"GD": "XCD", "GE": "GEL", "GF": "EUR", "GG": "GBP", "GH": "GHS", "GI": "GIP", "GL": "DKK",
+	"GM": "GMD", "GN": "GNF", "GP": "EUR", "GQ": "XAF", "GR": "EUR", "GS": "GBP", "GT": "GTQ",
+	"GU": "USD", "GW": "XOF", "GY": "GYD", "HK": "HKD", "HM": "AUD", "HN": "HNL", "HR": "EUR",
+	"HT": "HTG", "HU": "HUF", "ID": "IDR", "IE": "EUR", "IL": "ILS", "IM": "GBP", "IN": "INR",
+	"IO": "USD", "IQ": "IQD", "IR": "IRR", "IS": "ISK", "IT": "EUR", "JE": "GBP", "JM": "JMD",
+	"JO": "JOD", "JP": "JPY", "KE": "KES", "KG": "KGS", "KH": "KHR", "KI": "AUD", "KM": "KMF",
+	"KN": "XCD", "KP": "KPW", "KR": "KRW", "KW": "KWD", "KY": "KYD", "KZ": "KZT", "LA": "LAK",
+	"LB": "LBP", "LC": "XCD", "LI": "CHF", "LK": "LKR", "LR": "LRD", "LS": "LSL", "LT": "EUR",
+	"LU": "EUR", "LV": "EUR", "LY": "LYD", "MA": "MAD", "MC": "EUR", "MD": "MDL", "ME": "EUR",
+	"MF": "EUR", "MG": "MGA", "MH": "USD", "MK": "MKD", "ML": "XOF", "MM": "MMK", "MN": "MNT",
+	"MO": "MOP", "MP": "USD", "MQ": "EUR", "MR": "MRU", "MS": "XCD", "MT": "EUR", "MU": "MUR",
+	"MV": "MVR", "MW": "MWK", "MX": "MXN", "MY": "MYR", "MZ": "MZN", "NA": "NAD", "NC": "XPF",
+	"NE": "XOF", "NF": "AUD", "NG": "NGN", "NI": "NIO", "NL": "EUR", "NO": "NOK", "NP": "NPR",
+	"NR": "AUD", "NU": "NZD", "NZ": "NZD", "OM": "OMR", "PA": "USD", "PE": "PEN", "PF": "XPF",
+	"PG": "PGK", "PH": "PHP", "PK": "PKR", "PL": "PLN", "PM": "EUR", "PN": "NZD", "PR": "USD",
+	"PS": "ILS", "PT": "EUR", "PW": "USD", "PY": "PYG", "QA": "QAR", "RE": "EUR", "RO": "RON",
+	"RS": "RSD", "RU": "RUB", "RW": "RWF", "SA": "SAR", "SB": "SBD", "SC": "SCR", "SD": "SDG",
+	"SE": "SEK", "SG": "SGD", "SH": "SHP", "SI": "EUR", "SJ": "NOK", "SK": "EUR", "SL": "SLE",
+	"SM": "EUR", "SN": "XOF", "SO": "SOS", "SR": "SRD", "SS": "SSP", "ST": "STN", "SV": "USD",
+	"SX": "XCG", "SY": "SYP", "SZ": "SZL", "TC": "USD", "TD": "XAF", "TF": "EUR", "TG": "XOF",
+	"TH": "THB", "TJ": "TJS", "TK": "NZD", "TL": "USD", "TM": "TMT", "TN": "TND", "TO": "TOP",
+	"TR": "TRY", "TT": "TTD", "TV": "AUD", "TW": "TWD", "TZ": "TZS", "UA": "UAH", "UG": "UGX",
+	"UM": "USD", "US": "USD", "UY": "UYU", "UZ": "UZS", "VA": "EUR", "VC": "XCD", "VE": "VES",
+	"VG": "USD", "VI": "USD", "VN": "VND", "VU": "VUV", "WF": "XPF", "WS": "WST", "YE": "YER",
+	"YT": "EUR", "ZA": "ZAR", "ZM": "ZMW", "ZW": "ZWG",
+}
+
+// ParseRegion normalizes a region code to upper case and checks it is an assigned
+// ISO 3166-1 alpha-2 code.
+func ParseRegion(code string) (Region, error) {
+	r := Region(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := regionCurrencies[r]; !ok {
+		return "", ErrInvalidRegion
+	}
+	return r, nil
+}
+
+// Currency returns the currency prices are quoted in in the region.
+func (r Region) Currency() Currency {
+	return regionCurrencies[r]
+}
+
+func (r Region) String() string {
+	return string(r)
+}
+
+// RegionCurrencies returns the currency of every region that has one, keyed by region.
+func RegionCurrencies() map[Region]Currency {
+	out := make(map[Region]Currency, len(regionCurrencies))
+	for r, c := range regionCurrencies {
+		if c != "" {
+			out[r] = c
+		}
+	}
+	return out
+}
+
+// RegionsPriced reports whether priced, the currencies a product has a price in
+// (its primary one included), covers the currency of each allowed region, as
+// activation requires.
+func RegionsPriced(allowed []Region, priced []Currency) bool {
+	have := make(map[Currency]bool, len(priced))
+	for _, c := range priced {
+		have[c] = true
+	}
+	for _, r := range allowed {
+		if c := r.Currency(); c != "" && !have[c] {
+			return false
+		}
+	}
+	return true
+}
+
+// regionSet parses and deduplicates region codes.
+func regionSet(codes []Region) (map[Region]bool, error) {
+	out := make(map[Region]bool, len(codes))
+	for _, c := range codes {
+		r, err := ParseRegion(string(c))
+		if err != nil {
+			return nil, err
+		}
+		out[r] = true
+	}
+	return out, nil
+}
+
+// sortedRegions returns the regions of a set in code order.
+func sortedRegions(set map[Region]bool) []Region {
+	out := make([]Region, 0, len(set))
+	for r := range set {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// sameRegions reports whether two region sets hold the same regions.
+func sameRegions(a, b map[Region]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for r := range a {
+		if !b[r] {
+			return false
+		}
+	}
+	return true
+}
+
+// regionStrings returns region codes as strings, for event payloads.
+func regionStrings(regions []Region) []string {
+	out := make([]string, 0, len(regions))
+	for _, r := range regions {
+		out = append(out, r.String())
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegion(t *testing.T) {
+	r, err := ParseRegion(" de ")
+	require.NoError(t, err)
+	assert.Equal(t, Region("DE"), r)
+	assert.Equal(t, Currency("EUR"), r.Currency())
+
+	for _, code := range []string{"", "XX", "EU", "DEU", "UK"} {
+		_, err := ParseRegion(code)
+		assert.ErrorIs(t, err, ErrInvalidRegion, code)
+	}
+}
+
+func TestProductSetRegions(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-1", "Lamp", "", "lighting", NewMoney(40, 1), nil, ProductStatusActive, now, now, nil,
+		WithCurrencyPrices(NewMoneyIn("EUR", 37, 1)))
+	assert.True(t, p.AvailableIn("JP"))
+
+	assert.ErrorIs(t, p.SetRegions([]Region{"de"}, []Region{"DE"}, now), ErrRegionAllowedAndBlocked)
+	assert.ErrorIs(t, p.SetRegions([]Region{"US", "GB"}, nil, now), ErrNoPriceInRegionCurrency)
+	assert.ErrorIs(t, p.SetRegions(nil, []Region{"ZZ"}, now), ErrInvalidRegion)
+	assert.Empty(t, p.DomainEvents())
+
+	require.NoError(t, p.SetRegions([]Region{"us", "DE", "FR"}, []Region{"RU"}, now))
+	assert.Equal(t, []Region{"DE", "FR", "US"}, p.AllowedRegions())
+	assert.True(t, p.AvailableIn("DE"))
+	assert.False(t, p.AvailableIn("JP"))
+	assert.True(t, p.Changes().Dirty(FieldRegions))
+	require.Len(t, p.DomainEvents(), 1)
+	ev := p.DomainEvents()[0].(*ProductUpdatedEvent)
+	assert.Equal(t, []string{"RU"}, ev.Changes["blocked_regions"])
+
+	// Setting the same regions again changes nothing.
+	require.NoError(t, p.SetRegions([]Region{"FR", "DE", "US"}, []Region{"RU"}, now))
+	assert.Len(t, p.DomainEvents(), 1)
+
+	// The euro price is still needed by DE and FR.
+	assert.ErrorIs(t, p.RemoveCurrencyPrice("EUR", now), ErrPriceNeededByRegion)
+
+	// Only blocking regions ships everywhere else.
+	require.NoError(t, p.SetRegions(nil, []Region{"RU"}, now))
+	assert.True(t, p.AvailableIn("JP"))
+	assert.False(t, p.AvailableIn("RU"))
+	require.NoError(t, p.RemoveCurrencyPrice("EUR", now))
+
+	require.NoError(t, p.Deactivate(now))
+	require.NoError(t, p.Archive(now))
+	assert.ErrorIs(t, p.SetRegions(nil, nil, now), ErrProductArchived)
+}
+
+func TestProductActivateNeedsRegionPrices(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-1", "Lamp", "", "lighting", NewMoney(40, 1), nil, ProductStatusInactive, now, now, nil,
+		WithRegions([]Region{"US", "DE", "AQ"}, nil))
+	assert.ErrorIs(t, p.Activate(now), ErrNoPriceInRegionCurrency)
+	assert.Equal(t, ProductStatusInactive, p.Status())
+	assert.Empty(t, p.DomainEvents())
+
+	require.NoError(t, p.SetCurrencyPrice(NewMoneyIn("EUR", 37, 1), now))
+	require.NoError(t, p.Activate(now))
+	assert.Equal(t, ProductStatusActive, p.Status())
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// RelationType says how a product relates to a product it links to. Links read
+// "product <type> related product", e.g. a lens is an accessory_of a camera.
+type RelationType string
+
+const (
+	// RelationFrequentlyBoughtWith links products often bought together.
+	RelationFrequentlyBoughtWith RelationType = "frequently_bought_with"
+
+	// RelationAccessoryOf links an accessory to the product it goes with.
+	RelationAccessoryOf RelationType = "accessory_of"
+
+	// RelationReplacementFor links a product to the product it replaces.
+	RelationReplacementFor RelationType = "replacement_for"
+)
+
+// ParseRelationType validates a relation type.
+func ParseRelationType(s string) (RelationType, error) {
+	switch RelationType(strings.ToLower(strings.TrimSpace(s))) {
+	case RelationFrequentlyBoughtWith:
+		return RelationFrequentlyBoughtWith, nil
+	case RelationAccessoryOf:
+		return RelationAccessoryOf, nil
+	case RelationReplacementFor:
+		return RelationReplacementFor, nil
+	}
+	return "", ErrInvalidRelationType
+}
+
+// MaxProductRelations is the most links a product can have to other products.
+const MaxProductRelations = 100
+
+// fieldRelationPrefix prefixes the dirty-field name of a relation, e.g.
+// "relation:accessory_of:<product id>". Use RelationField to build it.
+const fieldRelationPrefix = "relation:"
+
+// RelationField returns the change-tracking field name for a link to a related product.
+func RelationField(relType RelationType, relatedID string) string {
+	return fieldRelationPrefix + string(relType) + ":" + relatedID
+}
+
+// RelationFromField returns the type and related product of a relation field, or
+// false if the field is not a relation field.
+func RelationFromField(field string) (RelationType, string, bool) {
+	if !strings.HasPrefix(field, fieldRelationPrefix) {
+		return "", "", false
+	}
+	relType, relatedID, ok := strings.Cut(strings.TrimPrefix(field, fieldRelationPrefix), ":")
+	if !ok {
+		return "", "", false
+	}
+	return RelationType(relType), relatedID, true
+}
+
+// ProductRelation is a typed, directed link from a product to a related product,
+// owned by the linking product.
+type ProductRelation struct {
+	relType   RelationType
+	productID string
+	createdAt time.Time
+}
+
+// ReconstructProductRelation reconstructs a link to the related product productID.
+func ReconstructProductRelation(relType RelationType, productID string, createdAt time.Time) *ProductRelation {
+	return &ProductRelation{relType: relType, productID: productID, createdAt: createdAt}
+}
+
+func (r *ProductRelation) Type() RelationType {
+	return r.relType
+}
+
+// ProductID returns the ID of the related product.
+func (r *ProductRelation) ProductID() string {
+	return r.productID
+}
+
+func (r *ProductRelation) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+type relationKey struct {
+	relType   RelationType
+	productID string
+}
+
+// sortRelations orders relations by type, then creation time, then related product.
+func sortRelations(relations []*ProductRelation) {
+	sort.Slice(relations, func(i, j int) bool {
+		a, b := relations[i], relations[j]
+		if a.relType != b.relType {
+			return a.relType < b.relType
+		}
+		if !a.createdAt.Equal(b.createdAt) {
+			return a.createdAt.Before(b.createdAt)
+		}
+		return a.productID < b.productID
+	})
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelationField(t *testing.T) {
+	field := RelationField(RelationAccessoryOf, "prod-2")
+	relType, id, ok := RelationFromField(field)
+	require.True(t, ok)
+	assert.Equal(t, RelationAccessoryOf, relType)
+	assert.Equal(t, "prod-2", id)
+
+	_, _, ok = RelationFromField(TagField("eco"))
+	assert.False(t, ok)
+}
+
+func TestProductRelations(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("prod-1", "Lens", "", "cameras", NewMoney(10, 1), nil, ProductStatusActive, now, now, nil)
+
+	assert.ErrorIs(t, p.AddRelation("goes_with", "prod-2", ProductStatusActive, now), ErrInvalidRelationType)
+	assert.ErrorIs(t, p.AddRelation(RelationAccessoryOf, "prod-1", ProductStatusActive, now), ErrSelfRelation)
+	assert.ErrorIs(t, p.AddRelation(RelationAccessoryOf, "prod-2", ProductStatusArchived, now), ErrRelatedProductArchived)
+
+	// Inactive products can be linked; listings only show active ones.
+	require.NoError(t, p.AddRelation(" Accessory_Of ", "prod-2", ProductStatusInactive, now))
+	require.NoError(t, p.AddRelation(RelationFrequentlyBoughtWith, "prod-2", ProductStatusActive, now.Add(time.Second)))
+	require.NoError(t, p.AddRelation(RelationAccessoryOf, "prod-2", ProductStatusActive, now))
+	assert.Len(t, p.DomainEvents(), 2)
+	assert.True(t, p.Changes().Dirty(RelationField(RelationAccessoryOf, "prod-2")))
+
+	relations := p.Relations()
+	require.Len(t, relations, 2)
+	assert.Equal(t, RelationAccessoryOf, relations[0].Type())
+	assert.Equal(t, RelationFrequentlyBoughtWith, relations[1].Type())
+
+	require.NoError(t, p.RemoveRelation(RelationReplacementFor, "prod-2", now))
+	assert.Len(t, p.DomainEvents(), 2)
+	require.NoError(t, p.RemoveRelation(RelationAccessoryOf, "prod-2", now))
+	_, ok := p.Relation(RelationAccessoryOf, "prod-2")
+	assert.False(t, ok)
+	assert.Equal(t, map[string]interface{}{"relation_type": "accessory_of", "related_product_removed": "prod-2"},
+		p.DomainEvents()[2].(*ProductUpdatedEvent).Changes)
+}
@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+)
+
+// RoundingMode is a strategy for rounding exact prices to a currency's minor units.
+type RoundingMode string
+
+const (
+	// RoundHalfUp rounds to the nearest minor unit, halves away from zero (19.995 → 20.00).
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundHalfEven rounds to the nearest minor unit, halves to the even neighbour (19.985 → 19.98).
+	RoundHalfEven RoundingMode = "half_even"
+	// RoundFloor rounds towards negative infinity (19.999 → 19.99).
+	RoundFloor RoundingMode = "floor"
+	// RoundPsychological rounds up to the next price ending in 9s (12.34 → 12.99, 1234 JPY → 1239 JPY).
+	RoundPsychological RoundingMode = "psychological"
+)
+
+// DefaultRoundingMode applies when no rule matches a product.
+const DefaultRoundingMode = RoundHalfUp
+
+// ParseRoundingMode validates a rounding mode name.
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	switch mode := RoundingMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case RoundHalfUp, RoundHalfEven, RoundFloor, RoundPsychological:
+		return mode, nil
+	}
+	return "", ErrInvalidRoundingMode
+}
+
+func (m RoundingMode) String() string {
+	return string(m)
+}
+
+// RoundingScope says what a rounding rule is keyed by.
+type RoundingScope string
+
+const (
+	RoundingScopeCurrency RoundingScope = "currency"
+	RoundingScopeCategory RoundingScope = "category"
+)
+
+// RoundingRule is a value object assigning a rounding mode to a currency or a category.
+type RoundingRule struct {
+	scope RoundingScope
+	key   string
+	mode  RoundingMode
+}
+
+// NewRoundingRule creates a rule. Currency keys are normalized ISO 4217 codes;
+// category keys are category slugs (see Slugify).
+func NewRoundingRule(scope RoundingScope, key string, mode RoundingMode) (*RoundingRule, error) {
+	key, err := normalizeRoundingKey(scope, key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ParseRoundingMode(mode.String()); err != nil {
+		return nil, err
+	}
+	return &RoundingRule{scope: scope, key: key, mode: mode}, nil
+}
+
+// normalizeRoundingKey validates and normalizes the key of a rule in the given scope.
+func normalizeRoundingKey(scope RoundingScope, key string) (string, error) {
+	switch scope {
+	case RoundingScopeCurrency:
+		if strings.TrimSpace(key) == "" {
+			return "", ErrInvalidRoundingRule
+		}
+		c, err := ParseCurrency(key)
+		if err != nil {
+			return "", err
+		}
+		return c.String(), nil
+	case RoundingScopeCategory:
+		slug := Slugify(key)
+		if slug == "" {
+			return "", ErrInvalidRoundingRule
+		}
+		return slug, nil
+	}
+	return "", ErrInvalidRoundingRule
+}
+
+func (r *RoundingRule) Scope() RoundingScope {
+	return r.scope
+}
+
+func (r *RoundingRule) Key() string {
+	return r.key
+}
+
+func (r *RoundingRule) Mode() RoundingMode {
+	return r.mode
+}
+
+// ParseRoundingRules parses a rule list such as
+// "currency:JPY=floor;category:grocery=psychological".
+// Rules are separated by ';'; an empty spec yields no rules.
+func ParseRoundingRules(spec string) ([]*RoundingRule, error) {
+	var rules []*RoundingRule
+	for _, part := range strings.Split(spec, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		target, modeName, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, ErrInvalidRoundingRule
+		}
+		scope, key, ok := strings.Cut(target, ":")
+		if !ok {
+			return nil, ErrInvalidRoundingRule
+		}
+		mode, err := ParseRoundingMode(modeName)
+		if err != nil {
+			return nil, err
+		}
+		rule, err := NewRoundingRule(RoundingScope(strings.ToLower(strings.TrimSpace(scope))), key, mode)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// RoundingPolicy resolves the rounding mode for a price from a set of rules.
+// A category rule wins over a currency rule; without either, DefaultRoundingMode applies.
+type RoundingPolicy struct {
+	byCategory map[string]RoundingMode
+	byCurrency map[Currency]RoundingMode
+}
+
+// NewRoundingPolicy builds a policy from rules. Later rules for the same key win.
+func NewRoundingPolicy(rules ...*RoundingRule) *RoundingPolicy {
+	p := &RoundingPolicy{
+		byCategory: make(map[string]RoundingMode),
+		byCurrency: make(map[Currency]RoundingMode),
+	}
+	for _, r := range rules {
+		if r == nil {
+			continue
+		}
+		switch r.scope {
+		case RoundingScopeCategory:
+			p.byCategory[r.key] = r.mode
+		case RoundingScopeCurrency:
+			p.byCurrency[Currency(r.key)] = r.mode
+		}
+	}
+	return p
+}
+
+// ModeFor returns the rounding mode for a product category priced in the given currency.
+func (p *RoundingPolicy) ModeFor(category string, currency Currency) RoundingMode {
+	if p != nil {
+		if mode, ok := p.byCategory[category]; ok {
+			return mode
+		}
+		if mode, ok := p.byCurrency[currency]; ok {
+			return mode
+		}
+	}
+	return DefaultRoundingMode
+}
+
+// Round rounds m to its currency's minor units using the given mode.
+func (m *Money) Round(mode RoundingMode) *Money {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(m.currency.MinorUnits())), nil)
+	scaled := new(big.Rat).Mul(m.amount, new(big.Rat).SetInt(scale)) // amount in minor units
+
+	var minor *big.Int
+	switch mode {
+	case RoundHalfEven:
+		minor = roundHalf(scaled, true)
+	case RoundFloor:
+		minor = floorRat(scaled)
+	case RoundPsychological:
+		minor = roundPsychological(scaled, m.currency.MinorUnits())
+	default:
+		minor = roundHalf(scaled, false)
+	}
+
+	return &Money{amount: new(big.Rat).SetFrac(minor, scale), currency: m.currency}
+}
+
+// floorRat returns the largest integer <= r.
+func floorRat(r *big.Rat) *big.Int {
+	// big.Int.Div is Euclidean division; with a positive denominator it floors.
+	return new(big.Int).Div(r.Num(), r.Denom())
+}
+
+// roundHalf rounds r to the nearest integer; halves go away from zero, or to even if toEven.
+func roundHalf(r *big.Rat, toEven bool) *big.Int {
+	floor := floorRat(r)
+	frac := new(big.Rat).Sub(r, new(big.Rat).SetInt(floor)) // in [0, 1)
+	switch frac.Cmp(big.NewRat(1, 2)) {
+	case 1:
+		return floor.Add(floor, big.NewInt(1))
+	case -1:
+		return floor
+	}
+	// Exactly half.
+	if toEven {
+		if floor.Bit(0) == 1 {
+			floor.Add(floor, big.NewInt(1))
+		}
+		return floor
+	}
+	if r.Sign() >= 0 {
+		return floor.Add(floor, big.NewInt(1))
+	}
+	return floor
+}
+
+// roundPsychological rounds r (in minor units) up to the next amount ending in 9s:
+// x.99 for currencies with minor units, ...9 for currencies without.
+func roundPsychological(r *big.Rat, minorUnits int) *big.Int {
+	digits := minorUnits
+	if digits == 0 {
+		digits = 1
+	}
+	step := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+
+	// Smallest value >= r of the form k*step - 1.
+	shifted := new(big.Rat).Add(r, big.NewRat(1, 1))
+	shifted.Quo(shifted, new(big.Rat).SetInt(step))
+	k := floorRat(shifted)
+	if !shifted.IsInt() {
+		k.Add(k, big.NewInt(1))
+	}
+	if k.Sign() <= 0 {
+		// Never round a positive price down to zero or below.
+		k = big.NewInt(1)
+	}
+	return k.Mul(k, step).Sub(k, big.NewInt(1))
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyRound(t *testing.T) {
+	cases := []struct {
+		name     string
+		money    *Money
+		mode     RoundingMode
+		expected string
+	}{
+		{"half up rounds halves up", NewMoney(19995, 1000), RoundHalfUp, "20.00 USD"},
+		{"half up rounds negative halves away from zero", NewMoney(-19995, 1000), RoundHalfUp, "-20.00 USD"},
+		{"half even rounds halves to even", NewMoney(19985, 1000), RoundHalfEven, "19.98 USD"},
+		{"half even rounds non-halves normally", NewMoney(19986, 1000), RoundHalfEven, "19.99 USD"},
+		{"floor", NewMoney(19999, 1000), RoundFloor, "19.99 USD"},
+		{"a third off 100", NewMoney(200, 3), RoundHalfUp, "66.67 USD"},
+		{"psychological rounds up to .99", NewMoney(1234, 100), RoundPsychological, "12.99 USD"},
+		{"psychological keeps .99", NewMoney(1299, 100), RoundPsychological, "12.99 USD"},
+		{"psychological on whole amount", NewMoney(13, 1), RoundPsychological, "13.99 USD"},
+		{"psychological without minor units", NewMoneyIn("JPY", 1234, 1), RoundPsychological, "1239 JPY"},
+		{"minor units of JPY", NewMoneyIn("JPY", 31984, 10), RoundHalfUp, "3198 JPY"},
+		{"minor units of BHD", NewMoneyIn("BHD", 1, 3), RoundHalfUp, "0.333 BHD"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.money.Round(tc.mode).String())
+		})
+	}
+}
+
+func TestRoundingPolicy(t *testing.T) {
+	rules, err := ParseRoundingRules("currency:jpy=floor; category:grocery=psychological")
+	require.NoError(t, err)
+	policy := NewRoundingPolicy(rules...)
+
+	assert.Equal(t, RoundPsychological, policy.ModeFor("grocery", "JPY"), "category wins over currency")
+	assert.Equal(t, RoundFloor, policy.ModeFor("books", "JPY"))
+	assert.Equal(t, DefaultRoundingMode, policy.ModeFor("books", "USD"))
+
+	_, err = ParseRoundingRules("currency:USD=bankers")
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = ParseRoundingRules("region:EU=floor")
+	assert.ErrorIs(t, err, ErrInvalidRoundingRule)
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// ScheduledPriceStatus is the lifecycle state of a scheduled price change.
+type ScheduledPriceStatus string
+
+const (
+	// ScheduledPricePending changes are waiting for their effective time.
+	ScheduledPricePending ScheduledPriceStatus = "pending"
+
+	// ScheduledPriceApplied changes have replaced the product's base price.
+	ScheduledPriceApplied ScheduledPriceStatus = "applied"
+
+	// ScheduledPriceCancelled changes were withdrawn before taking effect.
+	ScheduledPriceCancelled ScheduledPriceStatus = "cancelled"
+)
+
+// fieldScheduledPricePrefix prefixes the dirty-field name of a scheduled price change,
+// e.g. "scheduled_price:<id>". Use ScheduledPriceField to build it.
+const fieldScheduledPricePrefix = "scheduled_price:"
+
+// ScheduledPriceField returns the change-tracking field name for a scheduled price change.
+func ScheduledPriceField(id string) string {
+	return fieldScheduledPricePrefix + id
+}
+
+// ScheduleIDFromField returns the id of a scheduled price change field,
+// or false if the field is not a scheduled price change field.
+func ScheduleIDFromField(field string) (string, bool) {
+	if !strings.HasPrefix(field, fieldScheduledPricePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(field, fieldScheduledPricePrefix), true
+}
+
+// ScheduledPriceChange is a base price change planned for a later time. It is an
+// entity owned by the Product aggregate: pricing reads honour a pending change from
+// its effective time on, and the scheduler makes it the product's base price.
+type ScheduledPriceChange struct {
+	id          string
+	price       *Money
+	effectiveAt time.Time
+	status      ScheduledPriceStatus
+	// marginOverride carries an allowed margin override from scheduling to applying.
+	marginOverride bool
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// ReconstructScheduledPriceChange reconstructs a scheduled price change from persisted state.
+func ReconstructScheduledPriceChange(id string, price *Money, effectiveAt time.Time, status ScheduledPriceStatus, marginOverride bool, createdAt, updatedAt time.Time) *ScheduledPriceChange {
+	return &ScheduledPriceChange{
+		id:             id,
+		price:          price,
+		effectiveAt:    effectiveAt,
+		status:         status,
+		marginOverride: marginOverride,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+func (s *ScheduledPriceChange) ID() string {
+	return s.id
+}
+
+func (s *ScheduledPriceChange) Price() *Money {
+	return s.price
+}
+
+func (s *ScheduledPriceChange) EffectiveAt() time.Time {
+	return s.effectiveAt
+}
+
+func (s *ScheduledPriceChange) Status() ScheduledPriceStatus {
+	return s.status
+}
+
+// MarginOverride reports whether the change was scheduled under an allowed margin
+// override, which lets it breach the margin floor when it is applied too.
+func (s *ScheduledPriceChange) MarginOverride() bool {
+	return s.marginOverride
+}
+
+func (s *ScheduledPriceChange) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *ScheduledPriceChange) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// IsPending reports whether the change has neither been applied nor cancelled.
+func (s *ScheduledPriceChange) IsPending() bool {
+	return s.status == ScheduledPricePending
+}
+
+// BasePriceAt returns the base price in effect at the given time: the latest pending
+// change whose effective time has passed, or base when there is none. Pending changes
+// take effect at their effective time even before the scheduler applies them, so
+// reads never depend on scheduler latency.
+func BasePriceAt(base *Money, changes []*ScheduledPriceChange, at time.Time) *Money {
+	var latest *ScheduledPriceChange
+	for _, c := range changes {
+		if !c.IsPending() || c.effectiveAt.After(at) || !c.price.SameCurrency(base) {
+			continue
+		}
+		if latest == nil || c.effectiveAt.After(latest.effectiveAt) {
+			latest = c
+		}
+	}
+	if latest == nil {
+		return base
+	}
+	return latest.price
+}
+
+// sortScheduledPriceChanges orders changes by effective time, then id.
+func sortScheduledPriceChanges(changes []*ScheduledPriceChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		if !changes[i].effectiveAt.Equal(changes[j].effectiveAt) {
+			return changes[i].effectiveAt.Before(changes[j].effectiveAt)
+		}
+		return changes[i].id < changes[j].id
+	})
+}
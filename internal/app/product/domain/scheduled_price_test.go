@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulePriceChange(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	p.Changes().Clear()
+	p.ClearEvents()
+
+	_, err = p.SchedulePriceChange("s-past", NewMoney(12, 1), now, now)
+	assert.ErrorIs(t, err, ErrInvalidScheduleTime)
+	_, err = p.SchedulePriceChange("s-eur", NewMoneyIn("EUR", 12, 1), now.Add(time.Hour), now)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	later := now.Add(7 * 24 * time.Hour)
+	change, err := p.SchedulePriceChange("s-1", NewMoney(12, 1), later, now)
+	require.NoError(t, err)
+	assert.True(t, change.IsPending())
+	assert.True(t, p.Changes().Dirty(ScheduledPriceField("s-1")))
+	assert.False(t, p.Changes().Dirty(FieldBasePrice))
+	require.Len(t, p.DomainEvents(), 1)
+	assert.IsType(t, &PriceChangeScheduledEvent{}, p.DomainEvents()[0])
+
+	// The base price is unchanged until the change is applied, but reads honour it from its effective time.
+	assert.True(t, p.BasePrice().Equals(NewMoney(10, 1)))
+	assert.True(t, p.BasePriceAt(later.Add(-time.Second)).Equals(NewMoney(10, 1)))
+	assert.True(t, p.BasePriceAt(later).Equals(NewMoney(12, 1)))
+}
+
+func TestScheduledPriceChangeMarginCheck(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy, err := NewMarginPolicy(big.NewRat(1, 10))
+	require.NoError(t, err)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	require.NoError(t, p.SetCostPrice(NewMoney(8, 1), now, WithMarginPolicy(policy)))
+
+	_, err = p.SchedulePriceChange("s-1", NewMoney(85, 10), now.Add(time.Hour), now, WithMarginPolicy(policy))
+	assert.ErrorIs(t, err, ErrMarginViolation)
+	_, ok := p.ScheduledPriceChange("s-1")
+	assert.False(t, ok)
+}
+
+func TestApplyScheduledPriceChange(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	later := now.Add(time.Hour)
+	_, err = p.SchedulePriceChange("s-1", NewMoney(12, 1), later, now)
+	require.NoError(t, err)
+	p.Changes().Clear()
+	p.ClearEvents()
+
+	assert.ErrorIs(t, p.ApplyScheduledPriceChange("s-1", now), ErrScheduledPriceChangeNotDue)
+	assert.ErrorIs(t, p.ApplyScheduledPriceChange("missing", later), ErrScheduledPriceChangeNotFound)
+
+	require.NoError(t, p.ApplyScheduledPriceChange("s-1", later))
+	assert.True(t, p.BasePrice().Equals(NewMoney(12, 1)))
+	assert.True(t, p.Changes().Dirty(FieldBasePrice))
+	change, _ := p.ScheduledPriceChange("s-1")
+	assert.Equal(t, ScheduledPriceApplied, change.Status())
+
+	require.Len(t, p.DomainEvents(), 1)
+	ev, ok := p.DomainEvents()[0].(*PriceChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "s-1", ev.ScheduleID)
+	assert.True(t, ev.OldPrice.Equals(NewMoney(10, 1)))
+
+	assert.ErrorIs(t, p.ApplyScheduledPriceChange("s-1", later), ErrScheduledPriceChangeNotPending)
+	assert.ErrorIs(t, p.CancelScheduledPriceChange("s-1", later), ErrScheduledPriceChangeNotPending)
+}
+
+func TestApplyScheduledPriceChangeRechecksProduct(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	policy, err := NewMarginPolicy(big.NewRat(1, 10))
+	require.NoError(t, err)
+
+	// A change scheduled before the product became a components_sum bundle.
+	bundle := ReconstructProduct("prod-1", "Gift Set", "", "gifts", NewMoney(30, 1), nil, ProductStatusActive, now, now, nil,
+		WithBundle(BundlePricingComponentsSum, nil, ReconstructBundleComponent("prod-2", 2)),
+		WithScheduledPriceChanges(ReconstructScheduledPriceChange("s-1", NewMoney(25, 1), earlier, ScheduledPricePending, false, earlier, earlier)))
+	assert.ErrorIs(t, bundle.ApplyScheduledPriceChange("s-1", now), ErrBundlePriceDerived)
+	assert.True(t, bundle.BasePrice().Equals(NewMoney(30, 1)))
+
+	// The scheduler discards it, recording why.
+	require.NoError(t, bundle.DiscardScheduledPriceChange("s-1", ErrBundlePriceDerived, now))
+	change, _ := bundle.ScheduledPriceChange("s-1")
+	assert.Equal(t, ScheduledPriceCancelled, change.Status())
+	require.Len(t, bundle.DomainEvents(), 1)
+	assert.Equal(t, ErrBundlePriceDerived.Error(), bundle.DomainEvents()[0].(*ScheduledPriceChangeCancelledEvent).Reason)
+
+	// The cost rose after scheduling, so the price no longer keeps the margin...
+	p := ReconstructProduct("prod-3", "Coffee", "", "grocery", NewMoney(10, 1), nil, ProductStatusActive, now, now, nil,
+		WithCostPrice(NewMoney(8, 1)),
+		WithScheduledPriceChanges(
+			ReconstructScheduledPriceChange("s-2", NewMoney(85, 10), earlier, ScheduledPricePending, false, earlier, earlier),
+			ReconstructScheduledPriceChange("s-3", NewMoney(85, 10), earlier, ScheduledPricePending, true, earlier, earlier)))
+	assert.ErrorIs(t, p.ApplyScheduledPriceChange("s-2", now, WithMarginPolicy(policy)), ErrMarginViolation)
+
+	// ...unless the change was scheduled under an allowed override.
+	require.NoError(t, p.ApplyScheduledPriceChange("s-3", now, WithMarginPolicy(policy)))
+	assert.True(t, p.BasePrice().Equals(NewMoney(85, 10)))
+	require.Len(t, p.DomainEvents(), 1)
+	assert.True(t, p.DomainEvents()[0].(*PriceChangedEvent).MarginOverride)
+
+	// Archived products cannot be edited, but the scheduler can still discard their changes.
+	require.NoError(t, p.Deactivate(now))
+	require.NoError(t, p.Archive(now))
+	assert.ErrorIs(t, p.CancelScheduledPriceChange("s-2", now), ErrProductArchived)
+	require.NoError(t, p.DiscardScheduledPriceChange("s-2", ErrProductArchived, now))
+}
+
+func TestScheduledPriceChangeRecordsOverride(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+
+	plain, err := p.SchedulePriceChange("s-1", NewMoney(12, 1), now.Add(time.Hour), now)
+	require.NoError(t, err)
+	assert.False(t, plain.MarginOverride())
+	overridden, err := p.SchedulePriceChange("s-2", NewMoney(12, 1), now.Add(time.Hour), now, WithMarginOverride(RolePricingManager))
+	require.NoError(t, err)
+	assert.True(t, overridden.MarginOverride())
+}
+
+func TestCancelScheduledPriceChange(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	later := now.Add(time.Hour)
+	_, err = p.SchedulePriceChange("s-1", NewMoney(12, 1), later, now)
+	require.NoError(t, err)
+
+	require.NoError(t, p.CancelScheduledPriceChange("s-1", now))
+	change, _ := p.ScheduledPriceChange("s-1")
+	assert.Equal(t, ScheduledPriceCancelled, change.Status())
+	assert.True(t, p.BasePriceAt(later).Equals(NewMoney(10, 1)))
+
+	_, err = p.SchedulePriceChange("s-2", NewMoney(14, 1), later, now)
+	require.NoError(t, err)
+	require.NoError(t, p.Archive(now))
+	assert.ErrorIs(t, p.CancelScheduledPriceChange("s-2", now), ErrProductArchived)
+	change, _ = p.ScheduledPriceChange("s-2")
+	assert.True(t, change.IsPending())
+}
+
+func TestBasePriceAtPicksLatestDueChange(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	base := NewMoney(10, 1)
+	changes := []*ScheduledPriceChange{
+		ReconstructScheduledPriceChange("a", NewMoney(11, 1), t0.Add(time.Hour), ScheduledPricePending, false, t0, t0),
+		ReconstructScheduledPriceChange("b", NewMoney(13, 1), t0.Add(3*time.Hour), ScheduledPricePending, false, t0, t0),
+		ReconstructScheduledPriceChange("c", NewMoney(12, 1), t0.Add(2*time.Hour), ScheduledPriceCancelled, false, t0, t0),
+	}
+
+	assert.True(t, BasePriceAt(base, changes, t0).Equals(base))
+	assert.True(t, BasePriceAt(base, changes, t0.Add(2*time.Hour)).Equals(NewMoney(11, 1)))
+	assert.True(t, BasePriceAt(base, changes, t0.Add(4*time.Hour)).Equals(NewMoney(13, 1)))
+}
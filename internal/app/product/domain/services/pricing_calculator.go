@@ -41,6 +41,34 @@ func (pc *PricingCalculator) CalculateEffectivePrice(
 	return discount.ApplyTo(basePrice)
 }
 
+// CalculateSegmentPrice calculates the final price for a customer segment.
+// The price list entry (if any) replaces or adjusts the base price first; an
+// active product discount is then applied on top of the segment price.
+func (pc *PricingCalculator) CalculateSegmentPrice(
+	basePrice *domain.Money,
+	entry *domain.PriceListEntry,
+	discount *domain.Discount,
+	now time.Time,
+) *domain.Money {
+	segmentPrice := basePrice
+	if entry != nil {
+		segmentPrice = entry.ApplyTo(basePrice)
+	}
+	return pc.CalculateEffectivePrice(segmentPrice, discount, now)
+}
+
+// CalculateRoundedPrice rounds an exact price to its currency's minor units using
+// the mode the policy assigns to the product's category and the price's currency.
+// Rounding is the last pricing step; exact prices are never rounded in between.
+func (pc *PricingCalculator) CalculateRoundedPrice(
+	exactPrice *domain.Money,
+	policy *domain.RoundingPolicy,
+	category string,
+) (*domain.Money, domain.RoundingMode) {
+	mode := policy.ModeFor(category, exactPrice.Currency())
+	return exactPrice.Round(mode), mode
+}
+
 // CalculateSavings calculates how much money is saved with a discount.
 func (pc *PricingCalculator) CalculateSavings(
 	basePrice *domain.Money,
@@ -48,11 +76,11 @@ func (pc *PricingCalculator) CalculateSavings(
 	now time.Time,
 ) *domain.Money {
 	if discount == nil || !discount.IsValidAt(now) {
-		return domain.Zero()
+		return domain.ZeroIn(basePrice.Currency())
 	}
 
-	effectivePrice := discount.ApplyTo(basePrice)
-	return basePrice.Subtract(effectivePrice)
+	// base - (base - base*pct) == base*pct
+	return discount.CalculateDiscountAmount(basePrice)
 }
 
 // CalculateSavingsPercentage calculates the percentage saved with a discount.
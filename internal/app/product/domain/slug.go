@@ -0,0 +1,63 @@
+package domain
+
+import "strings"
+
+const (
+	// defaultProductSlug stands in for names without any letters or digits.
+	defaultProductSlug = "product"
+
+	// slugSuffixLength is how many characters of the product ID DisambiguateSlug appends.
+	slugSuffixLength = 8
+)
+
+// ProductSlug derives a product's URL slug from its name the way Slugify does for
+// categories ("Wireless Mouse" becomes "wireless-mouse").
+func ProductSlug(name string) string {
+	if slug := Slugify(name); slug != "" {
+		return slug
+	}
+	return defaultProductSlug
+}
+
+// slugSuffix returns the product's disambiguating slug suffix, e.g. "-1a2b3c4d".
+func (p *Product) slugSuffix() string {
+	id := strings.ReplaceAll(Slugify(p.id), "-", "")
+	if len(id) > slugSuffixLength {
+		id = id[:slugSuffixLength]
+	}
+	return "-" + id
+}
+
+// renameSlug follows a name change. A slug that DisambiguateSlug derived from the
+// same name is kept.
+func (p *Product) renameSlug(changes map[string]interface{}) {
+	slug := ProductSlug(p.name)
+	if p.slug == slug || p.slug == slug+p.slugSuffix() {
+		return
+	}
+	p.slug = slug
+	p.changes.MarkDirty(FieldSlug)
+	changes["slug"] = p.slug
+}
+
+// DisambiguateSlug appends the start of the product ID to a slug derived from the
+// name, for when another product already uses or used that slug
+// ("wireless-mouse" becomes "wireless-mouse-1a2b3c4d"). The pending creation or
+// update event is amended to carry the final slug.
+func (p *Product) DisambiguateSlug() {
+	if strings.HasSuffix(p.slug, p.slugSuffix()) {
+		return
+	}
+	p.slug += p.slugSuffix()
+	p.changes.MarkDirty(FieldSlug)
+	for _, ev := range p.events {
+		switch e := ev.(type) {
+		case *ProductCreatedEvent:
+			e.Slug = p.slug
+		case *ProductUpdatedEvent:
+			if _, ok := e.Changes["slug"]; ok {
+				e.Changes["slug"] = p.slug
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductSlug(t *testing.T) {
+	assert.Equal(t, "wireless-mouse", ProductSlug(" Wireless Mouse "))
+	assert.Equal(t, "product", ProductSlug("!!!"))
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("1a2b3c4d-5e6f", "Wireless Mouse", "", testCategory("tools"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	assert.Equal(t, "wireless-mouse", p.Slug())
+	assert.Equal(t, "", p.PreviousSlug())
+
+	p.DisambiguateSlug()
+	assert.Equal(t, "wireless-mouse-1a2b3c4d", p.Slug())
+	assert.Equal(t, "wireless-mouse-1a2b3c4d", p.DomainEvents()[0].(*ProductCreatedEvent).Slug)
+	p.DisambiguateSlug()
+	assert.Equal(t, "wireless-mouse-1a2b3c4d", p.Slug(), "suffix is added once")
+}
+
+func TestProductRenameChangesSlug(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := ReconstructProduct("1a2b3c4d-5e6f", "Wireless Mouse", "", "tools", NewMoney(10, 1), nil,
+		ProductStatusActive, now, now, nil, WithSlug("wireless-mouse-1a2b3c4d"))
+
+	// A rename to the same slug keeps the disambiguated slug.
+	require.NoError(t, p.UpdateDetails("WIRELESS MOUSE", "", nil, now))
+	assert.Equal(t, "wireless-mouse-1a2b3c4d", p.Slug())
+	assert.False(t, p.Changes().Dirty(FieldSlug))
+
+	require.NoError(t, p.UpdateDetails("Silent Mouse", "", nil, now))
+	assert.Equal(t, "silent-mouse", p.Slug())
+	assert.Equal(t, "wireless-mouse-1a2b3c4d", p.PreviousSlug())
+	assert.True(t, p.Changes().Dirty(FieldSlug))
+	changes := p.DomainEvents()[1].(*ProductUpdatedEvent).Changes
+	assert.Equal(t, "silent-mouse", changes["slug"])
+
+	p.DisambiguateSlug()
+	assert.Equal(t, "silent-mouse-1a2b3c4d", changes["slug"])
+}
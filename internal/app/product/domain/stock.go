@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxStockQuantity bounds the units on hand at a location and the units of a single
+// adjustment or reservation.
+const MaxStockQuantity = 1_000_000_000
+
+// locationPattern restricts location IDs to lowercase codes (e.g. "berlin-1", "dc_east").
+var locationPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// NormalizeLocationID trims and lowercases a stock location ID and validates it.
+func NormalizeLocationID(locationID string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(locationID))
+	if !locationPattern.MatchString(normalized) {
+		return "", ErrInvalidLocation
+	}
+	return normalized, nil
+}
+
+// StockChangeReason says why a stock level changed.
+type StockChangeReason string
+
+const (
+	StockChangeAdjusted  StockChangeReason = "adjusted"
+	StockChangeReserved  StockChangeReason = "reserved"
+	StockChangeReleased  StockChangeReason = "released"
+	StockChangeFulfilled StockChangeReason = "fulfilled"
+)
+
+// StockLevel is the aggregate root for a product's stock at one location (a warehouse
+// or store). OnHand counts the units there; Reserved counts those held for open orders,
+// which are not available to anyone else.
+//
+// Stock levels are written whole. Version counts the writes so far; the repository
+// checks it inside the commit's transaction, so concurrent writers cannot lose each
+// other's decrements.
+type StockLevel struct {
+	productID         string
+	locationID        string
+	onHand            int64
+	reserved          int64
+	lowStockThreshold int64
+	version           int64
+	updatedAt         time.Time
+	events            []DomainEvent
+}
+
+// NewStockLevel starts an empty stock level for a product at a location.
+func NewStockLevel(productID, locationID string, now time.Time) (*StockLevel, error) {
+	normalized, err := NormalizeLocationID(locationID)
+	if err != nil {
+		return nil, err
+	}
+	return &StockLevel{
+		productID:  productID,
+		locationID: normalized,
+		updatedAt:  now,
+		events:     make([]DomainEvent, 0),
+	}, nil
+}
+
+// ReconstructStockLevel reconstructs a StockLevel from persisted state.
+func ReconstructStockLevel(productID, locationID string, onHand, reserved, lowStockThreshold, version int64, updatedAt time.Time) *StockLevel {
+	return &StockLevel{
+		productID:         productID,
+		locationID:        locationID,
+		onHand:            onHand,
+		reserved:          reserved,
+		lowStockThreshold: lowStockThreshold,
+		version:           version,
+		updatedAt:         updatedAt,
+		events:            make([]DomainEvent, 0),
+	}
+}
+
+// Getters
+
+func (s *StockLevel) ProductID() string {
+	return s.productID
+}
+
+func (s *StockLevel) LocationID() string {
+	return s.locationID
+}
+
+func (s *StockLevel) OnHand() int64 {
+	return s.onHand
+}
+
+func (s *StockLevel) Reserved() int64 {
+	return s.reserved
+}
+
+// Available is the units on hand that are not reserved.
+func (s *StockLevel) Available() int64 {
+	return s.onHand - s.reserved
+}
+
+// LowStockThreshold is the available quantity at or below which the stock is low.
+func (s *StockLevel) LowStockThreshold() int64 {
+	return s.lowStockThreshold
+}
+
+// Version is the number of writes the level had when it was loaded; zero for a new level.
+func (s *StockLevel) Version() int64 {
+	return s.version
+}
+
+func (s *StockLevel) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+func (s *StockLevel) DomainEvents() []DomainEvent {
+	return s.events
+}
+
+// Business Methods
+
+// Adjust changes the units on hand by delta (a delivery, a count correction, a
+// write-off) and, when lowStockThreshold is non-nil, sets the low-stock threshold.
+// The units on hand cannot drop below the reserved units.
+func (s *StockLevel) Adjust(delta int64, lowStockThreshold *int64, now time.Time) error {
+	if delta == 0 && lowStockThreshold == nil {
+		return ErrInvalidStockQuantity
+	}
+	if delta < -MaxStockQuantity || delta > MaxStockQuantity {
+		return ErrInvalidStockQuantity
+	}
+	if lowStockThreshold != nil && (*lowStockThreshold < 0 || *lowStockThreshold > MaxStockQuantity) {
+		return ErrInvalidStockQuantity
+	}
+	onHand := s.onHand + delta
+	if onHand > MaxStockQuantity {
+		return ErrInvalidStockQuantity
+	}
+	if onHand < s.reserved {
+		return ErrInsufficientStock
+	}
+
+	wasLow := s.isLow()
+	s.onHand = onHand
+	if lowStockThreshold != nil {
+		s.lowStockThreshold = *lowStockThreshold
+	}
+	s.changed(StockChangeAdjusted, delta, "", wasLow, now)
+	return nil
+}
+
+// Reserve holds quantity available units of an active product for an order.
+func (s *StockLevel) Reserve(reservationID string, quantity int64, productStatus ProductStatus, now time.Time) (*StockReservation, error) {
+	if quantity <= 0 || quantity > MaxStockQuantity {
+		return nil, ErrInvalidStockQuantity
+	}
+	if productStatus != ProductStatusActive {
+		return nil, ErrProductNotActive
+	}
+	if s.Available() < quantity {
+		return nil, ErrInsufficientStock
+	}
+
+	wasLow := s.isLow()
+	s.reserved += quantity
+	r := &StockReservation{
+		id:         reservationID,
+		productID:  s.productID,
+		locationID: s.locationID,
+		quantity:   quantity,
+		createdAt:  now,
+	}
+	s.changed(StockChangeReserved, 0, r.id, wasLow, now)
+	return r, nil
+}
+
+// Release gives the units of a reservation back. When fulfilled, the order shipped
+// and the units leave the location instead.
+func (s *StockLevel) Release(r *StockReservation, fulfilled bool, now time.Time) error {
+	if r == nil || r.productID != s.productID || r.locationID != s.locationID || r.quantity > s.reserved {
+		return ErrReservationNotFound
+	}
+
+	wasLow := s.isLow()
+	s.reserved -= r.quantity
+	if !fulfilled {
+		s.changed(StockChangeReleased, 0, r.id, wasLow, now)
+		return nil
+	}
+	s.onHand -= r.quantity
+	s.changed(StockChangeFulfilled, -r.quantity, r.id, wasLow, now)
+	return nil
+}
+
+// ClearEvents clears the accumulated domain events.
+func (s *StockLevel) ClearEvents() {
+	s.events = make([]DomainEvent, 0)
+}
+
+// isLow reports whether the available units are at or below the low-stock threshold.
+// With a zero threshold the stock is low once it sells out.
+func (s *StockLevel) isLow() bool {
+	return s.Available() <= s.lowStockThreshold
+}
+
+// changed records a change of the units on hand by delta and raises the stock
+// events; stock.low only when the level just became low.
+func (s *StockLevel) changed(reason StockChangeReason, delta int64, reservationID string, wasLow bool, now time.Time) {
+	s.updatedAt = now
+	s.events = append(s.events, &StockLevelChangedEvent{
+		ProductID:         s.productID,
+		LocationID:        s.locationID,
+		Reason:            reason,
+		OnHandDelta:       delta,
+		OnHand:            s.onHand,
+		Reserved:          s.reserved,
+		Available:         s.Available(),
+		LowStockThreshold: s.lowStockThreshold,
+		ReservationID:     reservationID,
+		ChangedAt:         now,
+	})
+	if !wasLow && s.isLow() {
+		s.events = append(s.events, &LowStockEvent{
+			ProductID:         s.productID,
+			LocationID:        s.locationID,
+			Available:         s.Available(),
+			LowStockThreshold: s.lowStockThreshold,
+			DetectedAt:        now,
+		})
+	}
+}
+
+// StockReservation holds units of a product at a location for an order until it is
+// released or fulfilled.
+type StockReservation struct {
+	id         string
+	productID  string
+	locationID string
+	quantity   int64
+	createdAt  time.Time
+}
+
+// ReconstructStockReservation reconstructs a StockReservation from persisted state.
+func ReconstructStockReservation(id, productID, locationID string, quantity int64, createdAt time.Time) *StockReservation {
+	return &StockReservation{
+		id:         id,
+		productID:  productID,
+		locationID: locationID,
+		quantity:   quantity,
+		createdAt:  createdAt,
+	}
+}
+
+func (r *StockReservation) ID() string {
+	return r.id
+}
+
+func (r *StockReservation) ProductID() string {
+	return r.productID
+}
+
+func (r *StockReservation) LocationID() string {
+	return r.locationID
+}
+
+func (r *StockReservation) Quantity() int64 {
+	return r.quantity
+}
+
+func (r *StockReservation) CreatedAt() time.Time {
+	return r.createdAt
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLocationID(t *testing.T) {
+	id, err := NormalizeLocationID(" Berlin-1 ")
+	require.NoError(t, err)
+	assert.Equal(t, "berlin-1", id)
+
+	_, err = NormalizeLocationID("dc east")
+	assert.ErrorIs(t, err, ErrInvalidLocation)
+	_, err = NormalizeLocationID("")
+	assert.ErrorIs(t, err, ErrInvalidLocation)
+}
+
+func TestStockLevelAdjust(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s, err := NewStockLevel("prod-1", "berlin-1", now)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, s.Adjust(0, nil, now), ErrInvalidStockQuantity)
+	assert.ErrorIs(t, s.Adjust(-1, nil, now), ErrInsufficientStock)
+	assert.ErrorIs(t, s.Adjust(MaxStockQuantity+1, nil, now), ErrInvalidStockQuantity)
+	negative := int64(-1)
+	assert.ErrorIs(t, s.Adjust(5, &negative, now), ErrInvalidStockQuantity)
+	assert.Empty(t, s.DomainEvents())
+
+	threshold := int64(3)
+	require.NoError(t, s.Adjust(10, &threshold, now))
+	assert.Equal(t, int64(10), s.Available())
+	require.Len(t, s.DomainEvents(), 1)
+	ev := s.DomainEvents()[0].(*StockLevelChangedEvent)
+	assert.Equal(t, StockChangeAdjusted, ev.Reason)
+	assert.Equal(t, int64(10), ev.OnHandDelta)
+
+	// Falling to the threshold raises stock.low once.
+	require.NoError(t, s.Adjust(-7, nil, now))
+	require.Len(t, s.DomainEvents(), 3)
+	low := s.DomainEvents()[2].(*LowStockEvent)
+	assert.Equal(t, int64(3), low.Available)
+	require.NoError(t, s.Adjust(-1, nil, now))
+	assert.Len(t, s.DomainEvents(), 4)
+}
+
+func TestStockLevelReservations(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := ReconstructStockLevel("prod-1", "berlin-1", 5, 0, 0, 3, now)
+
+	_, err := s.Reserve("res-1", 0, ProductStatusActive, now)
+	assert.ErrorIs(t, err, ErrInvalidStockQuantity)
+	_, err = s.Reserve("res-1", 2, ProductStatusInactive, now)
+	assert.ErrorIs(t, err, ErrProductNotActive)
+	_, err = s.Reserve("res-1", 6, ProductStatusActive, now)
+	assert.ErrorIs(t, err, ErrInsufficientStock)
+
+	r, err := s.Reserve("res-1", 2, ProductStatusActive, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), r.Quantity())
+	assert.Equal(t, int64(3), s.Available())
+
+	// Reserved units cannot be written off.
+	assert.ErrorIs(t, s.Adjust(-4, nil, now), ErrInsufficientStock)
+
+	// Reserving the rest sells the location out.
+	rest, err := s.Reserve("res-2", 3, ProductStatusActive, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), s.Available())
+	assert.IsType(t, &LowStockEvent{}, s.DomainEvents()[len(s.DomainEvents())-1])
+
+	require.NoError(t, s.Release(rest, false, now))
+	assert.Equal(t, int64(5), s.OnHand())
+	assert.Equal(t, int64(3), s.Available())
+
+	require.NoError(t, s.Release(r, true, now))
+	assert.Equal(t, int64(3), s.OnHand())
+	assert.Equal(t, int64(0), s.Reserved())
+	ev := s.DomainEvents()[len(s.DomainEvents())-1].(*StockLevelChangedEvent)
+	assert.Equal(t, StockChangeFulfilled, ev.Reason)
+	assert.Equal(t, int64(-2), ev.OnHandDelta)
+	assert.Equal(t, "res-1", ev.ReservationID)
+
+	other := ReconstructStockReservation("res-3", "prod-1", "hamburg", 1, now)
+	assert.ErrorIs(t, s.Release(other, false, now), ErrReservationNotFound)
+
+	// The version is the one loaded; the repository bumps it on save.
+	assert.Equal(t, int64(3), s.Version())
+}
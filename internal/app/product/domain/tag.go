@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// MaxProductTags is the most tags a product can carry.
+	MaxProductTags = 20
+
+	maxTagLength = 50
+)
+
+// tagPattern restricts tags to lowercase words joined by hyphens (e.g. "holiday-2026").
+var tagPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+var tagSeparators = regexp.MustCompile(`[\s_]+`)
+
+// NormalizeTag returns the canonical form of a tag: trimmed, lower-cased, with runs
+// of spaces and underscores replaced by a hyphen ("Holiday 2026" becomes "holiday-2026").
+func NormalizeTag(tag string) (string, error) {
+	tag = tagSeparators.ReplaceAllString(strings.ToLower(strings.TrimSpace(tag)), "-")
+	if len(tag) > maxTagLength || !tagPattern.MatchString(tag) {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
+
+// normalizeTags normalizes tags, dropping duplicates while keeping their order.
+func normalizeTags(tags []string) ([]string, error) {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tag, err := NormalizeTag(t)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			out = append(out, tag)
+		}
+	}
+	return out, nil
+}
+
+// fieldTagPrefix prefixes the dirty-field name of a product tag, e.g. "tag:eco".
+// Use TagField to build it.
+const fieldTagPrefix = "tag:"
+
+// TagField returns the change-tracking field name for a tag.
+func TagField(tag string) string {
+	return fieldTagPrefix + tag
+}
+
+// TagFromField returns the tag of a tag field, or false if the field is not a tag field.
+func TagFromField(field string) (string, bool) {
+	if !strings.HasPrefix(field, fieldTagPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(field, fieldTagPrefix), true
+}
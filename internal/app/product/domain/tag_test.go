@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	for in, want := range map[string]string{
+		"eco":            "eco",
+		" New ":          "new",
+		"Holiday 2026":   "holiday-2026",
+		"back_to_school": "back-to-school",
+	} {
+		got, err := NormalizeTag(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"", "  ", "eco!", "-sale", "a--b", string(make([]byte, 51))} {
+		_, err := NormalizeTag(in)
+		assert.ErrorIs(t, err, ErrInvalidTag, in)
+	}
+}
+
+func TestProductTags(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Widget", "", testCategory("tools"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	p.ClearEvents()
+
+	require.NoError(t, p.AddTags([]string{"New", "eco", " ECO "}, now))
+	assert.Equal(t, []string{"eco", "new"}, p.Tags())
+	assert.True(t, p.HasTag("eco"))
+	assert.True(t, p.Changes().Dirty(TagField("eco")))
+	require.Len(t, p.DomainEvents(), 1)
+	ev := p.DomainEvents()[0].(*ProductUpdatedEvent)
+	assert.Equal(t, "product.updated", ev.EventType())
+	assert.Equal(t, []string{"new", "eco"}, ev.Changes["tags_added"])
+
+	// Adding tags the product already has, or removing ones it lacks, changes nothing.
+	p.ClearEvents()
+	p.Changes().Clear()
+	require.NoError(t, p.AddTags([]string{"eco"}, now))
+	require.NoError(t, p.RemoveTags([]string{"holiday-2026"}, now))
+	assert.Empty(t, p.DomainEvents())
+	assert.False(t, p.Changes().HasChanges())
+
+	assert.ErrorIs(t, p.AddTags([]string{"ok", "not ok!"}, now), ErrInvalidTag)
+	assert.Equal(t, []string{"eco", "new"}, p.Tags())
+
+	require.NoError(t, p.RemoveTags([]string{"NEW"}, now))
+	assert.Equal(t, []string{"eco"}, p.Tags())
+	assert.True(t, p.Changes().Dirty(TagField("new")))
+
+	many := make([]string, 0, MaxProductTags)
+	for i := 0; i < MaxProductTags; i++ {
+		many = append(many, fmt.Sprintf("tag-%d", i))
+	}
+	assert.ErrorIs(t, p.AddTags(many, now), ErrTooManyTags)
+	assert.Equal(t, []string{"eco"}, p.Tags())
+
+	archived := ReconstructProduct("prod-2", "Old", "", "tools", NewMoney(10, 1), nil, ProductStatusArchived,
+		now, now, &now, WithTags("eco"))
+	assert.ErrorIs(t, archived.AddTags([]string{"new"}, now), ErrProductArchived)
+	assert.ErrorIs(t, archived.RemoveTags([]string{"eco"}, now), ErrProductArchived)
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// TaxCategory classifies a product for sales tax (e.g. "standard", "reduced", "zero").
+// Tax rates are defined per region and category.
+type TaxCategory string
+
+// DefaultTaxCategory applies to products created without a tax category.
+const DefaultTaxCategory TaxCategory = "standard"
+
+var (
+	taxCategoryPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+	taxRegionPattern   = regexp.MustCompile(`^[A-Z]{2}(-[A-Z0-9]{1,5})?$`)
+)
+
+// ParseTaxCategory normalizes a tax category code to lower case.
+// An empty code yields DefaultTaxCategory.
+func ParseTaxCategory(code string) (TaxCategory, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if code == "" {
+		return DefaultTaxCategory, nil
+	}
+	if !taxCategoryPattern.MatchString(code) {
+		return "", ErrInvalidTaxCategory
+	}
+	return TaxCategory(code), nil
+}
+
+func (c TaxCategory) String() string {
+	return string(c)
+}
+
+// TaxRegion is the region a tax rate applies in: an ISO 3166-1 alpha-2 country
+// code, optionally followed by a subdivision (e.g. "DE", "US-CA").
+type TaxRegion string
+
+// ParseTaxRegion normalizes a tax region code to upper case.
+func ParseTaxRegion(code string) (TaxRegion, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !taxRegionPattern.MatchString(code) {
+		return "", ErrInvalidTaxRegion
+	}
+	return TaxRegion(code), nil
+}
+
+func (r TaxRegion) String() string {
+	return string(r)
+}
+
+// TaxRate is a value object holding the sales tax rate for one tax category in a region.
+// A rate applies from its effective time until a later rate for the same region and
+// category takes over. Rate is a fraction of the net price (e.g. 0.19 for 19% VAT).
+type TaxRate struct {
+	region      TaxRegion
+	category    TaxCategory
+	rate        *big.Rat
+	effectiveAt time.Time
+}
+
+// NewTaxRate creates a TaxRate. The rate must be in [0, 1] and storable exactly
+// as a NUMERIC.
+func NewTaxRate(region TaxRegion, category TaxCategory, rate *big.Rat, effectiveAt time.Time) (*TaxRate, error) {
+	if !taxRegionPattern.MatchString(string(region)) {
+		return nil, ErrInvalidTaxRegion
+	}
+	if !taxCategoryPattern.MatchString(string(category)) {
+		return nil, ErrInvalidTaxCategory
+	}
+	if rate == nil || rate.Sign() < 0 || rate.Cmp(big.NewRat(1, 1)) > 0 {
+		return nil, ErrInvalidTaxRate
+	}
+	if _, ok := numericString(rate); !ok {
+		return nil, ErrInvalidTaxRate
+	}
+	if effectiveAt.IsZero() {
+		return nil, ErrInvalidTaxRate
+	}
+	return &TaxRate{
+		region:      region,
+		category:    category,
+		rate:        new(big.Rat).Set(rate),
+		effectiveAt: effectiveAt.UTC(),
+	}, nil
+}
+
+func (r *TaxRate) Region() TaxRegion {
+	return r.region
+}
+
+func (r *TaxRate) Category() TaxCategory {
+	return r.category
+}
+
+// Rate returns a copy of the rate as a fraction of the net price.
+func (r *TaxRate) Rate() *big.Rat {
+	return new(big.Rat).Set(r.rate)
+}
+
+// RateString returns the rate as an exact decimal fraction (e.g. "0.19").
+func (r *TaxRate) RateString() string {
+	s, _ := numericString(r.rate)
+	return s
+}
+
+func (r *TaxRate) EffectiveAt() time.Time {
+	return r.effectiveAt
+}
+
+// SetEvent returns the event announcing that this rate was stored.
+func (r *TaxRate) SetEvent(now time.Time) *TaxRateSetEvent {
+	return &TaxRateSetEvent{
+		Region:      r.region,
+		Category:    r.category,
+		Rate:        r.RateString(),
+		EffectiveAt: r.effectiveAt,
+		SetAt:       now,
+	}
+}
+
+// TaxBreakdown splits a price into its net amount, the tax on it and the gross total.
+// All amounts are exact; rounding is left to the caller's rounding policy.
+type TaxBreakdown struct {
+	Net   *Money
+	Tax   *Money
+	Gross *Money
+	Rate  *TaxRate
+}
+
+// Apply computes the tax on a net price with exact rational arithmetic.
+func (r *TaxRate) Apply(net *Money) *TaxBreakdown {
+	tax := net.MultiplyByRat(r.rate)
+	return &TaxBreakdown{
+		Net:   net,
+		Tax:   tax,
+		Gross: net.add(tax),
+		Rate:  r,
+	}
+}
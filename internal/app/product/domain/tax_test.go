@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxRateApply(t *testing.T) {
+	effective := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rate, err := NewTaxRate("DE", DefaultTaxCategory, big.NewRat(19, 100), effective)
+	require.NoError(t, err)
+	assert.Equal(t, "0.19", rate.RateString())
+
+	b := rate.Apply(NewMoneyIn("EUR", 1999, 100))
+	assert.Equal(t, "37981/10000", b.Tax.Rat().RatString()) // 3.7981
+	assert.Equal(t, "237881/10000", b.Gross.Rat().RatString())
+	assert.Equal(t, Currency("EUR"), b.Gross.Currency())
+	assert.Equal(t, "23.79", b.Gross.Round(RoundHalfUp).FloatString(2))
+}
+
+func TestNewTaxRateValidation(t *testing.T) {
+	effective := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewTaxRate("DE", "reduced", big.NewRat(0, 1), effective)
+	require.NoError(t, err, "zero-rated goods are valid")
+
+	for _, r := range []*big.Rat{nil, big.NewRat(-1, 100), big.NewRat(101, 100), big.NewRat(1, 3)} {
+		_, err := NewTaxRate("DE", "reduced", r, effective)
+		assert.ErrorIs(t, err, ErrInvalidTaxRate)
+	}
+	_, err = NewTaxRate("DE", "reduced", big.NewRat(7, 100), time.Time{})
+	assert.ErrorIs(t, err, ErrInvalidTaxRate)
+	_, err = NewTaxRate("Germany", "reduced", big.NewRat(7, 100), effective)
+	assert.ErrorIs(t, err, ErrInvalidTaxRegion)
+	_, err = NewTaxRate("DE", "Reduced Rate", big.NewRat(7, 100), effective)
+	assert.ErrorIs(t, err, ErrInvalidTaxCategory)
+}
+
+func TestParseTaxCodes(t *testing.T) {
+	region, err := ParseTaxRegion(" us-ca ")
+	require.NoError(t, err)
+	assert.Equal(t, TaxRegion("US-CA"), region)
+
+	category, err := ParseTaxCategory("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTaxCategory, category)
+	category, err = ParseTaxCategory("Reduced")
+	require.NoError(t, err)
+	assert.Equal(t, TaxCategory("reduced"), category)
+}
+
+func TestSetTaxCategory(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Book", "", testCategory("books"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTaxCategory, p.TaxCategory())
+
+	require.NoError(t, p.SetTaxCategory(DefaultTaxCategory, now))
+	assert.False(t, p.Changes().Dirty(FieldTaxCategory))
+
+	require.NoError(t, p.SetTaxCategory("reduced", now))
+	assert.True(t, p.Changes().Dirty(FieldTaxCategory))
+	assert.ErrorIs(t, p.SetTaxCategory("", now), ErrInvalidTaxCategory)
+}
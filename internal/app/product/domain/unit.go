@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+)
+
+// UnitOfMeasure is the unit a product's package quantity is expressed in (e.g. "g", "l").
+type UnitOfMeasure string
+
+const (
+	UnitMilligram  UnitOfMeasure = "mg"
+	UnitGram       UnitOfMeasure = "g"
+	UnitKilogram   UnitOfMeasure = "kg"
+	UnitMillilitre UnitOfMeasure = "ml"
+	UnitCentilitre UnitOfMeasure = "cl"
+	UnitLitre      UnitOfMeasure = "l"
+	UnitMillimetre UnitOfMeasure = "mm"
+	UnitCentimetre UnitOfMeasure = "cm"
+	UnitMetre      UnitOfMeasure = "m"
+	UnitPiece      UnitOfMeasure = "pc"
+)
+
+// unitConversion converts a unit into the reference unit of its dimension:
+// one unit equals num/den reference units.
+type unitConversion struct {
+	reference UnitOfMeasure
+	num, den  int64
+}
+
+// units lists the supported units. Unit prices are quoted per reference unit
+// (per kg, per litre, per metre, per piece), as grocery regulations require.
+var units = map[UnitOfMeasure]unitConversion{
+	UnitMilligram:  {UnitKilogram, 1, 1_000_000},
+	UnitGram:       {UnitKilogram, 1, 1000},
+	UnitKilogram:   {UnitKilogram, 1, 1},
+	UnitMillilitre: {UnitLitre, 1, 1000},
+	UnitCentilitre: {UnitLitre, 1, 100},
+	UnitLitre:      {UnitLitre, 1, 1},
+	UnitMillimetre: {UnitMetre, 1, 1000},
+	UnitCentimetre: {UnitMetre, 1, 100},
+	UnitMetre:      {UnitMetre, 1, 1},
+	UnitPiece:      {UnitPiece, 1, 1},
+}
+
+// ParseUnitOfMeasure normalizes a unit code to lower case and checks it is supported.
+// "L" is accepted for litres.
+func ParseUnitOfMeasure(code string) (UnitOfMeasure, error) {
+	u := UnitOfMeasure(strings.ToLower(strings.TrimSpace(code)))
+	if _, ok := units[u]; !ok {
+		return "", ErrUnsupportedUnit
+	}
+	return u, nil
+}
+
+func (u UnitOfMeasure) String() string {
+	return string(u)
+}
+
+// ReferenceUnit returns the unit unit prices are quoted in for u's dimension.
+func (u UnitOfMeasure) ReferenceUnit() UnitOfMeasure {
+	return units[u].reference
+}
+
+// PackageSize is a value object holding how much of a unit one product contains (e.g. 500 g).
+type PackageSize struct {
+	quantity *big.Rat
+	unit     UnitOfMeasure
+}
+
+// NewPackageSize creates a PackageSize. The quantity must be positive and storable
+// exactly as a NUMERIC, and the unit must be supported.
+func NewPackageSize(quantity *big.Rat, unit UnitOfMeasure) (*PackageSize, error) {
+	conv, ok := units[unit]
+	if !ok {
+		return nil, ErrUnsupportedUnit
+	}
+	if quantity == nil || quantity.Sign() <= 0 {
+		return nil, ErrInvalidPackageSize
+	}
+	if _, ok := numericString(quantity); !ok {
+		return nil, ErrInvalidPackageSize
+	}
+	// The unit price divides by the quantity in reference units via an int64 fraction.
+	ref := new(big.Rat).Mul(quantity, big.NewRat(conv.num, conv.den))
+	if !ref.Num().IsInt64() || !ref.Denom().IsInt64() {
+		return nil, ErrInvalidPackageSize
+	}
+	return &PackageSize{quantity: new(big.Rat).Set(quantity), unit: unit}, nil
+}
+
+// Quantity returns a copy of the quantity in Unit.
+func (s *PackageSize) Quantity() *big.Rat {
+	return new(big.Rat).Set(s.quantity)
+}
+
+// QuantityString returns the quantity as an exact decimal (e.g. "0.75").
+func (s *PackageSize) QuantityString() string {
+	q, _ := numericString(s.quantity)
+	return q
+}
+
+func (s *PackageSize) Unit() UnitOfMeasure {
+	return s.unit
+}
+
+// Equals reports whether both sizes have the same quantity and unit; nil equals nil.
+func (s *PackageSize) Equals(other *PackageSize) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	return s.unit == other.unit && s.quantity.Cmp(other.quantity) == 0
+}
+
+// String returns e.g. "500 g".
+func (s *PackageSize) String() string {
+	return s.QuantityString() + " " + s.unit.String()
+}
+
+// UnitPrice returns the price per reference unit (e.g. per kg for a 500 g package)
+// with exact rational arithmetic. The result is not rounded.
+func (s *PackageSize) UnitPrice(price *Money) (*Money, UnitOfMeasure) {
+	conv := units[s.unit]
+	ref := new(big.Rat).Mul(s.quantity, big.NewRat(conv.num, conv.den))
+	return price.MultiplyByFraction(ref.Denom().Int64(), ref.Num().Int64()), conv.reference
+}
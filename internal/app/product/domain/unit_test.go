@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageSizeUnitPrice(t *testing.T) {
+	cases := []struct {
+		quantity string
+		unit     UnitOfMeasure
+		price    *Money
+		expected string // exact unit price
+		ref      UnitOfMeasure
+	}{
+		{"500", UnitGram, NewMoneyIn("EUR", 249, 100), "249/50", UnitKilogram}, // 4.98 per kg
+		{"0.75", UnitLitre, NewMoneyIn("EUR", 899, 100), "899/75", UnitLitre},  // 11.9866... per l
+		{"33", UnitCentilitre, NewMoneyIn("EUR", 99, 100), "3", UnitLitre},     // 3.00 per l
+		{"6", UnitPiece, NewMoneyIn("EUR", 300, 100), "1/2", UnitPiece},        // 0.50 per piece
+		{"250", UnitMilligram, NewMoneyIn("EUR", 1, 1), "4000", UnitKilogram},  // 4000 per kg
+		{"150", UnitCentimetre, NewMoneyIn("EUR", 6, 1), "4", UnitMetre},       // 4.00 per m
+	}
+	for _, tc := range cases {
+		t.Run(tc.quantity+tc.unit.String(), func(t *testing.T) {
+			q, ok := new(big.Rat).SetString(tc.quantity)
+			require.True(t, ok)
+			size, err := NewPackageSize(q, tc.unit)
+			require.NoError(t, err)
+
+			price, ref := size.UnitPrice(tc.price)
+			assert.Equal(t, tc.expected, price.Rat().RatString())
+			assert.Equal(t, tc.ref, ref)
+			assert.Equal(t, tc.price.Currency(), price.Currency())
+		})
+	}
+}
+
+func TestNewPackageSizeValidation(t *testing.T) {
+	_, err := NewPackageSize(big.NewRat(1, 1), "lb")
+	assert.ErrorIs(t, err, ErrUnsupportedUnit)
+
+	for _, q := range []*big.Rat{nil, big.NewRat(0, 1), big.NewRat(-1, 1), big.NewRat(1, 3)} {
+		_, err := NewPackageSize(q, UnitGram)
+		assert.ErrorIs(t, err, ErrInvalidPackageSize)
+	}
+
+	u, err := ParseUnitOfMeasure(" L ")
+	require.NoError(t, err)
+	assert.Equal(t, UnitLitre, u)
+}
+
+func TestSetPackageSize(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewProduct("prod-1", "Coffee", "", testCategory("grocery"), NewMoney(10, 1), now)
+	require.NoError(t, err)
+
+	size, err := NewPackageSize(big.NewRat(500, 1), UnitGram)
+	require.NoError(t, err)
+	require.NoError(t, p.SetPackageSize(size, now))
+	assert.True(t, p.Changes().Dirty(FieldPackageSize))
+	assert.Equal(t, "500 g", p.PackageSize().String())
+
+	p.Changes().Clear()
+	same, err := NewPackageSize(big.NewRat(500, 1), UnitGram)
+	require.NoError(t, err)
+	require.NoError(t, p.SetPackageSize(same, now))
+	assert.False(t, p.Changes().Dirty(FieldPackageSize))
+
+	require.NoError(t, p.SetPackageSize(nil, now))
+	assert.Nil(t, p.PackageSize())
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// VariantStatus is the lifecycle state of a product variant.
+type VariantStatus string
+
+const (
+	// VariantStatusActive variants are sellable while their product is active.
+	VariantStatusActive VariantStatus = "active"
+
+	// VariantStatusInactive variants are temporarily not sellable.
+	VariantStatusInactive VariantStatus = "inactive"
+)
+
+// ParseVariantStatus validates a variant status.
+func ParseVariantStatus(s string) (VariantStatus, error) {
+	switch VariantStatus(strings.ToLower(strings.TrimSpace(s))) {
+	case VariantStatusActive:
+		return VariantStatusActive, nil
+	case VariantStatusInactive:
+		return VariantStatusInactive, nil
+	}
+	return "", ErrInvalidVariantStatus
+}
+
+const (
+	maxSKULength         = 64
+	maxVariantOptions    = 10
+	maxOptionNameLength  = 50
+	maxOptionValueLength = 100
+)
+
+// fieldVariantPrefix prefixes the dirty-field name of a variant, e.g. "variant:<id>".
+// Use VariantField to build it.
+const fieldVariantPrefix = "variant:"
+
+// VariantField returns the change-tracking field name for a variant.
+func VariantField(id string) string {
+	return fieldVariantPrefix + id
+}
+
+// VariantIDFromField returns the id of a variant field, or false if the field is not a variant field.
+func VariantIDFromField(field string) (string, bool) {
+	if !strings.HasPrefix(field, fieldVariantPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(field, fieldVariantPrefix), true
+}
+
+// VariantOptions are a variant's option values keyed by option name, e.g.
+// {"size": "M", "color": "red"}. Names are lower-cased; values keep their case.
+type VariantOptions map[string]string
+
+// NewVariantOptions validates and normalizes option values. A variant needs at
+// least one option; names and values must be non-empty after trimming.
+func NewVariantOptions(in map[string]string) (VariantOptions, error) {
+	if len(in) == 0 || len(in) > maxVariantOptions {
+		return nil, ErrInvalidVariantOptions
+	}
+	out := make(VariantOptions, len(in))
+	for name, value := range in {
+		name = strings.ToLower(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+		if name == "" || value == "" || len(name) > maxOptionNameLength || len(value) > maxOptionValueLength ||
+			strings.ContainsAny(name, "=;") {
+			return nil, ErrInvalidVariantOptions
+		}
+		if _, dup := out[name]; dup {
+			return nil, ErrInvalidVariantOptions
+		}
+		out[name] = value
+	}
+	return out, nil
+}
+
+// Names returns the option names in alphabetical order.
+func (o VariantOptions) Names() []string {
+	names := make([]string, 0, len(o))
+	for name := range o {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Key renders the options canonically ("color=red;size=M"); equal option sets have equal keys.
+func (o VariantOptions) Key() string {
+	parts := make([]string, 0, len(o))
+	for _, name := range o.Names() {
+		parts = append(parts, name+"="+strings.ToLower(o[name]))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Equal reports whether both option sets have exactly the same values.
+func (o VariantOptions) Equal(other VariantOptions) bool {
+	if len(o) != len(other) {
+		return false
+	}
+	for name, value := range o {
+		if v, ok := other[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (o VariantOptions) clone() VariantOptions {
+	out := make(VariantOptions, len(o))
+	for k, v := range o {
+		out[k] = v
+	}
+	return out
+}
+
+// NormalizeSKU trims a SKU and validates it: 1-64 characters without whitespace.
+func NormalizeSKU(sku string) (string, error) {
+	sku = strings.TrimSpace(sku)
+	if sku == "" || len(sku) > maxSKULength || strings.IndexFunc(sku, unicode.IsSpace) >= 0 {
+		return "", ErrInvalidSKU
+	}
+	return sku, nil
+}
+
+// Variant is a sellable version of a product (e.g. size M in red). It is an entity
+// owned by the Product aggregate with its own SKU and status. Without a price of
+// its own it sells at the product's base price; the product's discount applies either way.
+type Variant struct {
+	id        string
+	sku       string
+	options   VariantOptions
+	price     *Money
+	status    VariantStatus
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// ReconstructVariant reconstructs a variant from persisted state. price may be nil.
+func ReconstructVariant(id, sku string, options VariantOptions, price *Money, status VariantStatus, createdAt, updatedAt time.Time) *Variant {
+	return &Variant{
+		id:        id,
+		sku:       sku,
+		options:   options,
+		price:     price,
+		status:    status,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (v *Variant) ID() string {
+	return v.id
+}
+
+func (v *Variant) SKU() string {
+	return v.sku
+}
+
+// Options returns a copy of the variant's option values.
+func (v *Variant) Options() VariantOptions {
+	return v.options.clone()
+}
+
+// Price returns the variant's own base price, or nil when it inherits the product's.
+func (v *Variant) Price() *Money {
+	return v.price
+}
+
+func (v *Variant) Status() VariantStatus {
+	return v.status
+}
+
+func (v *Variant) IsActive() bool {
+	return v.status == VariantStatusActive
+}
+
+func (v *Variant) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+func (v *Variant) UpdatedAt() time.Time {
+	return v.updatedAt
+}
+
+// VariantBasePrice returns the base price a variant sells at: its own price when
+// set, otherwise the product's base price.
+func VariantBasePrice(productBase *Money, v *Variant) *Money {
+	if v.price != nil {
+		return v.price
+	}
+	return productBase
+}
+
+// VariantUpdate lists the changes to a variant; nil fields are left unchanged.
+// ClearPrice makes the variant inherit the product's base price again.
+type VariantUpdate struct {
+	SKU        *string
+	Options    map[string]string
+	Price      *Money
+	ClearPrice bool
+	Status     *VariantStatus
+}
+
+// sortVariants orders variants by SKU, then id.
+func sortVariants(variants []*Variant) {
+	sort.Slice(variants, func(i, j int) bool {
+		if variants[i].sku != variants[j].sku {
+			return variants[i].sku < variants[j].sku
+		}
+		return variants[i].id < variants[j].id
+	})
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddVariant(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := newCostedProduct(t, now)
+
+	v, err := p.AddVariant("var-1", " TEE-M ", map[string]string{" Size ": "M", "color": "Black"}, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, "TEE-M", v.SKU())
+	assert.Equal(t, "M", v.Options()["size"])
+	assert.Equal(t, VariantStatusActive, v.Status())
+	assert.True(t, p.Changes().Dirty(VariantField("var-1")))
+
+	_, err = p.AddVariant("var-2", "TEE-M", map[string]string{"size": "L"}, nil, now)
+	assert.ErrorIs(t, err, ErrDuplicateVariantSKU)
+	_, err = p.AddVariant("var-2", "TEE-M2", map[string]string{"SIZE": "m", "color": "black"}, nil, now)
+	assert.ErrorIs(t, err, ErrDuplicateVariantOptions)
+	_, err = p.AddVariant("var-2", "TEE M", map[string]string{"size": "L"}, nil, now)
+	assert.ErrorIs(t, err, ErrInvalidSKU)
+	_, err = p.AddVariant("var-2", "TEE-L", nil, nil, now)
+	assert.ErrorIs(t, err, ErrInvalidVariantOptions)
+	_, err = p.AddVariant("var-2", "TEE-L", map[string]string{"size": "L"}, NewMoneyIn(Currency("EUR"), 12, 1), now)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	// 7.00 is below the 8.00 cost.
+	_, err = p.AddVariant("var-2", "TEE-L", map[string]string{"size": "L"}, NewMoney(7, 1), now)
+	assert.ErrorIs(t, err, ErrMarginViolation)
+
+	v, err = p.AddVariant("var-2", "TEE-L", map[string]string{"size": "L"}, NewMoney(12, 1), now)
+	require.NoError(t, err)
+	assert.Equal(t, "12", VariantBasePrice(p.BasePrice(), v).Rat().RatString())
+	first, ok := p.Variant("var-1")
+	require.True(t, ok)
+	assert.Equal(t, "10", VariantBasePrice(p.BasePrice(), first).Rat().RatString())
+	require.Len(t, p.Variants(), 2)
+	assert.Equal(t, "TEE-L", p.Variants()[0].SKU())
+}
+
+func TestUpdateAndRemoveVariant(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := newCostedProduct(t, now)
+	_, err := p.AddVariant("var-1", "TEE-M", map[string]string{"size": "M"}, NewMoney(12, 1), now)
+	require.NoError(t, err)
+	_, err = p.AddVariant("var-2", "TEE-L", map[string]string{"size": "L"}, nil, now)
+	require.NoError(t, err)
+
+	inactive := VariantStatusInactive
+	later := now.Add(time.Minute)
+	assert.ErrorIs(t, p.UpdateVariant("var-1", VariantUpdate{Price: NewMoney(13, 1), ClearPrice: true}, later), ErrInvalidVariantPrice)
+	assert.ErrorIs(t, p.UpdateVariant("var-1", VariantUpdate{Options: map[string]string{"size": "l"}}, later), ErrDuplicateVariantOptions)
+	assert.ErrorIs(t, p.UpdateVariant("missing", VariantUpdate{Status: &inactive}, later), ErrVariantNotFound)
+
+	require.NoError(t, p.UpdateVariant("var-1", VariantUpdate{ClearPrice: true, Status: &inactive}, later))
+	v, ok := p.Variant("var-1")
+	require.True(t, ok)
+	assert.Nil(t, v.Price())
+	assert.Equal(t, VariantStatusInactive, v.Status())
+	assert.Equal(t, later, v.UpdatedAt())
+
+	var updated *VariantUpdatedEvent
+	for _, ev := range p.DomainEvents() {
+		if e, ok := ev.(*VariantUpdatedEvent); ok {
+			updated = e
+		}
+	}
+	require.NotNil(t, updated)
+	assert.Contains(t, updated.Changes, "price")
+	assert.Equal(t, "inactive", updated.Changes["status"])
+
+	require.NoError(t, p.RemoveVariant("var-2", later))
+	_, ok = p.Variant("var-2")
+	assert.False(t, ok)
+	assert.ErrorIs(t, p.RemoveVariant("var-2", later), ErrVariantNotFound)
+}
+
+func TestApplyDiscountChecksVariantPrices(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := newCostedProduct(t, now)
+	// A 9.00 variant is cheaper than the 10.00 product.
+	_, err := p.AddVariant("var-1", "TEE-S", map[string]string{"size": "S"}, NewMoney(9, 1), now)
+	require.NoError(t, err)
+
+	// 15% off keeps the product at 8.50 but takes the variant to 7.65, below cost.
+	d, err := NewDiscountFromRat(big.NewRat(15, 100), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.ErrorIs(t, p.ApplyDiscount(d, now), ErrMarginViolation)
+}
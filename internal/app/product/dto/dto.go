@@ -4,12 +4,23 @@ package dto
 // Timestamps and optional fields use *string (RFC3339) to mirror how they
 // typically come from Spanner/SQL. Use helpers to parse them into time.Time.
 type ProductDTO struct {
-	ProductID     string
-	Name          string
-	Description   *string
-	Category      string
-	BasePriceNum  int64
-	BasePriceDen  int64
+	ProductID   string
+	Name        string
+	Description *string
+	Category    string // category slug
+	CategoryID  string
+	TaxCategory string
+	Slug        string
+	// Name and Description are in Locale: the first of the reader's locales (see
+	// contracts.ProductReadOptions.Locales) the product has a name in, else DefaultLocale.
+	// A missing description falls back along the same locales.
+	Locale        string
+	DefaultLocale string
+	SKU           *string
+	GTIN          *string // GTIN-14
+	BasePrice     string  // exact NUMERIC decimal
+	Currency      string
+	CostPrice     *string // exact NUMERIC decimal in Currency; nil when unknown
 	DiscountPct   *string
 	DiscountStart *string
 	DiscountEnd   *string
@@ -18,20 +29,362 @@ type ProductDTO struct {
 	UpdatedAt     *string
 	ArchivedAt    *string
 
-	// EffectivePrice computed by read query (decimal string).
-	EffectivePrice string
+	// PackageQuantity (exact decimal) and UnitOfMeasure are both set or both nil.
+	PackageQuantity *string
+	UnitOfMeasure   *string
+
+	// CurrencyPrices holds base prices in currencies other than Currency.
+	CurrencyPrices []*CurrencyPriceDTO
+
+	// ScheduledPriceChanges lists planned base price changes in any status, ordered by
+	// effective time. BasePrice stays the stored price until the scheduler applies a change.
+	ScheduledPriceChanges []*ScheduledPriceChangeDTO
+
+	// Variants lists the product's variants ordered by SKU, each with its effective price.
+	Variants []*VariantDTO
+
+	// Attributes lists the product's custom attribute values ordered by name.
+	Attributes []*AttributeValueDTO
+
+	// Tags lists the product's tags in alphabetical order.
+	Tags []string
+
+	// Translations lists the product's name and description in locales other than
+	// DefaultLocale, ordered by locale.
+	Translations []*TranslationDTO
+
+	// Media lists the product's images, videos and documents by ascending sort order.
+	Media []*MediaDTO
+
+	// Relations lists the product's links to related products ordered by type, then
+	// by the time they were added.
+	Relations []*RelationDTO
+
+	// Channels lists the product's sales channel assignments ordered by channel.
+	Channels []*ChannelDTO
+
+	// AllowedRegions, when not empty, lists the only ISO 3166-1 alpha-2 regions the
+	// product ships to; BlockedRegions lists regions it never ships to. Both are ordered.
+	AllowedRegions []string
+	BlockedRegions []string
+
+	// AvailableFrom and AvailableUntil (RFC3339) bound when the product is on sale;
+	// nil is open. OnSale says whether it is on sale at the read time, honouring the
+	// window even before the scheduler has activated or deactivated the product.
+	AvailableFrom  *string
+	AvailableUntil *string
+	OnSale         bool
+
+	// ProductType is "simple" or "bundle". Bundles set BundlePricing and list their
+	// BundleComponents by product ID; BundleDiscount is the exact decimal fraction
+	// taken off a components_sum bundle's component prices, nil when none.
+	ProductType      string
+	BundlePricing    *string
+	BundleDiscount   *string
+	BundleComponents []*BundleComponentDTO
+
+	// StockLevels lists the product's stock by location ID; InStock is true when
+	// units are available (on hand and not reserved) at any location.
+	StockLevels []*StockLevelDTO
+	InStock     bool
+
+	// EffectivePrice computed by read query (decimal string), in PriceCurrency.
+	// EffectivePriceExact is the same price as an exact rational ("num/den" or an integer).
+	EffectivePrice      string
+	EffectivePriceExact string
+	PriceCurrency       string
+
+	// RoundedPrice is the effective price rounded to PriceCurrency's minor units with RoundingMode.
+	RoundedPrice string
+	RoundingMode string
+
+	// DisplayPrice is the effective price converted into DisplayCurrency and rounded
+	// (decimal string), set only when a display currency is requested.
+	DisplayPrice    string
+	DisplayCurrency string
+
+	// Tax is the tax breakdown of the effective price, set only when a tax region is requested.
+	Tax *TaxDTO
+
+	// UnitPrice is the effective price per reference unit, set only for products with a package size.
+	UnitPrice *UnitPriceDTO
+}
+
+// UnitPriceDTO is an effective price per reference unit (e.g. per kg) in the price currency.
+// Price is an exact rational ("num/den" or an integer); RoundedPrice is rounded like the
+// effective price.
+type UnitPriceDTO struct {
+	Price        string
+	RoundedPrice string
+	Unit         string
+}
+
+// TaxDTO splits an effective price into net, tax and gross amounts for one tax region.
+// Net, Amount and Gross are exact rationals ("num/den" or an integer) in the price currency;
+// RoundedGross is the gross price rounded with the product's rounding mode.
+type TaxDTO struct {
+	Region       string
+	Category     string
+	Rate         string // exact decimal fraction, e.g. "0.19"
+	Net          string
+	Amount       string
+	Gross        string
+	RoundedGross string
+}
+
+// CurrencyPriceDTO is a product base price in one additional currency.
+type CurrencyPriceDTO struct {
+	Currency string
+	Price    string // exact NUMERIC decimal
+}
+
+// ScheduledPriceChangeDTO is a base price change planned for a later time.
+// Timestamps are RFC3339 with sub-second precision so they round-trip exactly.
+type ScheduledPriceChangeDTO struct {
+	ScheduleID  string
+	Price       string // exact NUMERIC decimal
+	Currency    string
+	EffectiveAt string
+	Status      string
+	// MarginOverride is true when the change was scheduled under an allowed margin override.
+	MarginOverride bool
+	CreatedAt      string
+	UpdatedAt      string
+}
+
+// VariantDTO is a product variant. Price is the variant's own base price in the
+// product's primary currency, nil when it inherits the product's base price.
+// The effective prices are in ProductDTO.PriceCurrency and include the product's
+// discount and price list entry. Timestamps are RFC3339 with sub-second precision.
+type VariantDTO struct {
+	VariantID string
+	SKU       string
+	Options   map[string]string
+	Price     *string // exact NUMERIC decimal
+	Status    string
+	CreatedAt string
+	UpdatedAt string
+
+	EffectivePrice      string
+	EffectivePriceExact string
+	RoundedPrice        string
+}
+
+// TranslationDTO is a product's name and description in one locale.
+type TranslationDTO struct {
+	Locale      string
+	Name        string
+	Description *string
+}
+
+// AttributeValueDTO is a product's value for one custom attribute.
+// Number is the exact NUMERIC value of number attributes, nil otherwise.
+type AttributeValueDTO struct {
+	Name   string
+	Value  string
+	Number *string
+}
+
+// AttributeDefinitionDTO is one custom attribute defined for a category.
+// Timestamps are RFC3339 with sub-second precision.
+type AttributeDefinitionDTO struct {
+	Category   string
+	Name       string
+	Type       string
+	Required   bool
+	EnumValues []string
+	Unit       string
+	CreatedAt  string
+	UpdatedAt  string
+}
+
+// CategoryDTO is one category of the product taxonomy.
+// Path holds the "/"-separated category IDs from the root down to the category.
+// Timestamps are RFC3339.
+type CategoryDTO struct {
+	CategoryID string
+	ParentID   *string // nil for root categories
+	Name       string
+	Slug       string
+	Path       string
+	CreatedAt  string
+	UpdatedAt  string
 }
 
 // ProductSummaryDTO is a compact DTO for list queries.
 type ProductSummaryDTO struct {
+	ProductID  string
+	Name       string
+	Category   string
+	CategoryID string
+	Tags       []string
+	Slug       string
+	SKU        *string
+	GTIN       *string
+	// Locale is the locale of Name, as for ProductDTO.
+	Locale string
+	// RelationType is how the product relates to the product of a related-products
+	// listing (see contracts.ProductFilter.RelatedTo); empty in other listings.
+	RelationType string
+	// EffectivePrice is a decimal string representation (best-effort) of the current effective price;
+	// EffectivePriceExact is the same price as an exact rational.
+	EffectivePrice      string
+	EffectivePriceExact string
+
+	// RoundedPrice/RoundingMode mirror ProductDTO.
+	RoundedPrice string
+	RoundingMode string
+
+	// BasePrice (exact decimal) is included so transport can return Money in API responses.
+	BasePrice string
+	// Currency is the currency of the base and effective prices.
+	Currency string
+	Status   string
+
+	// DisplayPrice/DisplayCurrency mirror ProductDTO and are set only when requested.
+	DisplayPrice    string
+	DisplayCurrency string
+
+	// Tax mirrors ProductDTO and is set only when requested.
+	Tax *TaxDTO
+
+	// UnitPrice mirrors ProductDTO.
+	UnitPrice *UnitPriceDTO
+
+	// InStock mirrors ProductDTO.
+	InStock bool
+}
+
+// PriceListDTO contains price list fields returned by read queries.
+// Entries is only populated by single price list lookups.
+type PriceListDTO struct {
+	PriceListID string
+	Segment     string
+	Name        string
+	Description *string
+	CreatedAt   *string
+	UpdatedAt   *string
+
+	Entries []*PriceListEntryDTO
+}
+
+// PriceListEntryDTO is one product entry of a price list.
+// Either OverridePrice or AdjustmentPct is set.
+type PriceListEntryDTO struct {
 	ProductID string
-	Name      string
-	Category  string
-	// EffectivePrice is a decimal string representation (best-effort) of the current effective price.
-	EffectivePrice string
-
-	// BasePriceNum/BasePriceDen are included so transport can return Money in API responses.
-	BasePriceNum int64
-	BasePriceDen int64
-	Status       string
+	// OverridePrice is an exact decimal string.
+	OverridePrice *string
+	// OverrideCurrency is the currency of the override price.
+	OverrideCurrency *string
+	// AdjustmentPct is a decimal fraction string (e.g. "-0.15" for 15% off).
+	AdjustmentPct *string
+	UpdatedAt     *string
+}
+
+// DueScheduledPriceChangeDTO identifies a pending scheduled price change whose effective time has passed.
+type DueScheduledPriceChangeDTO struct {
+	ProductID  string
+	ScheduleID string
+}
+
+// DueAvailabilityTransitionDTO identifies a product whose scheduled launch or
+// discontinuation has passed. Transition is "activate" or "deactivate".
+type DueAvailabilityTransitionDTO struct {
+	ProductID  string
+	Transition string
+}
+
+// PriceChangeRequestDTO is a price change held back for approval.
+// OldPrice/NewPrice are set for price changes, the Discount fields for discounts;
+// VariantID or Channel name the variant or channel of variant and channel price changes.
+// Timestamps are RFC3339.
+type PriceChangeRequestDTO struct {
+	RequestID      string
+	ProductID      string
+	Kind           string
+	VariantID      *string
+	Channel        *string
+	Currency       *string
+	OldPrice       *string // exact NUMERIC decimal
+	NewPrice       *string // exact NUMERIC decimal
+	DiscountPct    *string // 0-1 fraction, like ProductDTO.DiscountPct
+	DiscountStart  *string
+	DiscountEnd    *string
+	OverrideMargin bool
+	RequestedBy    string
+	RequestedRole  string
+	Status         string
+	DecidedBy      string
+	Reason         string
+	CreatedAt      string
+	DecidedAt      *string
+}
+
+// MediaDTO is a product image, video or document. AltText is the alt text in the
+// reader's locale, falling back like the product's name; AltTexts holds all of them
+// by locale. Timestamps are RFC3339 with sub-second precision.
+type MediaDTO struct {
+	MediaID   string
+	URL       string
+	Kind      string
+	AltText   string
+	AltTexts  map[string]string
+	SortOrder int64
+	Primary   bool
+	CreatedAt string
+	UpdatedAt string
+}
+
+// RelationDTO is a link from a product to a related product. CreatedAt is RFC3339
+// with sub-second precision.
+type RelationDTO struct {
+	Type      string
+	ProductID string
+	CreatedAt string
+}
+
+// ChannelDTO is a product's assignment to a sales channel. PriceOverride is an exact
+// NUMERIC decimal in the product's primary currency, nil when the channel sells at the
+// base price. UpdatedAt is RFC3339 with sub-second precision.
+type ChannelDTO struct {
+	Channel       string
+	Active        bool
+	PriceOverride *string
+	UpdatedAt     string
+}
+
+// BundleComponentDTO is a component product of a bundle. UnitPrice is the component's
+// base price (exact NUMERIC decimal) in its Currency. AllocatedPrice is the share of
+// the bundle's effective price allotted to the component's units for revenue
+// reporting, an exact rational in the bundle's PriceCurrency; the shares add up to
+// the effective price.
+type BundleComponentDTO struct {
+	ProductID      string
+	Quantity       int64
+	UnitPrice      string
+	Currency       string
+	AllocatedPrice string
+}
+
+// StockLevelDTO is a product's stock at one location. Available is OnHand less Reserved.
+// Version counts the writes to the level and UpdatedAt is RFC3339 with sub-second precision.
+type StockLevelDTO struct {
+	ProductID         string
+	LocationID        string
+	OnHand            int64
+	Reserved          int64
+	Available         int64
+	LowStockThreshold int64
+	Version           int64
+	UpdatedAt         string
+}
+
+// StockReservationDTO is units of a product held at a location for an order.
+// CreatedAt is RFC3339 with sub-second precision.
+type StockReservationDTO struct {
+	ReservationID string
+	ProductID     string
+	LocationID    string
+	Quantity      int64
+	CreatedAt     string
 }
@@ -0,0 +1,20 @@
+package attribute_definitions
+
+import (
+	"context"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+type Handler struct {
+	readModel contracts.AttributeSchemaReadModel
+}
+
+func NewHandler(r contracts.AttributeSchemaReadModel) *Handler {
+	return &Handler{readModel: r}
+}
+
+func (h *Handler) Execute(ctx context.Context, category string) ([]*dto.AttributeDefinitionDTO, error) {
+	return h.readModel.ListAttributeDefinitions(ctx, category)
+}
@@ -0,0 +1,64 @@
+package attribute_definitions
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+// SpannerAttributeDefinitionQuery reads category attribute definitions from Spanner directly.
+type SpannerAttributeDefinitionQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerAttributeDefinitionQuery(client *spanner.Client) *SpannerAttributeDefinitionQuery {
+	return &SpannerAttributeDefinitionQuery{Client: client}
+}
+
+// ListAttributeDefinitions returns the category's definitions ordered by name.
+// Schemas are keyed by category slug; category is normalized with domain.Slugify.
+func (q *SpannerAttributeDefinitionQuery) ListAttributeDefinitions(ctx context.Context, category string) ([]*dto.AttributeDefinitionDTO, error) {
+	category = domain.Slugify(category)
+	stmt := spanner.Statement{
+		SQL: `SELECT name, attribute_type, required, enum_values, unit, created_at, updated_at
+		      FROM attribute_definitions
+		      WHERE category = @category
+		      ORDER BY name`,
+		Params: map[string]interface{}{"category": category},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	out := make([]*dto.AttributeDefinitionDTO, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			d          = &dto.AttributeDefinitionDTO{Category: category}
+			enumValues []string
+			unit       spanner.NullString
+			createdAt  time.Time
+			updatedAt  time.Time
+		)
+		if err := row.Columns(&d.Name, &d.Type, &d.Required, &enumValues, &unit, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		d.EnumValues = enumValues
+		d.Unit = unit.StringVal
+		d.CreatedAt = createdAt.UTC().Format(time.RFC3339Nano)
+		d.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+		out = append(out, d)
+	}
+}
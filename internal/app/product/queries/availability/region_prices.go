@@ -0,0 +1,41 @@
+package availability
+
+import (
+	"sort"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// RegionPricesSQL is a condition on the products row p that holds when p has a price
+// in the currency of each of its allowed regions, as domain.Product.Activate requires
+// (see domain.RegionsPriced). It reads the parameters AddRegionParams sets.
+const RegionPricesSQL = `NOT EXISTS (
+		      SELECT 1 FROM UNNEST(p.allowed_regions) AS ar
+		      JOIN UNNEST(@region_codes) AS rc WITH OFFSET AS i ON rc = ar
+		      WHERE @region_currencies[OFFSET(i)] != p.currency
+		        AND NOT EXISTS (SELECT 1 FROM product_prices rp
+		                        WHERE rp.product_id = p.product_id AND rp.currency = @region_currencies[OFFSET(i)]))`
+
+// regionCodes and regionCurrencies list each region with a currency and, at the
+// same index, its currency.
+var regionCodes, regionCurrencies = regionParams()
+
+// AddRegionParams sets the parameters RegionPricesSQL reads.
+func AddRegionParams(params map[string]interface{}) {
+	params["region_codes"] = regionCodes
+	params["region_currencies"] = regionCurrencies
+}
+
+func regionParams() ([]string, []string) {
+	byRegion := domain.RegionCurrencies()
+	codes := make([]string, 0, len(byRegion))
+	for r := range byRegion {
+		codes = append(codes, r.String())
+	}
+	sort.Strings(codes)
+	currencies := make([]string, 0, len(codes))
+	for _, c := range codes {
+		currencies = append(currencies, byRegion[domain.Region(c)].String())
+	}
+	return codes, currencies
+}
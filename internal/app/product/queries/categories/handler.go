@@ -0,0 +1,26 @@
+package categories
+
+import (
+	"context"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+type Handler struct {
+	readModel contracts.CategoryReadModel
+}
+
+func NewHandler(r contracts.CategoryReadModel) *Handler {
+	return &Handler{readModel: r}
+}
+
+// Get returns the category with the given ID.
+func (h *Handler) Get(ctx context.Context, categoryID string) (*dto.CategoryDTO, error) {
+	return h.readModel.GetCategory(ctx, categoryID)
+}
+
+// List returns the children of parentID, or the root categories when parentID is empty.
+func (h *Handler) List(ctx context.Context, parentID string) ([]*dto.CategoryDTO, error) {
+	return h.readModel.ListCategories(ctx, parentID)
+}
@@ -0,0 +1,138 @@
+package categories
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+const categoryColumns = `category_id, parent_id, name, slug, path, created_at, updated_at`
+
+// SpannerCategoryQuery reads the category taxonomy from Spanner directly.
+type SpannerCategoryQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerCategoryQuery(client *spanner.Client) *SpannerCategoryQuery {
+	return &SpannerCategoryQuery{Client: client}
+}
+
+// GetCategory fetches a category by ID.
+func (q *SpannerCategoryQuery) GetCategory(ctx context.Context, categoryID string) (*dto.CategoryDTO, error) {
+	return q.getOne(ctx, spanner.Statement{
+		SQL:    `SELECT ` + categoryColumns + ` FROM categories WHERE category_id = @id`,
+		Params: map[string]interface{}{"id": categoryID},
+	})
+}
+
+// GetCategoryBySlug fetches a category by slug. The slug is normalized with
+// domain.Slugify first, so a category name such as "Home Office" finds "home-office".
+func (q *SpannerCategoryQuery) GetCategoryBySlug(ctx context.Context, slug string) (*dto.CategoryDTO, error) {
+	return q.getOne(ctx, spanner.Statement{
+		SQL:    `SELECT ` + categoryColumns + ` FROM categories WHERE slug = @slug`,
+		Params: map[string]interface{}{"slug": domain.Slugify(slug)},
+	})
+}
+
+// ListCategories lists the children of parentID ordered by name; an empty parentID
+// lists the root categories.
+func (q *SpannerCategoryQuery) ListCategories(ctx context.Context, parentID string) ([]*dto.CategoryDTO, error) {
+	stmt := spanner.Statement{
+		SQL:    `SELECT ` + categoryColumns + ` FROM categories WHERE parent_id IS NULL ORDER BY name, slug`,
+		Params: map[string]interface{}{},
+	}
+	if parentID != "" {
+		stmt.SQL = `SELECT ` + categoryColumns + ` FROM categories WHERE parent_id = @parent_id ORDER BY name, slug`
+		stmt.Params["parent_id"] = parentID
+	}
+	return q.list(ctx, q.Client.Single(), stmt)
+}
+
+// ListCategoryDescendants lists every category below categoryID, shallowest first.
+func (q *SpannerCategoryQuery) ListCategoryDescendants(ctx context.Context, categoryID string) ([]*dto.CategoryDTO, error) {
+	c, err := q.GetCategory(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	return q.list(ctx, q.Client.Single(), spanner.Statement{
+		SQL: `SELECT ` + categoryColumns + ` FROM categories
+		      WHERE STARTS_WITH(path, @prefix)
+		      ORDER BY LENGTH(path), path`,
+		Params: map[string]interface{}{"prefix": c.Path + "/"},
+	})
+}
+
+// CountCategoryProducts counts the products of any status that belong directly to the category.
+func (q *SpannerCategoryQuery) CountCategoryProducts(ctx context.Context, categoryID string) (int, error) {
+	iter := q.Client.Single().Query(ctx, spanner.Statement{
+		SQL:    `SELECT COUNT(*) FROM products WHERE category_id = @id`,
+		Params: map[string]interface{}{"id": categoryID},
+	})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	if err := row.Columns(&n); err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+func (q *SpannerCategoryQuery) getOne(ctx context.Context, stmt spanner.Statement) (*dto.CategoryDTO, error) {
+	out, err := q.list(ctx, q.Client.Single(), stmt)
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, domain.ErrCategoryNotFound
+	}
+	return out[0], nil
+}
+
+func (q *SpannerCategoryQuery) list(ctx context.Context, tx *spanner.ReadOnlyTransaction, stmt spanner.Statement) ([]*dto.CategoryDTO, error) {
+	iter := tx.Query(ctx, stmt)
+	defer iter.Stop()
+
+	out := make([]*dto.CategoryDTO, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		c, err := ScanCategory(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+}
+
+// ScanCategory maps a categories row (in the column order used by this package) into a DTO.
+func ScanCategory(row *spanner.Row) (*dto.CategoryDTO, error) {
+	var (
+		c                    = &dto.CategoryDTO{}
+		parentID             spanner.NullString
+		createdAt, updatedAt time.Time
+	)
+	if err := row.Columns(&c.CategoryID, &parentID, &c.Name, &c.Slug, &c.Path, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	if parentID.Valid {
+		p := parentID.StringVal
+		c.ParentID = &p
+	}
+	c.CreatedAt = createdAt.UTC().Format(time.RFC3339)
+	c.UpdatedAt = updatedAt.UTC().Format(time.RFC3339)
+	return c, nil
+}
@@ -0,0 +1,99 @@
+package exchange_rates
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// SpannerExchangeRateQuery looks up the exchange rate in effect for a currency pair.
+type SpannerExchangeRateQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerExchangeRateQuery(client *spanner.Client) *SpannerExchangeRateQuery {
+	return &SpannerExchangeRateQuery{Client: client}
+}
+
+// RateAt returns the rate converting from -> to in effect at the given time.
+// Rates stored for the opposite direction are inverted; when both directions
+// are stored, the most recently effective one wins.
+// Returns domain.ErrExchangeRateNotFound when no rate is in effect.
+func (q *SpannerExchangeRateQuery) RateAt(ctx context.Context, from, to domain.Currency, at time.Time) (*domain.ExchangeRate, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT from_currency, to_currency, rate, effective_at
+		      FROM exchange_rates
+		      WHERE ((from_currency = @from AND to_currency = @to)
+		          OR (from_currency = @to AND to_currency = @from))
+		        AND effective_at <= @at
+		      ORDER BY effective_at DESC, from_currency = @from DESC
+		      LIMIT 1`,
+		Params: map[string]interface{}{"from": from.String(), "to": to.String(), "at": at.UTC()},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, domain.ErrExchangeRateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rowFrom, rowTo string
+		rate           spanner.NullNumeric
+		effectiveAt    time.Time
+	)
+	if err := row.Columns(&rowFrom, &rowTo, &rate, &effectiveAt); err != nil {
+		return nil, err
+	}
+
+	r, err := domain.NewExchangeRate(domain.Currency(rowFrom), domain.Currency(rowTo), &rate.Numeric, effectiveAt)
+	if err != nil {
+		return nil, err
+	}
+	if r.From() != from {
+		return r.Inverse(), nil
+	}
+	return r, nil
+}
+
+// Converter converts exact effective prices into a display currency for one read.
+// Rates are looked up once per source currency and cached for the read.
+type Converter struct {
+	query *SpannerExchangeRateQuery
+	to    domain.Currency
+	at    time.Time
+	rates map[domain.Currency]*domain.ExchangeRate
+}
+
+// NewConverter returns a Converter into the given display currency at the given time.
+func (q *SpannerExchangeRateQuery) NewConverter(to domain.Currency, at time.Time) *Converter {
+	return &Converter{query: q, to: to, at: at, rates: map[domain.Currency]*domain.ExchangeRate{}}
+}
+
+// Convert converts m exactly; rounding is left to the caller's rounding policy.
+func (c *Converter) Convert(ctx context.Context, m *domain.Money) (*domain.Money, error) {
+	if m.Currency() == c.to {
+		return m, nil
+	}
+
+	rate, ok := c.rates[m.Currency()]
+	if !ok {
+		r, err := c.query.RateAt(ctx, m.Currency(), c.to, c.at)
+		if err != nil {
+			return nil, err
+		}
+		rate = r
+		c.rates[m.Currency()] = r
+	}
+
+	return rate.Convert(m)
+}
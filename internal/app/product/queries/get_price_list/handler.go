@@ -0,0 +1,20 @@
+package get_price_list
+
+import (
+	"context"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+type Handler struct {
+	readModel contracts.PriceListReadModel
+}
+
+func NewHandler(r contracts.PriceListReadModel) *Handler {
+	return &Handler{readModel: r}
+}
+
+func (h *Handler) Execute(ctx context.Context, priceListID string) (*dto.PriceListDTO, error) {
+	return h.readModel.GetPriceList(ctx, priceListID)
+}
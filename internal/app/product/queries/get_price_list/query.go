@@ -0,0 +1,154 @@
+package get_price_list
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/pricing"
+)
+
+// SpannerGetPriceListQuery reads a price list and its entries from Spanner directly.
+type SpannerGetPriceListQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerGetPriceListQuery(client *spanner.Client) *SpannerGetPriceListQuery {
+	return &SpannerGetPriceListQuery{Client: client}
+}
+
+// GetPriceList fetches a price list by ID, including all entries.
+func (q *SpannerGetPriceListQuery) GetPriceList(ctx context.Context, priceListID string) (*dto.PriceListDTO, error) {
+	return q.getOne(ctx, spanner.Statement{
+		SQL: `SELECT price_list_id, segment, name, description, created_at, updated_at
+		      FROM price_lists
+		      WHERE price_list_id = @id`,
+		Params: map[string]interface{}{"id": priceListID},
+	})
+}
+
+// GetPriceListBySegment fetches a price list by its segment code, including all entries.
+func (q *SpannerGetPriceListQuery) GetPriceListBySegment(ctx context.Context, segment string) (*dto.PriceListDTO, error) {
+	return q.getOne(ctx, spanner.Statement{
+		SQL: `SELECT price_list_id, segment, name, description, created_at, updated_at
+		      FROM price_lists
+		      WHERE segment = @segment`,
+		Params: map[string]interface{}{"segment": segment},
+	})
+}
+
+func (q *SpannerGetPriceListQuery) getOne(ctx context.Context, stmt spanner.Statement) (*dto.PriceListDTO, error) {
+	ro := q.Client.ReadOnlyTransaction()
+	defer ro.Close()
+
+	iter := ro.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, domain.ErrPriceListNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := ScanPriceList(row)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := loadEntries(ctx, ro, out.PriceListID)
+	if err != nil {
+		return nil, err
+	}
+	out.Entries = entries
+
+	return out, nil
+}
+
+// ScanPriceList maps a price_lists row (in the column order used by this package) into a DTO.
+func ScanPriceList(row *spanner.Row) (*dto.PriceListDTO, error) {
+	var (
+		id, segment, name    string
+		description          spanner.NullString
+		createdAt, updatedAt time.Time
+	)
+	if err := row.Columns(&id, &segment, &name, &description, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	out := &dto.PriceListDTO{
+		PriceListID: id,
+		Segment:     segment,
+		Name:        name,
+	}
+	if description.Valid {
+		d := description.StringVal
+		out.Description = &d
+	}
+	c := createdAt.UTC().Format(time.RFC3339)
+	out.CreatedAt = &c
+	u := updatedAt.UTC().Format(time.RFC3339)
+	out.UpdatedAt = &u
+
+	return out, nil
+}
+
+func loadEntries(ctx context.Context, ro *spanner.ReadOnlyTransaction, priceListID string) ([]*dto.PriceListEntryDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id, override_price, override_currency,
+		             adjustment_percent, updated_at
+		      FROM price_list_entries
+		      WHERE price_list_id = @id
+		      ORDER BY product_id ASC`,
+		Params: map[string]interface{}{"id": priceListID},
+	}
+
+	iter := ro.Query(ctx, stmt)
+	defer iter.Stop()
+
+	out := make([]*dto.PriceListEntryDTO, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			productID        string
+			overridePrice    spanner.NullNumeric
+			overrideCurrency spanner.NullString
+			adjustment       spanner.NullNumeric
+			updatedAt        time.Time
+		)
+		if err := row.Columns(&productID, &overridePrice, &overrideCurrency, &adjustment, &updatedAt); err != nil {
+			return nil, err
+		}
+
+		e := &dto.PriceListEntryDTO{ProductID: productID}
+		if overridePrice.Valid {
+			o := pricing.Decimal(&overridePrice.Numeric)
+			e.OverridePrice = &o
+			c := string(domain.DefaultCurrency)
+			if overrideCurrency.Valid {
+				c = overrideCurrency.StringVal
+			}
+			e.OverrideCurrency = &c
+		}
+		if adjustment.Valid {
+			a := adjustment.Numeric.FloatString(9)
+			e.AdjustmentPct = &a
+		}
+		u := updatedAt.UTC().Format(time.RFC3339)
+		e.UpdatedAt = &u
+
+		out = append(out, e)
+	}
+}
@@ -2,8 +2,10 @@ package get_product
 
 import (
 	"context"
+	"strings"
 
 	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
 )
 
@@ -15,6 +17,47 @@ func NewHandler(r contracts.ReadModel) *Handler {
 	return &Handler{readModel: r}
 }
 
-func (h *Handler) Execute(ctx context.Context, productID string) (*dto.ProductDTO, error) {
-	return h.readModel.GetProduct(ctx, productID)
+func (h *Handler) Execute(ctx context.Context, productID string, opts contracts.ProductReadOptions) (*dto.ProductDTO, error) {
+	return h.readModel.GetProduct(ctx, productID, opts)
+}
+
+// ExecuteBySKU returns the product with the given SKU, or the product owning a variant with it.
+func (h *Handler) ExecuteBySKU(ctx context.Context, sku string, opts contracts.ProductReadOptions) (*dto.ProductDTO, error) {
+	sku, err := domain.NormalizeSKU(sku)
+	if err != nil {
+		return nil, err
+	}
+	id, err := h.readModel.FindProductIDBySKU(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+	return h.readModel.GetProduct(ctx, id, opts)
+}
+
+// ExecuteByGTIN returns the product with the given GTIN, in any of its 8-14 digit forms.
+func (h *Handler) ExecuteByGTIN(ctx context.Context, gtin string, opts contracts.ProductReadOptions) (*dto.ProductDTO, error) {
+	gtin, err := domain.NormalizeGTIN(gtin)
+	if err != nil {
+		return nil, err
+	}
+	id, err := h.readModel.FindProductIDByGTIN(ctx, gtin)
+	if err != nil {
+		return nil, err
+	}
+	return h.readModel.GetProduct(ctx, id, opts)
+}
+
+// ExecuteBySlug returns the product with the given URL slug. A slug the product had
+// before a rename still finds it, with redirect set: callers should redirect to the
+// product's current Slug.
+func (h *Handler) ExecuteBySlug(ctx context.Context, slug string, opts contracts.ProductReadOptions) (product *dto.ProductDTO, redirect bool, err error) {
+	id, current, err := h.readModel.FindProductIDBySlug(ctx, strings.ToLower(strings.TrimSpace(slug)))
+	if err != nil {
+		return nil, false, err
+	}
+	product, err = h.readModel.GetProduct(ctx, id, opts)
+	if err != nil {
+		return nil, false, err
+	}
+	return product, !current, nil
 }
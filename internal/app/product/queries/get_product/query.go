@@ -2,31 +2,64 @@ package get_product
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
 
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/exchange_rates"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/localization"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/pricing"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/stock_levels"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/tax_rates"
 )
 
 // SpannerGetProductQuery is a concrete query implementation that reads from Spanner directly.
 type SpannerGetProductQuery struct {
-	Client *spanner.Client
+	Client   *spanner.Client
+	Rates    *exchange_rates.SpannerExchangeRateQuery
+	Taxes    *tax_rates.SpannerTaxRateQuery
+	Stock    *stock_levels.SpannerStockQuery
+	Rounding *domain.RoundingPolicy
 }
 
 func NewSpannerGetProductQuery(client *spanner.Client) *SpannerGetProductQuery {
-	return &SpannerGetProductQuery{Client: client}
+	return &SpannerGetProductQuery{
+		Client: client,
+		Rates:  exchange_rates.NewSpannerExchangeRateQuery(client),
+		Taxes:  tax_rates.NewSpannerTaxRateQuery(client),
+		Stock:  stock_levels.NewSpannerStockQuery(client),
+	}
 }
 
 // GetProduct executes a SQL query to fetch a product row and compute the effective price.
-func (q *SpannerGetProductQuery) GetProduct(ctx context.Context, productID string) (*dto.ProductDTO, error) {
+// When opts.Segment is set, the segment's price list entry (if any) is applied.
+// When opts.Currency is set, prices are resolved in that currency; a product not sold
+// in it yields domain.ErrNoPriceInCurrency. When opts.DisplayCurrency is set, the effective
+// price is also converted into it; a missing rate yields domain.ErrExchangeRateNotFound.
+// When opts.TaxRegion is set, the effective price is split into net, tax and gross with
+// the rate for the product's tax category; a missing rate yields domain.ErrTaxRateNotFound.
+// Prices are evaluated at opts.At (now when zero): a pending scheduled price change whose
+// effective time has passed replaces the primary base price even before the scheduler applies it.
+// Name, description and media alt texts are localized for opts.Locales (see localization.Localize).
+// Stock is listed by location; InStock is set when any location has units available.
+// When opts.Channel is set, a product not active on the channel yields
+// domain.ErrNotActiveOnChannel, and the channel's price override replaces the primary base price.
+// When opts.Region is set, a product that does not ship to the region yields domain.ErrNotAvailableInRegion.
+// OnSale is evaluated at opts.At from the status and the availability window (see domain.OnSaleAt).
+func (q *SpannerGetProductQuery) GetProduct(ctx context.Context, productID string, opts contracts.ProductReadOptions) (*dto.ProductDTO, error) {
 	stmt := spanner.Statement{
-		SQL: `SELECT product_id, name, description, category,
-		             base_price_numerator, base_price_denominator,
+		SQL: `SELECT product_id, name, description, default_locale, category, category_id, tax_category, slug, sku, gtin,
+		             base_price, currency, cost_price, package_quantity, unit_of_measure,
 		             discount_percent, discount_start_date, discount_end_date,
-		             status, created_at, updated_at, archived_at
+		             product_type, bundle_pricing, bundle_discount, allowed_regions, blocked_regions,
+		             available_from, available_until, status, created_at, updated_at, archived_at
 		      FROM products
 		      WHERE product_id = @id`,
 		Params: map[string]interface{}{"id": productID},
@@ -47,28 +80,54 @@ func (q *SpannerGetProductQuery) GetProduct(ctx context.Context, productID strin
 		id                         string
 		name                       string
 		description                spanner.NullString
+		defaultLocale              string
 		category                   string
-		baseNum                    int64
-		baseDen                    int64
+		categoryID                 string
+		taxCategory                string
+		slug                       string
+		sku, gtin                  spanner.NullString
+		basePrice                  big.Rat
+		currency                   string
+		costPrice                  spanner.NullNumeric
+		packageQuantity            spanner.NullNumeric
+		unitOfMeasure              spanner.NullString
 		discountPercent            spanner.NullNumeric
 		discountStart, discountEnd spanner.NullTime
+		productType                string
+		bundlePricing              spanner.NullString
+		bundleDiscount             spanner.NullNumeric
+		allowedRegions             []string
+		blockedRegions             []string
+		availableFrom              spanner.NullTime
+		availableUntil             spanner.NullTime
 		status                     string
 		createdAt, updatedAt       time.Time
 		archivedAt                 spanner.NullTime
 	)
 
-	if err := row.Columns(&id, &name, &description, &category, &baseNum, &baseDen,
-		&discountPercent, &discountStart, &discountEnd, &status, &createdAt, &updatedAt, &archivedAt); err != nil {
+	if err := row.Columns(&id, &name, &description, &defaultLocale, &category, &categoryID, &taxCategory, &slug, &sku, &gtin, &basePrice, &currency, &costPrice, &packageQuantity, &unitOfMeasure,
+		&discountPercent, &discountStart, &discountEnd, &productType, &bundlePricing, &bundleDiscount, &allowedRegions, &blockedRegions, &availableFrom, &availableUntil, &status, &createdAt, &updatedAt, &archivedAt); err != nil {
 		return nil, err
 	}
 
 	dtoOut := &dto.ProductDTO{
-		ProductID:    id,
-		Name:         name,
-		Category:     category,
-		BasePriceNum: baseNum,
-		BasePriceDen: baseDen,
-		Status:       status,
+		ProductID:      id,
+		Name:           name,
+		Locale:         defaultLocale,
+		DefaultLocale:  defaultLocale,
+		Category:       category,
+		CategoryID:     categoryID,
+		TaxCategory:    taxCategory,
+		Slug:           slug,
+		BasePrice:      pricing.Decimal(&basePrice),
+		Currency:       currency,
+		ProductType:    productType,
+		AllowedRegions: allowedRegions,
+		BlockedRegions: blockedRegions,
+		Status:         status,
+	}
+	if opts.Region != "" && !availableIn(opts.Region, allowedRegions, blockedRegions) {
+		return nil, domain.ErrNotAvailableInRegion
 	}
 
 	if description.Valid {
@@ -76,11 +135,39 @@ func (q *SpannerGetProductQuery) GetProduct(ctx context.Context, productID strin
 		dtoOut.Description = &desc
 	}
 
+	if sku.Valid {
+		s := sku.StringVal
+		dtoOut.SKU = &s
+	}
+	if gtin.Valid {
+		g := gtin.StringVal
+		dtoOut.GTIN = &g
+	}
+
+	if costPrice.Valid {
+		cost := pricing.Decimal(&costPrice.Numeric)
+		dtoOut.CostPrice = &cost
+	}
+
+	if packageQuantity.Valid && unitOfMeasure.Valid {
+		quantity, unit := pricing.Decimal(&packageQuantity.Numeric), unitOfMeasure.StringVal
+		dtoOut.PackageQuantity, dtoOut.UnitOfMeasure = &quantity, &unit
+	}
+
 	if discountPercent.Valid {
 		dp := new(big.Rat).Set(&discountPercent.Numeric).FloatString(10)
 		dtoOut.DiscountPct = &dp
 	}
 
+	if bundlePricing.Valid {
+		bp := bundlePricing.StringVal
+		dtoOut.BundlePricing = &bp
+	}
+	if bundleDiscount.Valid {
+		bd := pricing.Decimal(&bundleDiscount.Numeric)
+		dtoOut.BundleDiscount = &bd
+	}
+
 	if discountStart.Valid {
 		ds := discountStart.Time.UTC().Format(time.RFC3339)
 		dtoOut.DiscountStart = &ds
@@ -99,44 +186,798 @@ func (q *SpannerGetProductQuery) GetProduct(ctx context.Context, productID strin
 		aa := archivedAt.Time.UTC().Format(time.RFC3339)
 		dtoOut.ArchivedAt = &aa
 	}
+	var from, until *time.Time
+	if availableFrom.Valid {
+		f := availableFrom.Time.UTC()
+		fs := f.Format(time.RFC3339)
+		from, dtoOut.AvailableFrom = &f, &fs
+	}
+	if availableUntil.Valid {
+		u := availableUntil.Time.UTC()
+		us := u.Format(time.RFC3339)
+		until, dtoOut.AvailableUntil = &u, &us
+	}
 
-	// Compute effective price based on discount validity now (UTC).
-	effective, err := computeEffectivePrice(baseNum, baseDen, discountPercent, discountStart, discountEnd, time.Now().UTC())
+	prices, err := q.loadCurrencyPrices(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	dtoOut.CurrencyPrices = prices
+
+	scheduled, err := q.loadScheduledPrices(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range scheduled {
+		dtoOut.ScheduledPriceChanges = append(dtoOut.ScheduledPriceChanges, &dto.ScheduledPriceChangeDTO{
+			ScheduleID:     s.ID(),
+			Price:          pricing.Decimal(s.Price().Rat()),
+			Currency:       s.Price().Currency().String(),
+			EffectiveAt:    s.EffectiveAt().UTC().Format(time.RFC3339Nano),
+			Status:         string(s.Status()),
+			MarginOverride: s.MarginOverride(),
+			CreatedAt:      s.CreatedAt().UTC().Format(time.RFC3339Nano),
+			UpdatedAt:      s.UpdatedAt().UTC().Format(time.RFC3339Nano),
+		})
+	}
+
+	if dtoOut.Channels, err = q.loadChannels(ctx, id); err != nil {
+		return nil, err
+	}
+	var channel *dto.ChannelDTO
+	if opts.Channel != "" {
+		for _, c := range dtoOut.Channels {
+			if c.Channel == opts.Channel && c.Active {
+				channel = c
+			}
+		}
+		if channel == nil {
+			return nil, domain.ErrNotActiveOnChannel
+		}
+	}
+
+	now := opts.At.UTC()
+	if opts.At.IsZero() {
+		now = time.Now().UTC()
+	}
+	dtoOut.OnSale = domain.OnSaleAt(domain.ProductStatus(status), from, until, now)
+	if dtoOut.OnSale && domain.ProductStatus(status) != domain.ProductStatusActive {
+		// A pending launch Activate would refuse for a missing region price is not on sale.
+		regions := make([]domain.Region, 0, len(allowedRegions))
+		for _, r := range allowedRegions {
+			regions = append(regions, domain.Region(r))
+		}
+		priced := []domain.Currency{domain.Currency(currency)}
+		for _, p := range prices {
+			priced = append(priced, domain.Currency(p.Currency))
+		}
+		dtoOut.OnSale = domain.RegionsPriced(regions, priced)
+	}
+
+	// Resolve the base price in the requested currency. Scheduled changes and
+	// channel price overrides only apply to the primary currency; an override wins.
+	priceCurrency := currency
+	price := domain.BasePriceAt(domain.NewMoneyFromRatIn(domain.Currency(currency), &basePrice), scheduled, now).Rat()
+	if channel != nil && channel.PriceOverride != nil {
+		override, ok := new(big.Rat).SetString(*channel.PriceOverride)
+		if !ok {
+			return nil, fmt.Errorf("invalid stored price %q", *channel.PriceOverride)
+		}
+		price = override
+	}
+	if opts.Currency != "" && opts.Currency != currency {
+		found := false
+		for _, p := range prices {
+			if p.Currency == opts.Currency {
+				amount, ok := new(big.Rat).SetString(p.Price)
+				if !ok {
+					return nil, fmt.Errorf("invalid stored price %q", p.Price)
+				}
+				priceCurrency, price = p.Currency, amount
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, domain.ErrNoPriceInCurrency
+		}
+	}
+	dtoOut.PriceCurrency = priceCurrency
+
+	// Compute effective price based on discount validity at the evaluation time.
+	cols := pricing.Columns{
+		ProductID:     id,
+		BasePrice:     *price,
+		Currency:      priceCurrency,
+		DiscountPct:   discountPercent,
+		DiscountStart: discountStart,
+		DiscountEnd:   discountEnd,
+	}
+	if opts.Segment != "" {
+		if err := q.loadSegmentEntry(ctx, id, opts.Segment, &cols); err != nil {
+			return nil, err
+		}
+	}
+
+	effective, err := pricing.EffectivePrice(cols, now)
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := q.loadVariants(ctx, id, currency)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range variants {
+		out, err := q.variantDTO(v, cols, now, category)
+		if err != nil {
+			return nil, err
+		}
+		dtoOut.Variants = append(dtoOut.Variants, out)
+	}
+	if dtoOut.Attributes, err = q.loadAttributes(ctx, id); err != nil {
+		return nil, err
+	}
+	if dtoOut.Tags, err = q.loadTags(ctx, id); err != nil {
+		return nil, err
+	}
+	if dtoOut.Translations, err = q.loadTranslations(ctx, id); err != nil {
+		return nil, err
+	}
+	if dtoOut.Media, err = q.loadMedia(ctx, id); err != nil {
+		return nil, err
+	}
+	for _, m := range dtoOut.Media {
+		m.AltText = localization.AltText(opts.Locales, defaultLocale, m.AltTexts)
+	}
+	if dtoOut.Relations, err = q.loadRelations(ctx, id); err != nil {
+		return nil, err
+	}
+	if dtoOut.BundleComponents, err = q.loadBundleComponents(ctx, id, domain.NewMoneyFromRatIn(domain.Currency(priceCurrency), effective)); err != nil {
+		return nil, err
+	}
+	if dtoOut.StockLevels, err = q.Stock.ListStockLevels(ctx, id); err != nil {
+		return nil, err
+	}
+	for _, s := range dtoOut.StockLevels {
+		dtoOut.InStock = dtoOut.InStock || s.Available > 0
+	}
+	text := localization.Localize(opts.Locales,
+		localization.Text{Locale: defaultLocale, Name: dtoOut.Name, Description: dtoOut.Description}, dtoOut.Translations)
+	dtoOut.Locale, dtoOut.Name, dtoOut.Description = text.Locale, text.Name, text.Description
 	dtoOut.EffectivePrice = effective.FloatString(10)
+	dtoOut.EffectivePriceExact = effective.RatString()
+
+	rounded, mode := pricing.RoundedPrice(effective, priceCurrency, category, q.Rounding)
+	dtoOut.RoundedPrice = rounded.FloatString(rounded.Currency().MinorUnits())
+	dtoOut.RoundingMode = mode.String()
+
+	dtoOut.UnitPrice, err = pricing.UnitPrice(packageQuantity, unitOfMeasure, effective, priceCurrency, category, q.Rounding)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DisplayCurrency != "" {
+		to := domain.Currency(opts.DisplayCurrency)
+		converted, err := q.Rates.NewConverter(to, now).Convert(ctx, domain.NewMoneyFromRatIn(domain.Currency(priceCurrency), effective))
+		if err != nil {
+			return nil, err
+		}
+		display, _ := pricing.RoundedPrice(converted.Rat(), to.String(), category, q.Rounding)
+		dtoOut.DisplayPrice = display.FloatString(to.MinorUnits())
+		dtoOut.DisplayCurrency = to.String()
+	}
+
+	if opts.TaxRegion != "" {
+		calc := q.Taxes.NewCalculator(domain.TaxRegion(opts.TaxRegion), now)
+		b, err := calc.Apply(ctx, domain.TaxCategory(taxCategory), domain.NewMoneyFromRatIn(domain.Currency(priceCurrency), effective))
+		if err != nil {
+			return nil, err
+		}
+		dtoOut.Tax = pricing.TaxBreakdown(b, category, q.Rounding)
+	}
 
 	return dtoOut, nil
 }
 
-// computeEffectivePrice returns the effective price as *big.Rat
-func computeEffectivePrice(baseNum, baseDen int64, discountPercent spanner.NullNumeric, start, end spanner.NullTime, now time.Time) (*big.Rat, error) {
-	base := new(big.Rat).SetFrac(big.NewInt(baseNum), big.NewInt(baseDen))
+// loadCurrencyPrices reads the product's base prices in additional currencies.
+func (q *SpannerGetProductQuery) loadCurrencyPrices(ctx context.Context, productID string) ([]*dto.CurrencyPriceDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT currency, price
+		      FROM product_prices
+		      WHERE product_id = @id
+		      ORDER BY currency`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.CurrencyPriceDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			currency string
+			price    big.Rat
+		)
+		if err := row.Columns(&currency, &price); err != nil {
+			return nil, err
+		}
+		out = append(out, &dto.CurrencyPriceDTO{Currency: currency, Price: pricing.Decimal(&price)})
+	}
+}
+
+// loadScheduledPrices reads the product's scheduled price changes ordered by effective time.
+func (q *SpannerGetProductQuery) loadScheduledPrices(ctx context.Context, productID string) ([]*domain.ScheduledPriceChange, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT schedule_id, price, currency, effective_at, status, margin_override, created_at, updated_at
+		      FROM scheduled_price_changes
+		      WHERE product_id = @id
+		      ORDER BY effective_at, schedule_id`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*domain.ScheduledPriceChange
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			scheduleID, currency, status string
+			price                        big.Rat
+			effectiveAt                  time.Time
+			override                     bool
+			createdAt, updatedAt         time.Time
+		)
+		if err := row.Columns(&scheduleID, &price, &currency, &effectiveAt, &status, &override, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, domain.ReconstructScheduledPriceChange(scheduleID,
+			domain.NewMoneyFromRatIn(domain.Currency(currency), &price), effectiveAt.UTC(),
+			domain.ScheduledPriceStatus(status), override, createdAt.UTC(), updatedAt.UTC()))
+	}
+}
+
+// loadVariants reads the product's variants ordered by SKU. Variant prices are in
+// the product's primary currency.
+func (q *SpannerGetProductQuery) loadVariants(ctx context.Context, productID, currency string) ([]*domain.Variant, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT variant_id, sku, option_values, price, status, created_at, updated_at
+		      FROM product_variants
+		      WHERE product_id = @id
+		      ORDER BY sku, variant_id`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*domain.Variant
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			variantID, sku, status string
+			optionValues           spanner.NullJSON
+			price                  spanner.NullNumeric
+			createdAt, updatedAt   time.Time
+		)
+		if err := row.Columns(&variantID, &sku, &optionValues, &price, &status, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		options, err := variantOptions(optionValues)
+		if err != nil {
+			return nil, err
+		}
+		var own *domain.Money
+		if price.Valid {
+			own = domain.NewMoneyFromRatIn(domain.Currency(currency), &price.Numeric)
+		}
+		out = append(out, domain.ReconstructVariant(variantID, sku, options, own,
+			domain.VariantStatus(status), createdAt.UTC(), updatedAt.UTC()))
+	}
+}
 
-	// no discount present
-	if !discountPercent.Valid {
-		return base, nil
+// loadAttributes reads the product's custom attribute values ordered by name.
+func (q *SpannerGetProductQuery) loadAttributes(ctx context.Context, productID string) ([]*dto.AttributeValueDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT name, value, number_value
+		      FROM product_attributes
+		      WHERE product_id = @id
+		      ORDER BY name`,
+		Params: map[string]interface{}{"id": productID},
 	}
 
-	// check validity window (start inclusive, end exclusive) to mirror domain.Discount.IsValidAt
-	// now >= end => expired
-	if end.Valid && !now.Before(end.Time) {
-		return base, nil
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.AttributeValueDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			a      dto.AttributeValueDTO
+			number spanner.NullNumeric
+		)
+		if err := row.Columns(&a.Name, &a.Value, &number); err != nil {
+			return nil, err
+		}
+		if number.Valid {
+			n := pricing.Decimal(&number.Numeric)
+			a.Number = &n
+		}
+		out = append(out, &a)
 	}
-	// end is exclusive to mirror domain.Discount.IsValidAt
-	if end.Valid && !now.Before(end.Time) {
-		return base, nil
+}
+
+// FindProductIDBySKU returns the ID of the product whose SKU, or one of whose
+// variants' SKUs, is sku. Returns domain.ErrProductNotFound when there is none.
+func (q *SpannerGetProductQuery) FindProductIDBySKU(ctx context.Context, sku string) (string, error) {
+	return q.findProductID(ctx, spanner.Statement{
+		SQL: `SELECT product_id FROM products WHERE sku = @sku
+		      UNION ALL
+		      SELECT product_id FROM product_variants WHERE sku = @sku
+		      LIMIT 1`,
+		Params: map[string]interface{}{"sku": sku},
+	})
+}
+
+// FindProductIDByGTIN returns the ID of the product with the given GTIN-14.
+// Returns domain.ErrProductNotFound when there is none.
+func (q *SpannerGetProductQuery) FindProductIDByGTIN(ctx context.Context, gtin string) (string, error) {
+	return q.findProductID(ctx, spanner.Statement{
+		SQL:    `SELECT product_id FROM products WHERE gtin = @gtin`,
+		Params: map[string]interface{}{"gtin": gtin},
+	})
+}
+
+// FindProductIDBySlug returns the ID of the product whose current slug is slug, or
+// failing that the product that had it before a rename, with current false.
+// Returns domain.ErrProductNotFound when there is none.
+func (q *SpannerGetProductQuery) FindProductIDBySlug(ctx context.Context, slug string) (string, bool, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id, TRUE AS is_current FROM products WHERE slug = @slug
+		      UNION ALL
+		      SELECT product_id, FALSE AS is_current FROM product_slug_history WHERE slug = @slug
+		      ORDER BY is_current DESC
+		      LIMIT 1`,
+		Params: map[string]interface{}{"slug": slug},
 	}
 
-	// discount_percent is stored as a NUMERIC (0.0-1.0 scale) and decoded into big.Rat.
-	discRat := new(big.Rat).Set(&discountPercent.Numeric)
-	// Defensive: if stored as "20" rather than "0.20", normalize to 0-1 scale.
-	if discRat.Cmp(big.NewRat(1, 1)) == 1 {
-		discRat.Quo(discRat, big.NewRat(100, 1))
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return "", false, domain.ErrProductNotFound
+	}
+	if err != nil {
+		return "", false, err
 	}
+	var (
+		id      string
+		current bool
+	)
+	if err := row.Columns(&id, &current); err != nil {
+		return "", false, err
+	}
+	return id, current, nil
+}
 
-	discountAmount := new(big.Rat).Mul(base, discRat)
-	final := new(big.Rat).Sub(base, discountAmount)
-	return final, nil
+// FindBundleIDsByComponent returns the IDs of the bundles containing the product,
+// ordered by ID.
+func (q *SpannerGetProductQuery) FindBundleIDsByComponent(ctx context.Context, productID string) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id
+		      FROM product_bundle_components
+		      WHERE component_product_id = @id
+		      ORDER BY product_id`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+}
+
+func (q *SpannerGetProductQuery) findProductID(ctx context.Context, stmt spanner.Statement) (string, error) {
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return "", domain.ErrProductNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	var id string
+	if err := row.Columns(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// loadTags reads the product's tags in alphabetical order.
+func (q *SpannerGetProductQuery) loadTags(ctx context.Context, productID string) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT tag
+		      FROM product_tags
+		      WHERE product_id = @id
+		      ORDER BY tag`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var tag string
+		if err := row.Columns(&tag); err != nil {
+			return nil, err
+		}
+		out = append(out, tag)
+	}
+}
+
+// loadTranslations reads the product's translations ordered by locale.
+func (q *SpannerGetProductQuery) loadTranslations(ctx context.Context, productID string) ([]*dto.TranslationDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT locale, name, description
+		      FROM product_translations
+		      WHERE product_id = @id
+		      ORDER BY locale`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.TranslationDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			t           dto.TranslationDTO
+			description spanner.NullString
+		)
+		if err := row.Columns(&t.Locale, &t.Name, &description); err != nil {
+			return nil, err
+		}
+		if description.Valid {
+			d := description.StringVal
+			t.Description = &d
+		}
+		out = append(out, &t)
+	}
+}
+
+// loadMedia reads the product's media by ascending sort order.
+func (q *SpannerGetProductQuery) loadMedia(ctx context.Context, productID string) ([]*dto.MediaDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT media_id, url, kind, alt_texts, sort_order, is_primary, created_at, updated_at
+		      FROM product_media
+		      WHERE product_id = @id
+		      ORDER BY sort_order, media_id`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.MediaDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			m                    dto.MediaDTO
+			altTexts             spanner.NullJSON
+			createdAt, updatedAt time.Time
+		)
+		if err := row.Columns(&m.MediaID, &m.URL, &m.Kind, &altTexts, &m.SortOrder, &m.Primary, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		if m.AltTexts, err = mediaAltTexts(altTexts); err != nil {
+			return nil, err
+		}
+		m.CreatedAt = createdAt.UTC().Format(time.RFC3339Nano)
+		m.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+		out = append(out, &m)
+	}
+}
+
+// loadRelations reads the product's links to related products ordered by type and creation.
+func (q *SpannerGetProductQuery) loadRelations(ctx context.Context, productID string) ([]*dto.RelationDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT relation_type, related_product_id, created_at
+		      FROM product_relations
+		      WHERE product_id = @id
+		      ORDER BY relation_type, created_at, related_product_id`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.RelationDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			r         dto.RelationDTO
+			createdAt time.Time
+		)
+		if err := row.Columns(&r.Type, &r.ProductID, &createdAt); err != nil {
+			return nil, err
+		}
+		r.CreatedAt = createdAt.UTC().Format(time.RFC3339Nano)
+		out = append(out, &r)
+	}
+}
+
+// loadChannels reads the product's sales channel assignments ordered by channel.
+func (q *SpannerGetProductQuery) loadChannels(ctx context.Context, productID string) ([]*dto.ChannelDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT channel, active, price_override, updated_at
+		      FROM product_channels
+		      WHERE product_id = @id
+		      ORDER BY channel`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.ChannelDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			c         dto.ChannelDTO
+			price     spanner.NullNumeric
+			updatedAt time.Time
+		)
+		if err := row.Columns(&c.Channel, &c.Active, &price, &updatedAt); err != nil {
+			return nil, err
+		}
+		if price.Valid {
+			p := pricing.Decimal(&price.Numeric)
+			c.PriceOverride = &p
+		}
+		c.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+		out = append(out, &c)
+	}
+}
+
+// loadBundleComponents reads a bundle's components with their base prices, ordered by
+// product ID, and allocates the bundle's effective price across them. Nothing is
+// allocated when the components are no longer priced in one currency.
+func (q *SpannerGetProductQuery) loadBundleComponents(ctx context.Context, productID string, effective *domain.Money) ([]*dto.BundleComponentDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT c.component_product_id, c.quantity, p.base_price, p.currency
+		      FROM product_bundle_components c
+		      JOIN products p ON p.product_id = c.component_product_id
+		      WHERE c.product_id = @id
+		      ORDER BY c.component_product_id`,
+		Params: map[string]interface{}{"id": productID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var (
+		out   []*dto.BundleComponentDTO
+		lines []domain.BundleLine
+	)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			c         dto.BundleComponentDTO
+			unitPrice big.Rat
+		)
+		if err := row.Columns(&c.ProductID, &c.Quantity, &unitPrice, &c.Currency); err != nil {
+			return nil, err
+		}
+		c.UnitPrice = pricing.Decimal(&unitPrice)
+		out = append(out, &c)
+		lines = append(lines, domain.BundleLine{ProductID: c.ProductID, Quantity: c.Quantity,
+			UnitPrice: domain.NewMoneyFromRatIn(domain.Currency(c.Currency), &unitPrice)})
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+
+	shares, err := domain.AllocateBundlePrice(effective, lines)
+	if errors.Is(err, domain.ErrBundleCurrencyMismatch) {
+		return out, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, share := range shares {
+		out[i].AllocatedPrice = share.Rat().RatString()
+	}
+	return out, nil
+}
+
+// variantDTO prices a variant like its product: the variant's own price replaces the
+// base price in cols when prices are read in its currency; otherwise it inherits the
+// product's price in the requested currency.
+func (q *SpannerGetProductQuery) variantDTO(v *domain.Variant, cols pricing.Columns, now time.Time, category string) (*dto.VariantDTO, error) {
+	out := &dto.VariantDTO{
+		VariantID: v.ID(),
+		SKU:       v.SKU(),
+		Options:   v.Options(),
+		Status:    string(v.Status()),
+		CreatedAt: v.CreatedAt().UTC().Format(time.RFC3339Nano),
+		UpdatedAt: v.UpdatedAt().UTC().Format(time.RFC3339Nano),
+	}
+	if v.Price() != nil {
+		own := pricing.Decimal(v.Price().Rat())
+		out.Price = &own
+		if cols.Currency == v.Price().Currency().String() {
+			cols.BasePrice = *new(big.Rat).Set(v.Price().Rat())
+		}
+	}
+
+	effective, err := pricing.EffectivePrice(cols, now)
+	if err != nil {
+		return nil, err
+	}
+	out.EffectivePrice = effective.FloatString(10)
+	out.EffectivePriceExact = effective.RatString()
+	rounded, _ := pricing.RoundedPrice(effective, cols.Currency, category, q.Rounding)
+	out.RoundedPrice = rounded.FloatString(rounded.Currency().MinorUnits())
+	return out, nil
+}
+
+// variantOptions decodes a variant's option_values JSON object.
+func variantOptions(v spanner.NullJSON) (domain.VariantOptions, error) {
+	raw, ok := v.Value.(map[string]interface{})
+	if !v.Valid || !ok {
+		return nil, fmt.Errorf("invalid stored variant options: %v", v.Value)
+	}
+	out := make(domain.VariantOptions, len(raw))
+	for name, value := range raw {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid stored variant option %q: %v", name, value)
+		}
+		out[name] = s
+	}
+	return out, nil
+}
+
+// mediaAltTexts decodes a media entry's alt_texts JSON object.
+func mediaAltTexts(v spanner.NullJSON) (map[string]string, error) {
+	raw, ok := v.Value.(map[string]interface{})
+	if !v.Valid || !ok {
+		return nil, fmt.Errorf("invalid stored media alt texts: %v", v.Value)
+	}
+	out := make(map[string]string, len(raw))
+	for locale, value := range raw {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid stored media alt text %q: %v", locale, value)
+		}
+		out[locale] = s
+	}
+	return out, nil
+}
+
+// loadSegmentEntry reads the product's entry in the segment's price list into cols.
+// Returns domain.ErrPriceListNotFound when no price list exists for the segment.
+func (q *SpannerGetProductQuery) loadSegmentEntry(ctx context.Context, productID, segment string, cols *pricing.Columns) error {
+	stmt := spanner.Statement{
+		SQL: `SELECT e.override_price, e.override_currency, e.adjustment_percent
+		      FROM price_lists pl
+		      LEFT JOIN price_list_entries e
+		        ON e.price_list_id = pl.price_list_id AND e.product_id = @id
+		      WHERE pl.segment = @segment`,
+		Params: map[string]interface{}{"id": productID, "segment": segment},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return domain.ErrPriceListNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return row.Columns(&cols.OverridePrice, &cols.OverrideCurrency, &cols.Adjustment)
+}
+
+// availableIn reports whether a product with the given region lists ships to region,
+// matching domain.Product.AvailableIn.
+func availableIn(region string, allowed, blocked []string) bool {
+	for _, r := range blocked {
+		if r == region {
+			return false
+		}
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, r := range allowed {
+		if r == region {
+			return true
+		}
+	}
+	return false
 }
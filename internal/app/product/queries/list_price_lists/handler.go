@@ -0,0 +1,20 @@
+package list_price_lists
+
+import (
+	"context"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+type Handler struct {
+	readModel contracts.PriceListReadModel
+}
+
+func NewHandler(r contracts.PriceListReadModel) *Handler {
+	return &Handler{readModel: r}
+}
+
+func (h *Handler) Execute(ctx context.Context) ([]*dto.PriceListDTO, error) {
+	return h.readModel.ListPriceLists(ctx)
+}
@@ -0,0 +1,48 @@
+package list_price_lists
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_price_list"
+)
+
+// SpannerListPriceListsQuery lists all price lists (without entries).
+type SpannerListPriceListsQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerListPriceListsQuery(client *spanner.Client) *SpannerListPriceListsQuery {
+	return &SpannerListPriceListsQuery{Client: client}
+}
+
+func (q *SpannerListPriceListsQuery) ListPriceLists(ctx context.Context) ([]*dto.PriceListDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT price_list_id, segment, name, description, created_at, updated_at
+		      FROM price_lists
+		      ORDER BY segment ASC`,
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	out := make([]*dto.PriceListDTO, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		pl, err := get_price_list.ScanPriceList(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pl)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
 )
 
@@ -15,6 +16,21 @@ func NewHandler(r contracts.ReadModel) *Handler {
 	return &Handler{readModel: r}
 }
 
-func (h *Handler) Execute(ctx context.Context, category *string, limit, offset int) ([]*dto.ProductSummaryDTO, error) {
-	return h.readModel.ListActiveProducts(ctx, category, limit, offset)
+func (h *Handler) Execute(ctx context.Context, filter contracts.ProductFilter, opts contracts.ProductReadOptions, limit, offset int) ([]*dto.ProductSummaryDTO, error) {
+	return h.readModel.ListActiveProducts(ctx, filter, opts, limit, offset)
+}
+
+// ExecuteRelated lists the active products related to productID, of relTypes when not
+// empty (see contracts.ProductFilter.RelatedTo). An unknown product fails with
+// domain.ErrProductNotFound rather than listing nothing.
+func (h *Handler) ExecuteRelated(ctx context.Context, productID string, relTypes []domain.RelationType, inverse bool, opts contracts.ProductReadOptions, limit, offset int) ([]*dto.ProductSummaryDTO, error) {
+	filter := contracts.ProductFilter{RelatedTo: &productID, RelationTypes: relTypes, RelatedInverse: inverse}
+	items, err := h.readModel.ListActiveProducts(ctx, filter, opts, limit, offset)
+	if err != nil || len(items) > 0 {
+		return items, err
+	}
+	if _, err := h.readModel.GetProduct(ctx, productID, contracts.ProductReadOptions{}); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
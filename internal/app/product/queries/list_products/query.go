@@ -2,39 +2,194 @@ package list_products
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
 
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/exchange_rates"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/localization"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/pricing"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/tax_rates"
 )
 
-// SpannerListProductsQuery lists active products with optional category filter.
+// translatedName is a product's name in one locale, as selected by ListActiveProducts.
+type translatedName struct {
+	Locale string `spanner:"locale"`
+	Name   string `spanner:"name"`
+}
+
+// SpannerListProductsQuery lists active products with optional category and attribute filters.
 type SpannerListProductsQuery struct {
-	Client *spanner.Client
+	Client   *spanner.Client
+	Rates    *exchange_rates.SpannerExchangeRateQuery
+	Taxes    *tax_rates.SpannerTaxRateQuery
+	Rounding *domain.RoundingPolicy
 }
 
 func NewSpannerListProductsQuery(client *spanner.Client) *SpannerListProductsQuery {
-	return &SpannerListProductsQuery{Client: client}
+	return &SpannerListProductsQuery{
+		Client: client,
+		Rates:  exchange_rates.NewSpannerExchangeRateQuery(client),
+		Taxes:  tax_rates.NewSpannerTaxRateQuery(client),
+	}
 }
 
-func (q *SpannerListProductsQuery) ListActiveProducts(ctx context.Context, category *string, limit, offset int) ([]*dto.ProductSummaryDTO, error) {
-	baseSQL := `SELECT product_id, name, category,
-					  base_price_numerator, base_price_denominator,
-					  discount_percent, discount_start_date, discount_end_date
-		FROM products
-		WHERE status = 'active'`
+// ListActiveProducts lists the products on sale at opts.At: active products within
+// their availability window, and products whose launch has passed but that the
+// scheduler has not activated yet (see domain.OnSaleAt); those keep their stored
+// draft or inactive Status until it does. When opts.Segment is set,
+// each product's entry in the segment's price list (if any) is applied to its
+// effective price.
+// When opts.Currency is set, only products sold in that currency are listed and
+// their prices are returned in it. When opts.DisplayCurrency is set, effective prices
+// are also converted into it; a missing rate fails the whole listing with
+// domain.ErrExchangeRateNotFound rather than returning a partial page. Likewise, when
+// opts.TaxRegion is set, a tax category without a rate fails with domain.ErrTaxRateNotFound.
+// Prices are evaluated at opts.At (now when zero), honouring due scheduled price changes
+// on the primary base price like GetProduct does. filter.CategoryID selects the
+// category's whole subtree through the categories' materialized paths; an unknown
+// category lists nothing. Each attribute filter must match one of the product's
+// custom attribute values. Tag filters go through idx_product_tags_tag; an invalid
+// tag fails with domain.ErrInvalidTag. filter.RelatedTo lists linked products in
+// link order, each with its RelationType. filter.InStock keeps products with units
+// available at some location. opts.Channel keeps products active on the channel and
+// prices them with its price override like GetProduct does. opts.Region skips
+// products that do not ship to the region.
+// Names are localized for opts.Locales like GetProduct does.
+func (q *SpannerListProductsQuery) ListActiveProducts(ctx context.Context, filter contracts.ProductFilter, opts contracts.ProductReadOptions, limit, offset int) ([]*dto.ProductSummaryDTO, error) {
 	params := map[string]interface{}{}
-	if category != nil {
-		baseSQL += " AND category = @category"
-		params["category"] = *category
+
+	// Segment entries are LEFT JOINed against a single resolved price list;
+	// a NULL price_list_id matches nothing, leaving retail prices untouched.
+	var priceListID spanner.NullString
+	if opts.Segment != "" {
+		id, err := q.resolvePriceListID(ctx, opts.Segment)
+		if err != nil {
+			return nil, err
+		}
+		priceListID = spanner.NullString{StringVal: id, Valid: true}
+	}
+	params["price_list_id"] = priceListID
+
+	// A NULL currency selects each product's primary price.
+	var currency spanner.NullString
+	if opts.Currency != "" {
+		currency = spanner.NullString{StringVal: opts.Currency, Valid: true}
+	}
+	params["currency"] = currency
+
+	now := opts.At.UTC()
+	if opts.At.IsZero() {
+		now = time.Now().UTC()
+	}
+	params["at"] = now
+	availability.AddRegionParams(params)
+
+	// Only translations into the reader's locales, or their languages, are read.
+	preferred := make([]domain.Locale, 0, len(opts.Locales))
+	for _, l := range opts.Locales {
+		preferred = append(preferred, domain.Locale(l))
+	}
+	locales := make([]string, 0, 2*len(preferred))
+	for _, l := range domain.LocaleFallbacks(preferred, "") {
+		locales = append(locales, l.String())
+	}
+	params["locales"] = locales
+
+	// A NULL channel joins no assignment and keeps every product.
+	var channel spanner.NullString
+	if opts.Channel != "" {
+		channel = spanner.NullString{StringVal: opts.Channel, Valid: true}
 	}
-	baseSQL += " ORDER BY name ASC LIMIT @limit OFFSET @offset"
+	params["channel"] = channel
+
+	relationCol, relationJoin, orderBy := "CAST(NULL AS STRING)", "", "p.name ASC"
+	if filter.RelatedTo != nil {
+		relationCol, orderBy = "r.relation_type", "r.relation_type, r.created_at, p.product_id"
+		relationJoin = relationJoinSQL(*filter.RelatedTo, filter.RelationTypes, filter.RelatedInverse, params)
+	}
+
+	// The channel's price override, else the latest pending scheduled change that is
+	// due, replaces the primary base price; ties on effective_at resolve like
+	// domain.BasePriceAt (lowest schedule_id).
+	baseSQL := `SELECT p.product_id, p.name, p.status, p.category, p.category_id, p.tax_category, p.slug, p.sku, p.gtin,
+					  ARRAY(SELECT t.tag FROM product_tags t WHERE t.product_id = p.product_id ORDER BY t.tag),
+					  p.default_locale,
+					  ARRAY(SELECT AS STRUCT tr.locale, tr.name FROM product_translations tr
+					        WHERE tr.product_id = p.product_id AND tr.locale IN UNNEST(@locales)),
+					  IF(pp.product_id IS NULL,
+					     COALESCE(pc.price_override, (SELECT s.price FROM scheduled_price_changes s
+					                                  WHERE s.product_id = p.product_id AND s.status = 'pending'
+					                                    AND s.currency = p.currency AND s.effective_at <= @at
+					                                  ORDER BY s.effective_at DESC, s.schedule_id ASC LIMIT 1), p.base_price),
+					     pp.price),
+					  IF(pp.product_id IS NULL, p.currency, pp.currency),
+					  p.package_quantity, p.unit_of_measure,
+					  p.discount_percent, p.discount_start_date, p.discount_end_date,
+					  e.override_price, e.override_currency, e.adjustment_percent,
+					  EXISTS(SELECT 1 FROM stock_levels sl WHERE sl.product_id = p.product_id AND sl.on_hand > sl.reserved),
+					  ` + relationCol + `
+		FROM products p` + relationJoin + `
+		LEFT JOIN product_prices pp
+		  ON pp.product_id = p.product_id AND pp.currency = @currency
+		LEFT JOIN price_list_entries e
+		  ON e.price_list_id = @price_list_id AND e.product_id = p.product_id
+		LEFT JOIN product_channels pc
+		  ON pc.product_id = p.product_id AND pc.channel = @channel
+		WHERE (p.status = 'active' OR (p.status IN ('draft', 'inactive') AND p.available_from IS NOT NULL
+		                               AND ` + availability.RegionPricesSQL + `))
+		  AND (p.available_from IS NULL OR p.available_from <= @at)
+		  AND (p.available_until IS NULL OR p.available_until > @at)
+		  AND (@currency IS NULL OR p.currency = @currency OR pp.product_id IS NOT NULL)
+		  AND (@channel IS NULL OR pc.active)`
+	if opts.Region != "" {
+		// An empty allow-list ships everywhere the product is not blocked.
+		baseSQL += ` AND (p.allowed_regions IS NULL OR ARRAY_LENGTH(p.allowed_regions) = 0 OR @region IN UNNEST(p.allowed_regions))
+		  AND (p.blocked_regions IS NULL OR @region NOT IN UNNEST(p.blocked_regions))`
+		params["region"] = opts.Region
+	}
+	if filter.Category != nil {
+		baseSQL += " AND p.category = @category"
+		params["category"] = domain.Slugify(*filter.Category)
+	}
+	if filter.CategoryID != nil {
+		baseSQL += ` AND p.category_id IN (
+			SELECT c.category_id FROM categories c, categories root
+			WHERE root.category_id = @category_id
+			  AND (c.category_id = root.category_id OR STARTS_WITH(c.path, CONCAT(root.path, '/'))))`
+		params["category_id"] = *filter.CategoryID
+	}
+	if filter.InStock {
+		baseSQL += ` AND EXISTS (SELECT 1 FROM stock_levels sl
+		                         WHERE sl.product_id = p.product_id AND sl.on_hand > sl.reserved)`
+	}
+	baseSQL += attributeFilterSQL(filter.Attributes, params)
+	tagSQL, err := tagFilterSQL(filter.Tags, filter.TagMatch, params)
+	if err != nil {
+		return nil, err
+	}
+	baseSQL += tagSQL
+	baseSQL += " ORDER BY " + orderBy + " LIMIT @limit OFFSET @offset"
 	params["limit"] = limit
 	params["offset"] = offset
 
+	var converter *exchange_rates.Converter
+	if opts.DisplayCurrency != "" {
+		converter = q.Rates.NewConverter(domain.Currency(opts.DisplayCurrency), now)
+	}
+	var taxes *tax_rates.Calculator
+	if opts.TaxRegion != "" {
+		taxes = q.Taxes.NewCalculator(domain.TaxRegion(opts.TaxRegion), now)
+	}
+
 	stmt := spanner.Statement{SQL: baseSQL, Params: params}
 	iter := q.Client.Single().Query(ctx, stmt)
 	defer iter.Stop()
@@ -50,56 +205,201 @@ func (q *SpannerListProductsQuery) ListActiveProducts(ctx context.Context, categ
 		}
 
 		var (
-			id                         string
-			name                       string
-			categoryStr                string
-			baseNum                    int64
-			baseDen                    int64
-			discountPct                spanner.NullNumeric
-			discountStart, discountEnd spanner.NullTime
+			id          string
+			name        string
+			status      string
+			categoryStr string
+			categoryID  string
+			tags        []string
+			locale      string
+			names       []*translatedName
+			slug        string
+			sku, gtin   spanner.NullString
+			taxCategory string
+			quantity    spanner.NullNumeric
+			unit        spanner.NullString
+			inStock     bool
+			relation    spanner.NullString
+			cols        pricing.Columns
 		)
-		if err := row.Columns(&id, &name, &categoryStr, &baseNum, &baseDen, &discountPct, &discountStart, &discountEnd); err != nil {
+		if err := row.Columns(&id, &name, &status, &categoryStr, &categoryID, &taxCategory, &slug, &sku, &gtin, &tags, &locale, &names, &cols.BasePrice, &cols.Currency, &quantity, &unit,
+			&cols.DiscountPct, &cols.DiscountStart, &cols.DiscountEnd,
+			&cols.OverridePrice, &cols.OverrideCurrency, &cols.Adjustment, &inStock, &relation); err != nil {
+			return nil, err
+		}
+		cols.ProductID = id
+
+		priceRat, err := pricing.EffectivePrice(cols, now)
+		if err != nil {
 			return nil, err
 		}
 
-		priceRat, err := computeEffectivePrice(baseNum, baseDen, discountPct, discountStart, discountEnd, time.Now().UTC())
+		rounded, mode := pricing.RoundedPrice(priceRat, cols.Currency, categoryStr, q.Rounding)
+		unitPrice, err := pricing.UnitPrice(quantity, unit, priceRat, cols.Currency, categoryStr, q.Rounding)
 		if err != nil {
 			return nil, err
 		}
 
-		out = append(out, &dto.ProductSummaryDTO{
-			ProductID:      id,
-			Name:           name,
-			Category:       categoryStr,
-			EffectivePrice: priceRat.FloatString(10),
-			BasePriceNum:   baseNum,
-			BasePriceDen:   baseDen,
-			Status:         "active",
-		})
+		translations := make([]*dto.TranslationDTO, 0, len(names))
+		for _, n := range names {
+			translations = append(translations, &dto.TranslationDTO{Locale: n.Locale, Name: n.Name})
+		}
+		text := localization.Localize(opts.Locales, localization.Text{Locale: locale, Name: name}, translations)
+
+		item := &dto.ProductSummaryDTO{
+			ProductID:           id,
+			Name:                text.Name,
+			Locale:              text.Locale,
+			RelationType:        relation.StringVal,
+			Category:            categoryStr,
+			CategoryID:          categoryID,
+			Tags:                tags,
+			Slug:                slug,
+			EffectivePrice:      priceRat.FloatString(10),
+			EffectivePriceExact: priceRat.RatString(),
+			RoundedPrice:        rounded.FloatString(rounded.Currency().MinorUnits()),
+			RoundingMode:        mode.String(),
+			BasePrice:           pricing.Decimal(&cols.BasePrice),
+			Currency:            cols.Currency,
+			Status:              status,
+			UnitPrice:           unitPrice,
+			InStock:             inStock,
+		}
+		if sku.Valid {
+			item.SKU = &sku.StringVal
+		}
+		if gtin.Valid {
+			item.GTIN = &gtin.StringVal
+		}
+
+		if converter != nil {
+			converted, err := converter.Convert(ctx, domain.NewMoneyFromRatIn(domain.Currency(cols.Currency), priceRat))
+			if err != nil {
+				return nil, err
+			}
+			display, _ := pricing.RoundedPrice(converted.Rat(), opts.DisplayCurrency, categoryStr, q.Rounding)
+			item.DisplayPrice = display.FloatString(domain.Currency(opts.DisplayCurrency).MinorUnits())
+			item.DisplayCurrency = opts.DisplayCurrency
+		}
+
+		if taxes != nil {
+			b, err := taxes.Apply(ctx, domain.TaxCategory(taxCategory), domain.NewMoneyFromRatIn(domain.Currency(cols.Currency), priceRat))
+			if err != nil {
+				return nil, err
+			}
+			item.Tax = pricing.TaxBreakdown(b, categoryStr, q.Rounding)
+		}
+
+		out = append(out, item)
 	}
 }
 
-// computeEffectivePrice mirrors the helper from get_product.
-func computeEffectivePrice(baseNum, baseDen int64, discountPercent spanner.NullNumeric, start, end spanner.NullTime, now time.Time) (*big.Rat, error) {
-	base := new(big.Rat).SetFrac(big.NewInt(baseNum), big.NewInt(baseDen))
+// resolvePriceListID looks up the price list for a segment.
+// Returns domain.ErrPriceListNotFound when no price list exists for the segment.
+func (q *SpannerListProductsQuery) resolvePriceListID(ctx context.Context, segment string) (string, error) {
+	stmt := spanner.Statement{
+		SQL:    `SELECT price_list_id FROM price_lists WHERE segment = @segment`,
+		Params: map[string]interface{}{"segment": segment},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
 
-	if !discountPercent.Valid {
-		return base, nil
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return "", domain.ErrPriceListNotFound
 	}
-	if start.Valid && now.Before(start.Time) {
-		return base, nil
+	if err != nil {
+		return "", err
 	}
-	if end.Valid && now.After(end.Time) {
-		return base, nil
+
+	var id string
+	if err := row.Columns(&id); err != nil {
+		return "", err
 	}
+	return id, nil
+}
 
-	discRat := new(big.Rat).Set(&discountPercent.Numeric)
-	// Defensive: if stored as "20" rather than "0.20", normalize to 0-1 scale.
-	if discRat.Cmp(big.NewRat(1, 1)) == 1 {
-		discRat.Quo(discRat, big.NewRat(100, 1))
+// attributeFilterSQL renders one EXISTS condition per attribute filter, adding its
+// parameters. A filter value also matches number attributes equal to it by value.
+func attributeFilterSQL(attrs []contracts.AttributeFilter, params map[string]interface{}) string {
+	var sql strings.Builder
+	for i, f := range attrs {
+		p := fmt.Sprintf("attr%d_", i)
+		params[p+"name"] = strings.ToLower(strings.TrimSpace(f.Name))
+		sql.WriteString(fmt.Sprintf(`
+		  AND EXISTS (SELECT 1 FROM product_attributes a
+		              WHERE a.product_id = p.product_id AND a.name = @%sname`, p))
+		if f.Value != nil {
+			value := strings.TrimSpace(*f.Value)
+			var number spanner.NullNumeric
+			if n, ok := new(big.Rat).SetString(value); ok {
+				number = spanner.NullNumeric{Numeric: *n, Valid: true}
+			}
+			params[p+"value"], params[p+"number"] = value, number
+			sql.WriteString(fmt.Sprintf(" AND (LOWER(a.value) = LOWER(@%svalue) OR a.number_value = @%snumber)", p, p))
+		}
+		if f.Min != nil {
+			params[p+"min"] = *f.Min
+			sql.WriteString(fmt.Sprintf(" AND a.number_value >= @%smin", p))
+		}
+		if f.Max != nil {
+			params[p+"max"] = *f.Max
+			sql.WriteString(fmt.Sprintf(" AND a.number_value <= @%smax", p))
+		}
+		sql.WriteString(")")
 	}
+	return sql.String()
+}
 
-	discountAmount := new(big.Rat).Mul(base, discRat)
-	final := new(big.Rat).Sub(base, discountAmount)
-	return final, nil
+// tagFilterSQL renders the tag condition of a listing, adding its parameters:
+// TagMatchAll requires every tag, anything else at least one.
+func tagFilterSQL(tags []string, match contracts.TagMatch, params map[string]interface{}) (string, error) {
+	if len(tags) == 0 {
+		return "", nil
+	}
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tag, err := domain.NormalizeTag(t)
+		if err != nil {
+			return "", err
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	params["tags"] = normalized
+
+	if match == contracts.TagMatchAll {
+		params["tag_count"] = int64(len(normalized))
+		return `
+		  AND (SELECT COUNT(*) FROM product_tags t
+		       WHERE t.product_id = p.product_id AND t.tag IN UNNEST(@tags)) = @tag_count`, nil
+	}
+	return `
+		  AND EXISTS (SELECT 1 FROM product_tags t
+		              WHERE t.product_id = p.product_id AND t.tag IN UNNEST(@tags))`, nil
+}
+
+// relationJoinSQL joins the links of the product relatedTo, restricted to relTypes
+// when not empty; inverse joins the links to it instead.
+func relationJoinSQL(relatedTo string, relTypes []domain.RelationType, inverse bool, params map[string]interface{}) string {
+	params["related_to"] = relatedTo
+	join := `
+		JOIN product_relations r ON r.related_product_id = p.product_id AND r.product_id = @related_to`
+	if inverse {
+		join = `
+		JOIN product_relations r ON r.product_id = p.product_id AND r.related_product_id = @related_to`
+	}
+	if len(relTypes) > 0 {
+		types := make([]string, 0, len(relTypes))
+		for _, t := range relTypes {
+			types = append(types, string(t))
+		}
+		params["relation_types"] = types
+		join += " AND r.relation_type IN UNNEST(@relation_types)"
+	}
+	return join
 }
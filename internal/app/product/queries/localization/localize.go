@@ -0,0 +1,68 @@
+package localization
+
+import (
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+// Text is a product's name and description in one locale; Description is nil when unset.
+type Text struct {
+	Locale      string
+	Name        string
+	Description *string
+}
+
+// Localize picks the name and description to show a reader preferring locales,
+// trying them in the order of domain.LocaleFallbacks ending with the product's
+// default locale, whose texts are base. The name comes from the first locale the
+// product has texts in; the description from the first one that has a description.
+func Localize(locales []string, base Text, translations []*dto.TranslationDTO) Text {
+	if len(locales) == 0 || len(translations) == 0 {
+		return base
+	}
+
+	byLocale := make(map[domain.Locale]Text, len(translations)+1)
+	for _, t := range translations {
+		byLocale[domain.Locale(t.Locale)] = Text{Locale: t.Locale, Name: t.Name, Description: t.Description}
+	}
+	byLocale[domain.Locale(base.Locale)] = base
+
+	preferred := make([]domain.Locale, 0, len(locales))
+	for _, l := range locales {
+		preferred = append(preferred, domain.Locale(l))
+	}
+
+	var out Text
+	found := false
+	for _, l := range domain.LocaleFallbacks(preferred, domain.Locale(base.Locale)) {
+		t, ok := byLocale[l]
+		if !ok {
+			continue
+		}
+		if !found {
+			out, found = t, true
+		}
+		if out.Description == nil && t.Description != nil {
+			out.Description = t.Description
+		}
+		if out.Description != nil {
+			break
+		}
+	}
+	return out
+}
+
+// AltText picks a media alt text for a reader preferring locales like Localize picks
+// a name, or returns "" when there is none in those locales or the default locale.
+func AltText(locales []string, defaultLocale string, texts map[string]string) string {
+	preferred := make([]domain.Locale, 0, len(locales))
+	for _, l := range locales {
+		preferred = append(preferred, domain.Locale(l))
+	}
+	for _, l := range domain.LocaleFallbacks(preferred, domain.Locale(defaultLocale)) {
+		if t, ok := texts[l.String()]; ok {
+			return t
+		}
+	}
+	return ""
+}
@@ -0,0 +1,20 @@
+package price_change_requests
+
+import (
+	"context"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+type Handler struct {
+	readModel contracts.PriceChangeRequestReadModel
+}
+
+func NewHandler(r contracts.PriceChangeRequestReadModel) *Handler {
+	return &Handler{readModel: r}
+}
+
+func (h *Handler) Execute(ctx context.Context, productID, status string, limit, offset int) ([]*dto.PriceChangeRequestDTO, error) {
+	return h.readModel.ListPriceChangeRequests(ctx, productID, status, limit, offset)
+}
@@ -0,0 +1,154 @@
+package price_change_requests
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/pricing"
+)
+
+const selectColumns = `SELECT request_id, product_id, kind, variant_id, channel, currency, old_price, new_price,
+		             discount_percent, discount_start_date, discount_end_date, override_margin,
+		             requested_by, requested_role, status, decided_by, decision_reason, created_at, decided_at
+		      FROM price_change_requests`
+
+// SpannerPriceChangeRequestQuery reads price change requests from Spanner directly.
+type SpannerPriceChangeRequestQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerPriceChangeRequestQuery(client *spanner.Client) *SpannerPriceChangeRequestQuery {
+	return &SpannerPriceChangeRequestQuery{Client: client}
+}
+
+// GetPriceChangeRequest fetches a request by ID.
+// Returns domain.ErrPriceChangeRequestNotFound when it does not exist.
+func (q *SpannerPriceChangeRequestQuery) GetPriceChangeRequest(ctx context.Context, requestID string) (*dto.PriceChangeRequestDTO, error) {
+	stmt := spanner.Statement{
+		SQL:    selectColumns + ` WHERE request_id = @id`,
+		Params: map[string]interface{}{"id": requestID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, domain.ErrPriceChangeRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return scanRequest(row)
+}
+
+// ListPriceChangeRequests lists requests, newest first. Empty productID or status match all.
+func (q *SpannerPriceChangeRequestQuery) ListPriceChangeRequests(ctx context.Context, productID, status string, limit, offset int) ([]*dto.PriceChangeRequestDTO, error) {
+	stmt := spanner.Statement{
+		SQL: selectColumns + `
+		      WHERE (@product_id = '' OR product_id = @product_id)
+		        AND (@status = '' OR status = @status)
+		      ORDER BY created_at DESC, request_id
+		      LIMIT @limit OFFSET @offset`,
+		Params: map[string]interface{}{
+			"product_id": productID,
+			"status":     status,
+			"limit":      limit,
+			"offset":     offset,
+		},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.PriceChangeRequestDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		r, err := scanRequest(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+}
+
+// scanRequest maps a price_change_requests row (in selectColumns order) into a DTO.
+func scanRequest(row *spanner.Row) (*dto.PriceChangeRequestDTO, error) {
+	var (
+		id, productID, kind, status     string
+		variantID, channel, currency    spanner.NullString
+		oldPrice, newPrice, discountPct spanner.NullNumeric
+		discountStart, discountEnd      spanner.NullTime
+		overrideMargin                  bool
+		requestedBy, requestedRole      spanner.NullString
+		decidedBy, reason               spanner.NullString
+		createdAt                       time.Time
+		decidedAt                       spanner.NullTime
+	)
+	if err := row.Columns(&id, &productID, &kind, &variantID, &channel, &currency, &oldPrice, &newPrice,
+		&discountPct, &discountStart, &discountEnd, &overrideMargin,
+		&requestedBy, &requestedRole, &status, &decidedBy, &reason, &createdAt, &decidedAt); err != nil {
+		return nil, err
+	}
+
+	out := &dto.PriceChangeRequestDTO{
+		RequestID:      id,
+		ProductID:      productID,
+		Kind:           kind,
+		OverrideMargin: overrideMargin,
+		RequestedBy:    requestedBy.StringVal,
+		RequestedRole:  requestedRole.StringVal,
+		Status:         status,
+		DecidedBy:      decidedBy.StringVal,
+		Reason:         reason.StringVal,
+		CreatedAt:      createdAt.UTC().Format(time.RFC3339),
+	}
+	if variantID.Valid {
+		v := variantID.StringVal
+		out.VariantID = &v
+	}
+	if channel.Valid {
+		c := channel.StringVal
+		out.Channel = &c
+	}
+	if currency.Valid {
+		c := currency.StringVal
+		out.Currency = &c
+	}
+	if oldPrice.Valid {
+		p := pricing.Decimal(&oldPrice.Numeric)
+		out.OldPrice = &p
+	}
+	if newPrice.Valid {
+		p := pricing.Decimal(&newPrice.Numeric)
+		out.NewPrice = &p
+	}
+	if discountPct.Valid {
+		dp := discountPct.Numeric.FloatString(10)
+		out.DiscountPct = &dp
+	}
+	if discountStart.Valid {
+		ds := discountStart.Time.UTC().Format(time.RFC3339)
+		out.DiscountStart = &ds
+	}
+	if discountEnd.Valid {
+		de := discountEnd.Time.UTC().Format(time.RFC3339)
+		out.DiscountEnd = &de
+	}
+	if decidedAt.Valid {
+		da := decidedAt.Time.UTC().Format(time.RFC3339)
+		out.DecidedAt = &da
+	}
+	return out, nil
+}
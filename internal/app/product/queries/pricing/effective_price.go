@@ -0,0 +1,133 @@
+package pricing
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain/services"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+// Columns holds the raw pricing columns a read query selects for one product.
+// Segment columns are NULL when no price list is requested or the product has no entry.
+type Columns struct {
+	ProductID     string
+	BasePrice     big.Rat
+	Currency      string
+	DiscountPct   spanner.NullNumeric
+	DiscountStart spanner.NullTime
+	DiscountEnd   spanner.NullTime
+
+	OverridePrice spanner.NullNumeric
+	// OverrideCurrency is NULL for overrides stored before currencies were introduced.
+	OverrideCurrency spanner.NullString
+	Adjustment       spanner.NullNumeric
+}
+
+// EffectivePrice resolves the effective price for a row at the given time.
+// The read side bypasses the aggregate, but still delegates the pricing rules
+// to the domain PricingCalculator so reads and writes never disagree.
+func EffectivePrice(c Columns, now time.Time) (*big.Rat, error) {
+	base := domain.NewMoneyFromRatIn(currencyOrDefault(c.Currency), &c.BasePrice)
+
+	var entry *domain.PriceListEntry
+	switch {
+	case c.OverridePrice.Valid:
+		e, err := domain.NewPriceListOverride(c.ProductID,
+			domain.NewMoneyFromRatIn(currencyOrDefault(c.OverrideCurrency.StringVal), &c.OverridePrice.Numeric))
+		if err != nil {
+			return nil, err
+		}
+		entry = e
+	case c.Adjustment.Valid:
+		e, err := domain.NewPriceListAdjustment(c.ProductID, &c.Adjustment.Numeric)
+		if err != nil {
+			return nil, err
+		}
+		entry = e
+	}
+
+	discount, err := discountFromColumns(c)
+	if err != nil {
+		return nil, err
+	}
+
+	price := services.NewPricingCalculator().CalculateSegmentPrice(base, entry, discount, now)
+	return price.Rat(), nil
+}
+
+// RoundedPrice rounds an exact effective price with the rounding policy, via the
+// domain PricingCalculator. A nil policy applies domain.DefaultRoundingMode.
+func RoundedPrice(exact *big.Rat, currency, category string, policy *domain.RoundingPolicy) (*domain.Money, domain.RoundingMode) {
+	price := domain.NewMoneyFromRatIn(currencyOrDefault(currency), exact)
+	return services.NewPricingCalculator().CalculateRoundedPrice(price, policy, category)
+}
+
+// Decimal formats a NUMERIC value as an exact decimal string without trailing
+// zeros. NUMERIC has at most 9 fractional digits, so nothing is rounded.
+func Decimal(r *big.Rat) string {
+	s := r.FloatString(domain.NumericScale)
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
+
+// discountFromColumns rebuilds the stored discount (if complete) as a domain value object.
+func discountFromColumns(c Columns) (*domain.Discount, error) {
+	if !c.DiscountPct.Valid || !c.DiscountStart.Valid || !c.DiscountEnd.Valid {
+		return nil, nil
+	}
+
+	// discount_percent is stored as a NUMERIC (0.0-1.0 scale) and decoded into big.Rat.
+	discRat := new(big.Rat).Set(&c.DiscountPct.Numeric)
+	// Defensive: if stored as "20" rather than "0.20", normalize to 0-1 scale.
+	if discRat.Cmp(big.NewRat(1, 1)) == 1 {
+		discRat.Quo(discRat, big.NewRat(100, 1))
+	}
+
+	return domain.NewDiscountFromRat(discRat, c.DiscountStart.Time, c.DiscountEnd.Time)
+}
+
+// currencyOrDefault maps an empty stored currency to domain.DefaultCurrency.
+func currencyOrDefault(code string) domain.Currency {
+	if code == "" {
+		return domain.DefaultCurrency
+	}
+	return domain.Currency(code)
+}
+
+// TaxBreakdown maps an exact tax breakdown to its DTO. The gross price is rounded
+// like the effective price, via the domain PricingCalculator.
+func TaxBreakdown(b *domain.TaxBreakdown, category string, policy *domain.RoundingPolicy) *dto.TaxDTO {
+	rounded, _ := services.NewPricingCalculator().CalculateRoundedPrice(b.Gross, policy, category)
+	return &dto.TaxDTO{
+		Region:       b.Rate.Region().String(),
+		Category:     b.Rate.Category().String(),
+		Rate:         b.Rate.RateString(),
+		Net:          b.Net.Rat().RatString(),
+		Amount:       b.Tax.Rat().RatString(),
+		Gross:        b.Gross.Rat().RatString(),
+		RoundedGross: rounded.FloatString(rounded.Currency().MinorUnits()),
+	}
+}
+
+// UnitPrice quotes an exact effective price per reference unit of the stored package
+// size, rounded like the effective price. It returns nil when no package size is stored.
+func UnitPrice(quantity spanner.NullNumeric, unit spanner.NullString, exact *big.Rat, currency, category string, policy *domain.RoundingPolicy) (*dto.UnitPriceDTO, error) {
+	if !quantity.Valid || !unit.Valid {
+		return nil, nil
+	}
+	size, err := domain.NewPackageSize(&quantity.Numeric, domain.UnitOfMeasure(unit.StringVal))
+	if err != nil {
+		return nil, err
+	}
+	price, ref := size.UnitPrice(domain.NewMoneyFromRatIn(currencyOrDefault(currency), exact))
+	rounded, _ := services.NewPricingCalculator().CalculateRoundedPrice(price, policy, category)
+	return &dto.UnitPriceDTO{
+		Price:        price.Rat().RatString(),
+		RoundedPrice: rounded.FloatString(rounded.Currency().MinorUnits()),
+		Unit:         ref.String(),
+	}, nil
+}
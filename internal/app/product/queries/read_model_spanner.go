@@ -2,32 +2,157 @@ package queries
 
 import (
 	"context"
+	"time"
 
 	"cloud.google.com/go/spanner"
 
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/attribute_definitions"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/categories"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_price_lists"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/price_change_requests"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/scheduled_availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/scheduled_prices"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/stock_levels"
 )
 
-// SpannerReadModel is an infrastructure adapter that satisfies contracts.ReadModel.
+// SpannerReadModel is an infrastructure adapter that satisfies contracts.ReadModel,
+// contracts.PriceListReadModel, contracts.ScheduledPriceReadModel,
+// contracts.ScheduledAvailabilityReadModel, contracts.PriceChangeRequestReadModel,
+// contracts.AttributeSchemaReadModel, contracts.CategoryReadModel and contracts.StockReadModel.
 // It composes the individual query implementations.
 type SpannerReadModel struct {
 	getQ  *get_product.SpannerGetProductQuery
 	listQ *list_products.SpannerListProductsQuery
+
+	getPriceListQ   *get_price_list.SpannerGetPriceListQuery
+	listPriceListsQ *list_price_lists.SpannerListPriceListsQuery
+
+	scheduledPricesQ       *scheduled_prices.SpannerScheduledPriceQuery
+	scheduledAvailabilityQ *scheduled_availability.SpannerScheduledAvailabilityQuery
+	changeRequestsQ        *price_change_requests.SpannerPriceChangeRequestQuery
+	attributesQ            *attribute_definitions.SpannerAttributeDefinitionQuery
+	categoriesQ            *categories.SpannerCategoryQuery
+	stockQ                 *stock_levels.SpannerStockQuery
+}
+
+// Option configures a SpannerReadModel.
+type Option func(rm *SpannerReadModel)
+
+// WithRoundingPolicy sets the policy used to round effective and display prices.
+// Without it, domain.DefaultRoundingMode applies to every product.
+func WithRoundingPolicy(policy *domain.RoundingPolicy) Option {
+	return func(rm *SpannerReadModel) {
+		rm.getQ.Rounding = policy
+		rm.listQ.Rounding = policy
+	}
 }
 
-func NewSpannerReadModel(client *spanner.Client) *SpannerReadModel {
-	return &SpannerReadModel{
-		getQ:  get_product.NewSpannerGetProductQuery(client),
-		listQ: list_products.NewSpannerListProductsQuery(client),
+func NewSpannerReadModel(client *spanner.Client, opts ...Option) *SpannerReadModel {
+	rm := &SpannerReadModel{
+		getQ:            get_product.NewSpannerGetProductQuery(client),
+		listQ:           list_products.NewSpannerListProductsQuery(client),
+		getPriceListQ:   get_price_list.NewSpannerGetPriceListQuery(client),
+		listPriceListsQ: list_price_lists.NewSpannerListPriceListsQuery(client),
+
+		scheduledPricesQ:       scheduled_prices.NewSpannerScheduledPriceQuery(client),
+		scheduledAvailabilityQ: scheduled_availability.NewSpannerScheduledAvailabilityQuery(client),
+		changeRequestsQ:        price_change_requests.NewSpannerPriceChangeRequestQuery(client),
+		attributesQ:            attribute_definitions.NewSpannerAttributeDefinitionQuery(client),
+		categoriesQ:            categories.NewSpannerCategoryQuery(client),
+		stockQ:                 stock_levels.NewSpannerStockQuery(client),
+	}
+	for _, opt := range opts {
+		opt(rm)
 	}
+	return rm
+}
+
+func (rm *SpannerReadModel) GetProduct(ctx context.Context, productID string, opts contracts.ProductReadOptions) (*dto.ProductDTO, error) {
+	return rm.getQ.GetProduct(ctx, productID, opts)
+}
+
+func (rm *SpannerReadModel) FindProductIDBySKU(ctx context.Context, sku string) (string, error) {
+	return rm.getQ.FindProductIDBySKU(ctx, sku)
+}
+
+func (rm *SpannerReadModel) FindProductIDByGTIN(ctx context.Context, gtin string) (string, error) {
+	return rm.getQ.FindProductIDByGTIN(ctx, gtin)
+}
+
+func (rm *SpannerReadModel) FindProductIDBySlug(ctx context.Context, slug string) (string, bool, error) {
+	return rm.getQ.FindProductIDBySlug(ctx, slug)
+}
+
+func (rm *SpannerReadModel) FindBundleIDsByComponent(ctx context.Context, productID string) ([]string, error) {
+	return rm.getQ.FindBundleIDsByComponent(ctx, productID)
+}
+
+func (rm *SpannerReadModel) ListActiveProducts(ctx context.Context, filter contracts.ProductFilter, opts contracts.ProductReadOptions, limit, offset int) ([]*dto.ProductSummaryDTO, error) {
+	return rm.listQ.ListActiveProducts(ctx, filter, opts, limit, offset)
+}
+
+func (rm *SpannerReadModel) GetPriceList(ctx context.Context, priceListID string) (*dto.PriceListDTO, error) {
+	return rm.getPriceListQ.GetPriceList(ctx, priceListID)
+}
+
+func (rm *SpannerReadModel) GetPriceListBySegment(ctx context.Context, segment string) (*dto.PriceListDTO, error) {
+	return rm.getPriceListQ.GetPriceListBySegment(ctx, segment)
+}
+
+func (rm *SpannerReadModel) ListPriceLists(ctx context.Context) ([]*dto.PriceListDTO, error) {
+	return rm.listPriceListsQ.ListPriceLists(ctx)
+}
+
+func (rm *SpannerReadModel) ListDueScheduledPriceChanges(ctx context.Context, at time.Time, limit int) ([]*dto.DueScheduledPriceChangeDTO, error) {
+	return rm.scheduledPricesQ.ListDueScheduledPriceChanges(ctx, at, limit)
+}
+
+func (rm *SpannerReadModel) ListDueAvailabilityTransitions(ctx context.Context, at time.Time, limit int) ([]*dto.DueAvailabilityTransitionDTO, error) {
+	return rm.scheduledAvailabilityQ.ListDueAvailabilityTransitions(ctx, at, limit)
+}
+
+func (rm *SpannerReadModel) GetPriceChangeRequest(ctx context.Context, requestID string) (*dto.PriceChangeRequestDTO, error) {
+	return rm.changeRequestsQ.GetPriceChangeRequest(ctx, requestID)
+}
+
+func (rm *SpannerReadModel) ListPriceChangeRequests(ctx context.Context, productID, status string, limit, offset int) ([]*dto.PriceChangeRequestDTO, error) {
+	return rm.changeRequestsQ.ListPriceChangeRequests(ctx, productID, status, limit, offset)
+}
+
+func (rm *SpannerReadModel) ListAttributeDefinitions(ctx context.Context, category string) ([]*dto.AttributeDefinitionDTO, error) {
+	return rm.attributesQ.ListAttributeDefinitions(ctx, category)
+}
+
+func (rm *SpannerReadModel) GetCategory(ctx context.Context, categoryID string) (*dto.CategoryDTO, error) {
+	return rm.categoriesQ.GetCategory(ctx, categoryID)
+}
+
+func (rm *SpannerReadModel) GetCategoryBySlug(ctx context.Context, slug string) (*dto.CategoryDTO, error) {
+	return rm.categoriesQ.GetCategoryBySlug(ctx, slug)
+}
+
+func (rm *SpannerReadModel) ListCategories(ctx context.Context, parentID string) ([]*dto.CategoryDTO, error) {
+	return rm.categoriesQ.ListCategories(ctx, parentID)
+}
+
+func (rm *SpannerReadModel) ListCategoryDescendants(ctx context.Context, categoryID string) ([]*dto.CategoryDTO, error) {
+	return rm.categoriesQ.ListCategoryDescendants(ctx, categoryID)
+}
+
+func (rm *SpannerReadModel) CountCategoryProducts(ctx context.Context, categoryID string) (int, error) {
+	return rm.categoriesQ.CountCategoryProducts(ctx, categoryID)
 }
 
-func (rm *SpannerReadModel) GetProduct(ctx context.Context, productID string) (*dto.ProductDTO, error) {
-	return rm.getQ.GetProduct(ctx, productID)
+func (rm *SpannerReadModel) GetStockLevel(ctx context.Context, productID, locationID string) (*dto.StockLevelDTO, error) {
+	return rm.stockQ.GetStockLevel(ctx, productID, locationID)
 }
 
-func (rm *SpannerReadModel) ListActiveProducts(ctx context.Context, category *string, limit, offset int) ([]*dto.ProductSummaryDTO, error) {
-	return rm.listQ.ListActiveProducts(ctx, category, limit, offset)
+func (rm *SpannerReadModel) GetStockReservation(ctx context.Context, reservationID string) (*dto.StockReservationDTO, error) {
+	return rm.stockQ.GetStockReservation(ctx, reservationID)
 }
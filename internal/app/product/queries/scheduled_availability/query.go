@@ -0,0 +1,65 @@
+package scheduled_availability
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/availability"
+)
+
+// SpannerScheduledAvailabilityQuery finds due launches and discontinuations (served by
+// idx_products_available_from and idx_products_available_until).
+type SpannerScheduledAvailabilityQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerScheduledAvailabilityQuery(client *spanner.Client) *SpannerScheduledAvailabilityQuery {
+	return &SpannerScheduledAvailabilityQuery{Client: client}
+}
+
+// ListDueAvailabilityTransitions returns up to limit due transitions, oldest first:
+// draft or inactive products whose launch has passed while their window is still open
+// are due for activation unless a region price Activate requires is missing (they wait
+// for it without holding up the batch), active products whose discontinuation has passed for deactivation.
+func (q *SpannerScheduledAvailabilityQuery) ListDueAvailabilityTransitions(ctx context.Context, at time.Time, limit int) ([]*dto.DueAvailabilityTransitionDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id, transition FROM (
+		        SELECT p.product_id, 'activate' AS transition, p.available_from AS due_at
+		        FROM products p
+		        WHERE p.available_from <= @at AND p.status IN ('draft', 'inactive')
+		          AND (p.available_until IS NULL OR p.available_until > @at)
+		          AND ` + availability.RegionPricesSQL + `
+		        UNION ALL
+		        SELECT product_id, 'deactivate' AS transition, available_until AS due_at
+		        FROM products
+		        WHERE available_until <= @at AND status = 'active'
+		      )
+		      ORDER BY due_at, product_id
+		      LIMIT @limit`,
+		Params: map[string]interface{}{"at": at.UTC(), "limit": limit},
+	}
+	availability.AddRegionParams(stmt.Params)
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.DueAvailabilityTransitionDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var d dto.DueAvailabilityTransitionDTO
+		if err := row.Columns(&d.ProductID, &d.Transition); err != nil {
+			return nil, err
+		}
+		out = append(out, &d)
+	}
+}
@@ -0,0 +1,52 @@
+package scheduled_prices
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+// SpannerScheduledPriceQuery finds due scheduled price changes (served by idx_scheduled_price_changes_due).
+type SpannerScheduledPriceQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerScheduledPriceQuery(client *spanner.Client) *SpannerScheduledPriceQuery {
+	return &SpannerScheduledPriceQuery{Client: client}
+}
+
+// ListDueScheduledPriceChanges returns up to limit pending changes effective at or
+// before the given time, oldest first.
+func (q *SpannerScheduledPriceQuery) ListDueScheduledPriceChanges(ctx context.Context, at time.Time, limit int) ([]*dto.DueScheduledPriceChangeDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id, schedule_id
+		      FROM scheduled_price_changes
+		      WHERE status = 'pending' AND effective_at <= @at
+		      ORDER BY effective_at, schedule_id
+		      LIMIT @limit`,
+		Params: map[string]interface{}{"at": at.UTC(), "limit": limit},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.DueScheduledPriceChangeDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var d dto.DueScheduledPriceChangeDTO
+		if err := row.Columns(&d.ProductID, &d.ScheduleID); err != nil {
+			return nil, err
+		}
+		out = append(out, &d)
+	}
+}
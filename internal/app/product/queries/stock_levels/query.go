@@ -0,0 +1,107 @@
+package stock_levels
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+const selectLevelColumns = `SELECT product_id, location_id, on_hand, reserved, low_stock_threshold, version, updated_at
+		      FROM stock_levels`
+
+// SpannerStockQuery reads stock levels and reservations from Spanner directly.
+type SpannerStockQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerStockQuery(client *spanner.Client) *SpannerStockQuery {
+	return &SpannerStockQuery{Client: client}
+}
+
+// GetStockLevel fetches a product's stock at a location.
+// Returns domain.ErrStockLevelNotFound when the product has none there.
+func (q *SpannerStockQuery) GetStockLevel(ctx context.Context, productID, locationID string) (*dto.StockLevelDTO, error) {
+	stmt := spanner.Statement{
+		SQL:    selectLevelColumns + ` WHERE product_id = @product_id AND location_id = @location_id`,
+		Params: map[string]interface{}{"product_id": productID, "location_id": locationID},
+	}
+	levels, err := q.queryLevels(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	if len(levels) == 0 {
+		return nil, domain.ErrStockLevelNotFound
+	}
+	return levels[0], nil
+}
+
+// ListStockLevels lists a product's stock levels ordered by location ID; none is not an error.
+func (q *SpannerStockQuery) ListStockLevels(ctx context.Context, productID string) ([]*dto.StockLevelDTO, error) {
+	stmt := spanner.Statement{
+		SQL:    selectLevelColumns + ` WHERE product_id = @product_id ORDER BY location_id`,
+		Params: map[string]interface{}{"product_id": productID},
+	}
+	return q.queryLevels(ctx, stmt)
+}
+
+// GetStockReservation fetches a reservation by ID.
+// Returns domain.ErrReservationNotFound when it does not exist (or was released).
+func (q *SpannerStockQuery) GetStockReservation(ctx context.Context, reservationID string) (*dto.StockReservationDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT reservation_id, product_id, location_id, quantity, created_at
+		      FROM stock_reservations
+		      WHERE reservation_id = @id`,
+		Params: map[string]interface{}{"id": reservationID},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, domain.ErrReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		r         dto.StockReservationDTO
+		createdAt time.Time
+	)
+	if err := row.Columns(&r.ReservationID, &r.ProductID, &r.LocationID, &r.Quantity, &createdAt); err != nil {
+		return nil, err
+	}
+	r.CreatedAt = createdAt.UTC().Format(time.RFC3339Nano)
+	return &r, nil
+}
+
+func (q *SpannerStockQuery) queryLevels(ctx context.Context, stmt spanner.Statement) ([]*dto.StockLevelDTO, error) {
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var out []*dto.StockLevelDTO
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var (
+			s         dto.StockLevelDTO
+			updatedAt time.Time
+		)
+		if err := row.Columns(&s.ProductID, &s.LocationID, &s.OnHand, &s.Reserved, &s.LowStockThreshold, &s.Version, &updatedAt); err != nil {
+			return nil, err
+		}
+		s.Available = s.OnHand - s.Reserved
+		s.UpdatedAt = updatedAt.UTC().Format(time.RFC3339Nano)
+		out = append(out, &s)
+	}
+}
@@ -0,0 +1,82 @@
+package tax_rates
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// SpannerTaxRateQuery looks up the tax rate in effect for a region and tax category.
+type SpannerTaxRateQuery struct {
+	Client *spanner.Client
+}
+
+func NewSpannerTaxRateQuery(client *spanner.Client) *SpannerTaxRateQuery {
+	return &SpannerTaxRateQuery{Client: client}
+}
+
+// RateAt returns the rate for the tax category in the region in effect at the given time.
+// Returns domain.ErrTaxRateNotFound when no rate is in effect.
+func (q *SpannerTaxRateQuery) RateAt(ctx context.Context, region domain.TaxRegion, category domain.TaxCategory, at time.Time) (*domain.TaxRate, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT rate, effective_at
+		      FROM tax_rates
+		      WHERE region = @region AND tax_category = @category
+		        AND effective_at <= @at
+		      ORDER BY effective_at DESC
+		      LIMIT 1`,
+		Params: map[string]interface{}{"region": region.String(), "category": category.String(), "at": at.UTC()},
+	}
+
+	iter := q.Client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, domain.ErrTaxRateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rate        spanner.NullNumeric
+		effectiveAt time.Time
+	)
+	if err := row.Columns(&rate, &effectiveAt); err != nil {
+		return nil, err
+	}
+	return domain.NewTaxRate(region, category, &rate.Numeric, effectiveAt)
+}
+
+// Calculator computes tax breakdowns in one region for one read.
+// Rates are looked up once per tax category and cached for the read.
+type Calculator struct {
+	query  *SpannerTaxRateQuery
+	region domain.TaxRegion
+	at     time.Time
+	rates  map[domain.TaxCategory]*domain.TaxRate
+}
+
+// NewCalculator returns a Calculator for the given region at the given time.
+func (q *SpannerTaxRateQuery) NewCalculator(region domain.TaxRegion, at time.Time) *Calculator {
+	return &Calculator{query: q, region: region, at: at, rates: map[domain.TaxCategory]*domain.TaxRate{}}
+}
+
+// Apply splits a net price into net, tax and gross exactly; rounding is left to the caller.
+func (c *Calculator) Apply(ctx context.Context, category domain.TaxCategory, net *domain.Money) (*domain.TaxBreakdown, error) {
+	rate, ok := c.rates[category]
+	if !ok {
+		r, err := c.query.RateAt(ctx, c.region, category, c.at)
+		if err != nil {
+			return nil, err
+		}
+		rate = r
+		c.rates[category] = r
+	}
+	return rate.Apply(net), nil
+}
@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"cloud.google.com/go/spanner"
+
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/models/m_attribute_definition"
+)
+
+// AttributeSchemaRepo is the Spanner implementation of the attribute schema repository.
+// It returns *spanner.Mutation objects but never applies them.
+type AttributeSchemaRepo struct{}
+
+func NewAttributeSchemaRepo() *AttributeSchemaRepo {
+	return &AttributeSchemaRepo{}
+}
+
+// DefinitionMuts returns one mutation per dirty definition: an upsert for definitions
+// that were added or replaced and a delete for definitions that were removed.
+func (r *AttributeSchemaRepo) DefinitionMuts(s *domain.AttributeSchema) []*spanner.Mutation {
+	if s == nil || s.Changes() == nil || !s.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range s.Changes().DirtyFields() {
+		name, ok := domain.AttributeNameFromField(field)
+		if !ok {
+			continue
+		}
+		d, ok := s.Definition(name)
+		if !ok {
+			muts = append(muts, m_attribute_definition.DeleteMutation(s.Category(), name))
+			continue
+		}
+		var unit *string
+		if u := d.Unit(); u != "" {
+			unit = &u
+		}
+		muts = append(muts, m_attribute_definition.UpsertMutation(s.Category(), d.Name(), string(d.Type()),
+			d.Required(), d.EnumValues(), unit, d.CreatedAt().UTC(), d.UpdatedAt().UTC()))
+	}
+	return muts
+}
@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"cloud.google.com/go/spanner"
+
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/models/m_category"
+)
+
+// CategoryRepo is the Spanner implementation of the category repository.
+// It returns *spanner.Mutation objects but never applies them.
+type CategoryRepo struct{}
+
+func NewCategoryRepo() *CategoryRepo {
+	return &CategoryRepo{}
+}
+
+// InsertMut builds an Insert mutation for a new category.
+func (r *CategoryRepo) InsertMut(c *domain.Category) *spanner.Mutation {
+	var parentID *string
+	if id := c.ParentID(); id != "" {
+		parentID = &id
+	}
+	values := m_category.BuildInsertMap(c.ID(), parentID, c.Name(), c.Slug(), c.Path(),
+		c.CreatedAt().UTC(), c.UpdatedAt().UTC())
+	return m_category.InsertMutation(values)
+}
+
+// UpdateMut builds an Update mutation using the aggregate's ChangeTracker.
+func (r *CategoryRepo) UpdateMut(c *domain.Category) *spanner.Mutation {
+	if c == nil || c.Changes() == nil || !c.Changes().HasChanges() {
+		return nil
+	}
+
+	updates := map[string]interface{}{}
+
+	if c.Changes().Dirty(domain.FieldCategoryName) {
+		updates[m_category.ColName] = c.Name()
+	}
+	if c.Changes().Dirty(domain.FieldCategoryParent) {
+		if c.ParentID() == "" {
+			updates[m_category.ColParentID] = nil
+		} else {
+			updates[m_category.ColParentID] = c.ParentID()
+		}
+	}
+	if c.Changes().Dirty(domain.FieldCategoryPath) {
+		updates[m_category.ColPath] = c.Path()
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	updates[m_category.ColUpdatedAt] = c.UpdatedAt().UTC()
+	return m_category.UpdateMutation(c.ID(), updates)
+}
+
+// DeleteMut builds a Delete mutation for the category.
+func (r *CategoryRepo) DeleteMut(c *domain.Category) *spanner.Mutation {
+	if c == nil {
+		return nil
+	}
+	return m_category.DeleteMutation(c.ID())
+}
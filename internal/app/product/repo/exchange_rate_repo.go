@@ -0,0 +1,28 @@
+package repo
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/models/m_exchange_rate"
+)
+
+// ExchangeRateRepo is the Spanner implementation of the exchange rate repository.
+// It returns *spanner.Mutation objects but never applies them.
+type ExchangeRateRepo struct{}
+
+func NewExchangeRateRepo() *ExchangeRateRepo {
+	return &ExchangeRateRepo{}
+}
+
+// UpsertMut builds an InsertOrUpdate mutation for the rate.
+func (r *ExchangeRateRepo) UpsertMut(rate *domain.ExchangeRate, now time.Time) *spanner.Mutation {
+	if rate == nil {
+		return nil
+	}
+	// NUMERIC supports 9 fractional digits.
+	return m_exchange_rate.UpsertMutation(rate.From().String(), rate.To().String(),
+		rate.EffectiveAt().UTC(), rate.Rate().FloatString(9), now.UTC())
+}
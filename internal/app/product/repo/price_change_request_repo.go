@@ -0,0 +1,118 @@
+package repo
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/models/m_price_change_request"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// PriceChangeRequestRepo is the Spanner implementation of the price change request repository.
+// It returns *spanner.Mutation objects but never applies them.
+type PriceChangeRequestRepo struct{}
+
+func NewPriceChangeRequestRepo() *PriceChangeRequestRepo {
+	return &PriceChangeRequestRepo{}
+}
+
+// buildChangeRequestInsertValues constructs the values map used for insertion.
+func buildChangeRequestInsertValues(r *domain.PriceChangeRequest) map[string]interface{} {
+	values := map[string]interface{}{
+		m_price_change_request.ColRequestID:         r.ID(),
+		m_price_change_request.ColProductID:         r.ProductID(),
+		m_price_change_request.ColKind:              string(r.Kind()),
+		m_price_change_request.ColVariantID:         nullableString(r.VariantID()),
+		m_price_change_request.ColChannel:           nullableString(r.Channel()),
+		m_price_change_request.ColCurrency:          nil,
+		m_price_change_request.ColOldPrice:          nil,
+		m_price_change_request.ColNewPrice:          nil,
+		m_price_change_request.ColDiscountPercent:   nil,
+		m_price_change_request.ColDiscountStartDate: nil,
+		m_price_change_request.ColDiscountEndDate:   nil,
+		m_price_change_request.ColOverrideMargin:    r.OverrideMargin(),
+		m_price_change_request.ColRequestedBy:       nullableString(r.RequestedBy()),
+		m_price_change_request.ColRequestedRole:     nullableString(string(r.RequestedRole())),
+		m_price_change_request.ColStatus:            string(r.Status()),
+		m_price_change_request.ColDecidedBy:         nil,
+		m_price_change_request.ColDecisionReason:    nil,
+		m_price_change_request.ColCreatedAt:         r.CreatedAt().UTC(),
+		m_price_change_request.ColDecidedAt:         nil,
+	}
+
+	if p := r.NewPrice(); p != nil {
+		values[m_price_change_request.ColCurrency] = p.Currency().String()
+		values[m_price_change_request.ColOldPrice] = numericPrice(r.OldPrice())
+		values[m_price_change_request.ColNewPrice] = numericPrice(p)
+	}
+	if d := r.Discount(); d != nil {
+		// Same 0-1 fraction as products.discount_percent.
+		values[m_price_change_request.ColDiscountPercent] = d.PercentageRat().FloatString(10)
+		values[m_price_change_request.ColDiscountStartDate] = d.StartDate().UTC()
+		values[m_price_change_request.ColDiscountEndDate] = d.EndDate().UTC()
+	}
+
+	return values
+}
+
+// InsertMut builds an Insert mutation for a new price change request.
+func (repo *PriceChangeRequestRepo) InsertMut(r *domain.PriceChangeRequest) *spanner.Mutation {
+	return m_price_change_request.InsertMutation(buildChangeRequestInsertValues(r))
+}
+
+// UpdateMut builds an Update mutation recording the request's decision.
+func (repo *PriceChangeRequestRepo) UpdateMut(r *domain.PriceChangeRequest) *spanner.Mutation {
+	if r == nil || r.Changes() == nil || !r.Changes().Dirty(domain.FieldChangeRequestDecision) {
+		return nil
+	}
+
+	updates := map[string]interface{}{
+		m_price_change_request.ColStatus:         string(r.Status()),
+		m_price_change_request.ColDecidedBy:      nullableString(r.DecidedBy()),
+		m_price_change_request.ColDecisionReason: nullableString(r.Reason()),
+		m_price_change_request.ColDecidedAt:      nil,
+	}
+	if at := r.DecidedAt(); at != nil {
+		updates[m_price_change_request.ColDecidedAt] = at.UTC()
+	}
+	return m_price_change_request.UpdateMutation(r.ID(), updates)
+}
+
+// PendingCheck builds a check that re-reads the request's status inside the commit's
+// transaction and fails with domain.ErrPriceChangeRequestNotPending when another
+// decision was recorded since the request was loaded.
+func (repo *PriceChangeRequestRepo) PendingCheck(r *domain.PriceChangeRequest) commitplan.Check {
+	if r == nil {
+		return nil
+	}
+	requestID := r.ID()
+	return func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		row, err := tx.ReadRow(ctx, m_price_change_request.TableName, m_price_change_request.Key(requestID),
+			[]string{m_price_change_request.ColStatus})
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+			return domain.ErrPriceChangeRequestNotFound
+		case err != nil:
+			return err
+		}
+		var status string
+		if err := row.Column(0, &status); err != nil {
+			return err
+		}
+		if domain.PriceChangeRequestStatus(status) != domain.PriceChangeRequestPending {
+			return domain.ErrPriceChangeRequestNotPending
+		}
+		return nil
+	}
+}
+
+// nullableString maps an empty string to NULL.
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
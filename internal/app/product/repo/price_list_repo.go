@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"cloud.google.com/go/spanner"
+
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/models/m_price_list"
+)
+
+// PriceListRepo is the Spanner implementation of the price list repository.
+// It returns *spanner.Mutation objects but never applies them.
+type PriceListRepo struct{}
+
+func NewPriceListRepo() *PriceListRepo {
+	return &PriceListRepo{}
+}
+
+// InsertMut builds an Insert mutation for a new price list.
+func (r *PriceListRepo) InsertMut(pl *domain.PriceList) *spanner.Mutation {
+	var description *string
+	if d := pl.Description(); d != "" {
+		description = &d
+	}
+	values := m_price_list.BuildInsertMap(pl.ID(), pl.Segment(), pl.Name(), description,
+		pl.CreatedAt().UTC(), pl.UpdatedAt().UTC())
+	return m_price_list.InsertMutation(values)
+}
+
+// UpdateMut builds an Update mutation using the aggregate's ChangeTracker.
+func (r *PriceListRepo) UpdateMut(pl *domain.PriceList) *spanner.Mutation {
+	if pl == nil || pl.Changes() == nil || !pl.Changes().HasChanges() {
+		return nil
+	}
+
+	updates := map[string]interface{}{}
+
+	if pl.Changes().Dirty(domain.FieldPriceListName) {
+		updates[m_price_list.ColName] = pl.Name()
+	}
+	if pl.Changes().Dirty(domain.FieldPriceListDescription) {
+		if pl.Description() == "" {
+			updates[m_price_list.ColDescription] = nil
+		} else {
+			updates[m_price_list.ColDescription] = pl.Description()
+		}
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	updates[m_price_list.ColUpdatedAt] = pl.UpdatedAt().UTC()
+	return m_price_list.UpdateMutation(pl.ID(), updates)
+}
+
+// DeleteMut builds a Delete mutation for the price list; entries cascade.
+func (r *PriceListRepo) DeleteMut(pl *domain.PriceList) *spanner.Mutation {
+	if pl == nil {
+		return nil
+	}
+	return m_price_list.DeleteMutation(pl.ID())
+}
+
+// UpsertEntryMut builds an InsertOrUpdate mutation for a price list entry.
+func (r *PriceListRepo) UpsertEntryMut(pl *domain.PriceList, entry *domain.PriceListEntry) *spanner.Mutation {
+	if pl == nil || entry == nil {
+		return nil
+	}
+
+	var overridePrice, overrideCurrency *string
+	if o := entry.Override(); o != nil {
+		price, cur := numericPrice(o), o.Currency().String()
+		overridePrice, overrideCurrency = &price, &cur
+	}
+
+	var adjustment *string
+	if a := entry.Adjustment(); a != nil {
+		// NUMERIC supports 9 fractional digits.
+		s := a.FloatString(9)
+		adjustment = &s
+	}
+
+	return m_price_list.EntryUpsertMutation(pl.ID(), entry.ProductID(), overridePrice, overrideCurrency, adjustment, pl.UpdatedAt().UTC())
+}
+
+// DeleteEntryMut builds a Delete mutation for a single price list entry.
+func (r *PriceListRepo) DeleteEntryMut(pl *domain.PriceList, productID string) *spanner.Mutation {
+	if pl == nil {
+		return nil
+	}
+	return m_price_list.EntryDeleteMutation(pl.ID(), productID)
+}
@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"fmt"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -28,11 +29,25 @@ func buildInsertValues(p *domain.Product) map[string]interface{} {
 		description = &desc
 	}
 	category := p.Category()
+	var sku, gtin *string
+	if s := p.SKU(); s != "" {
+		sku = &s
+	}
+	if g := p.GTIN(); g != "" {
+		gtin = &g
+	}
 
-	// base price assumed returned as numerator/denominator
-	base := p.BasePrice() // *domain.Money
-	baseNum := base.Numerator()
-	baseDen := base.Denominator()
+	basePrice := numericPrice(p.BasePrice())
+	var costPrice *string
+	if c := p.CostPrice(); c != nil {
+		cost := numericPrice(c)
+		costPrice = &cost
+	}
+	var packageQuantity, unitOfMeasure *string
+	if s := p.PackageSize(); s != nil {
+		q, u := s.QuantityString(), s.Unit().String()
+		packageQuantity, unitOfMeasure = &q, &u
+	}
 
 	var discountPct *string
 	var discountStart *time.Time
@@ -55,8 +70,8 @@ func buildInsertValues(p *domain.Product) map[string]interface{} {
 
 	status := string(p.Status())
 
-	values := m_product.BuildInsertMap(productID, name, description, category, baseNum, baseDen,
-		discountPct, discountStart, discountEnd, status, p.CreatedAt().UTC(), p.UpdatedAt().UTC())
+	values := m_product.BuildInsertMap(productID, name, description, p.DefaultLocale().String(), p.CategoryID(), category, p.TaxCategory().String(), p.Slug(), sku, gtin, basePrice,
+		p.Currency().String(), costPrice, packageQuantity, unitOfMeasure, discountPct, discountStart, discountEnd, string(p.Type()), status, p.CreatedAt().UTC(), p.UpdatedAt().UTC())
 
 	return values
 }
@@ -87,11 +102,51 @@ func (r *ProductRepo) UpdateMut(p *domain.Product) *spanner.Mutation {
 		}
 	}
 	if p.Changes().Dirty(domain.FieldCategory) {
+		updates[m_product.ColCategoryID] = p.CategoryID()
 		updates[m_product.ColCategory] = p.Category()
 	}
+	if p.Changes().Dirty(domain.FieldTaxCategory) {
+		updates[m_product.ColTaxCategory] = p.TaxCategory().String()
+	}
+	if p.Changes().Dirty(domain.FieldSKU) {
+		if p.SKU() == "" {
+			updates[m_product.ColSKU] = nil
+		} else {
+			updates[m_product.ColSKU] = p.SKU()
+		}
+	}
+	if p.Changes().Dirty(domain.FieldGTIN) {
+		if p.GTIN() == "" {
+			updates[m_product.ColGTIN] = nil
+		} else {
+			updates[m_product.ColGTIN] = p.GTIN()
+		}
+	}
+	if p.Changes().Dirty(domain.FieldSlug) {
+		updates[m_product.ColSlug] = p.Slug()
+	}
+	if p.Changes().Dirty(domain.FieldDefaultLocale) {
+		updates[m_product.ColDefaultLocale] = p.DefaultLocale().String()
+	}
 	if p.Changes().Dirty(domain.FieldBasePrice) {
-		updates[m_product.ColBasePriceNumerator] = p.BasePrice().Numerator()
-		updates[m_product.ColBasePriceDenominator] = p.BasePrice().Denominator()
+		updates[m_product.ColBasePrice] = numericPrice(p.BasePrice())
+		updates[m_product.ColCurrency] = p.Currency().String()
+	}
+	if p.Changes().Dirty(domain.FieldCostPrice) {
+		if c := p.CostPrice(); c != nil {
+			updates[m_product.ColCostPrice] = numericPrice(c)
+		} else {
+			updates[m_product.ColCostPrice] = nil
+		}
+	}
+	if p.Changes().Dirty(domain.FieldPackageSize) {
+		if s := p.PackageSize(); s != nil {
+			updates[m_product.ColPackageQuantity] = s.QuantityString()
+			updates[m_product.ColUnitOfMeasure] = s.Unit().String()
+		} else {
+			updates[m_product.ColPackageQuantity] = nil
+			updates[m_product.ColUnitOfMeasure] = nil
+		}
 	}
 	if p.Changes().Dirty(domain.FieldDiscount) {
 		if d := p.Discount(); d != nil {
@@ -106,6 +161,27 @@ func (r *ProductRepo) UpdateMut(p *domain.Product) *spanner.Mutation {
 			updates[m_product.ColDiscountEndDate] = nil
 		}
 	}
+	if p.Changes().Dirty(domain.FieldBundle) {
+		updates[m_product.ColProductType] = string(p.Type())
+		if p.IsBundle() {
+			updates[m_product.ColBundlePricing] = string(p.BundlePricing())
+		} else {
+			updates[m_product.ColBundlePricing] = nil
+		}
+		if d := p.BundleDiscount(); d != nil {
+			updates[m_product.ColBundleDiscount] = d.FloatString(domain.NumericScale)
+		} else {
+			updates[m_product.ColBundleDiscount] = nil
+		}
+	}
+	if p.Changes().Dirty(domain.FieldRegions) {
+		updates[m_product.ColAllowedRegions] = regionCodes(p.AllowedRegions())
+		updates[m_product.ColBlockedRegions] = regionCodes(p.BlockedRegions())
+	}
+	if p.Changes().Dirty(domain.FieldAvailability) {
+		updates[m_product.ColAvailableFrom] = utcOrNil(p.AvailableFrom())
+		updates[m_product.ColAvailableUntil] = utcOrNil(p.AvailableUntil())
+	}
 	if p.Changes().Dirty(domain.FieldStatus) {
 		updates[m_product.ColStatus] = string(p.Status())
 	}
@@ -117,12 +193,279 @@ func (r *ProductRepo) UpdateMut(p *domain.Product) *spanner.Mutation {
 		}
 	}
 
-	if len(updates) == 0 {
+	// Per-currency price changes live in product_prices but still stamp updated_at here.
+	updates[m_product.ColUpdatedAt] = p.UpdatedAt().UTC()
+	return m_product.UpdateMutation(p.ID(), updates)
+}
+
+// CurrencyPriceMuts returns one mutation per dirty per-currency price:
+// an upsert for prices that were set and a delete for prices that were removed.
+func (r *ProductRepo) CurrencyPriceMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
 		return nil
 	}
 
-	updates[m_product.ColUpdatedAt] = p.UpdatedAt().UTC()
-	return m_product.UpdateMutation(p.ID(), updates)
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		currency, ok := domain.CurrencyFromPriceField(field)
+		if !ok {
+			continue
+		}
+		if price, ok := p.PriceIn(currency); ok {
+			muts = append(muts, m_product.PriceUpsertMutation(p.ID(), currency.String(),
+				numericPrice(price), p.UpdatedAt().UTC()))
+		} else {
+			muts = append(muts, m_product.PriceDeleteMutation(p.ID(), currency.String()))
+		}
+	}
+	return muts
+}
+
+// ScheduledPriceMuts returns one upsert per dirty scheduled price change, covering
+// newly scheduled changes as well as status transitions (applied, cancelled).
+func (r *ProductRepo) ScheduledPriceMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		id, ok := domain.ScheduleIDFromField(field)
+		if !ok {
+			continue
+		}
+		c, ok := p.ScheduledPriceChange(id)
+		if !ok {
+			continue
+		}
+		muts = append(muts, m_product.ScheduledPriceUpsertMutation(p.ID(), c.ID(), numericPrice(c.Price()),
+			c.Price().Currency().String(), c.EffectiveAt().UTC(), string(c.Status()), c.MarginOverride(), c.CreatedAt().UTC(), c.UpdatedAt().UTC()))
+	}
+	return muts
+}
+
+// VariantMuts returns one mutation per dirty variant: an upsert for variants that
+// were added or changed and a delete for variants that were removed.
+func (r *ProductRepo) VariantMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		id, ok := domain.VariantIDFromField(field)
+		if !ok {
+			continue
+		}
+		v, ok := p.Variant(id)
+		if !ok {
+			muts = append(muts, m_product.VariantDeleteMutation(p.ID(), id))
+			continue
+		}
+		var price *string
+		if v.Price() != nil {
+			amount := numericPrice(v.Price())
+			price = &amount
+		}
+		muts = append(muts, m_product.VariantUpsertMutation(p.ID(), v.ID(), v.SKU(), v.Options(), price,
+			string(v.Status()), v.CreatedAt().UTC(), v.UpdatedAt().UTC()))
+	}
+	return muts
+}
+
+// AttributeMuts returns one mutation per dirty attribute: an upsert for values that
+// were set or changed and a delete for values that were dropped.
+func (r *ProductRepo) AttributeMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		name, ok := domain.AttributeNameFromField(field)
+		if !ok {
+			continue
+		}
+		v, ok := p.Attribute(name)
+		if !ok {
+			muts = append(muts, m_product.AttributeDeleteMutation(p.ID(), name))
+			continue
+		}
+		// Number values are already canonical NUMERIC decimals.
+		var number *string
+		if v.Number() != nil {
+			amount := v.String()
+			number = &amount
+		}
+		muts = append(muts, m_product.AttributeUpsertMutation(p.ID(), name, v.String(), number, p.UpdatedAt().UTC()))
+	}
+	return muts
+}
+
+// TagMuts returns one mutation per dirty tag: an insert for tags that were added
+// and a delete for tags that were removed.
+func (r *ProductRepo) TagMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		tag, ok := domain.TagFromField(field)
+		if !ok {
+			continue
+		}
+		if p.HasTag(tag) {
+			muts = append(muts, m_product.TagInsertMutation(p.ID(), tag, p.UpdatedAt().UTC()))
+		} else {
+			muts = append(muts, m_product.TagDeleteMutation(p.ID(), tag))
+		}
+	}
+	return muts
+}
+
+// MediaMuts returns one mutation per dirty media entry: an upsert for entries that
+// were added, moved or changed and a delete for entries that were removed.
+func (r *ProductRepo) MediaMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		id, ok := domain.MediaIDFromField(field)
+		if !ok {
+			continue
+		}
+		m, ok := p.MediaByID(id)
+		if !ok {
+			muts = append(muts, m_product.MediaDeleteMutation(p.ID(), id))
+			continue
+		}
+		altTexts := make(map[string]string, len(m.AltTexts()))
+		for l, t := range m.AltTexts() {
+			altTexts[l.String()] = t
+		}
+		muts = append(muts, m_product.MediaUpsertMutation(p.ID(), id, m.URL(), string(m.Kind()), altTexts,
+			int64(m.SortOrder()), m.IsPrimary(), m.CreatedAt().UTC(), m.UpdatedAt().UTC()))
+	}
+	return muts
+}
+
+// RelationMuts returns one mutation per dirty relation: an insert for links that
+// were added and a delete for links that were removed.
+func (r *ProductRepo) RelationMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		relType, relatedID, ok := domain.RelationFromField(field)
+		if !ok {
+			continue
+		}
+		rel, ok := p.Relation(relType, relatedID)
+		if !ok {
+			muts = append(muts, m_product.RelationDeleteMutation(p.ID(), string(relType), relatedID))
+			continue
+		}
+		muts = append(muts, m_product.RelationInsertMutation(p.ID(), string(relType), relatedID, rel.CreatedAt().UTC()))
+	}
+	return muts
+}
+
+// ChannelMuts returns an upsert per dirty sales channel assignment. Assignments are
+// deactivated rather than removed, so there are no deletes.
+func (r *ProductRepo) ChannelMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		channel, ok := domain.ChannelFromField(field)
+		if !ok {
+			continue
+		}
+		c, ok := p.Channel(channel)
+		if !ok {
+			continue
+		}
+		var price *string
+		if c.Price() != nil {
+			amount := numericPrice(c.Price())
+			price = &amount
+		}
+		muts = append(muts, m_product.ChannelUpsertMutation(p.ID(), channel, c.Active(), price, c.UpdatedAt().UTC()))
+	}
+	return muts
+}
+
+// BundleComponentMuts returns one mutation per dirty bundle component: an upsert for
+// components that were added or changed quantity and a delete for removed ones.
+func (r *ProductRepo) BundleComponentMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		componentID, ok := domain.BundleComponentIDFromField(field)
+		if !ok {
+			continue
+		}
+		c, ok := p.BundleComponent(componentID)
+		if !ok {
+			muts = append(muts, m_product.BundleComponentDeleteMutation(p.ID(), componentID))
+			continue
+		}
+		muts = append(muts, m_product.BundleComponentUpsertMutation(p.ID(), componentID, c.Quantity(), p.UpdatedAt().UTC()))
+	}
+	return muts
+}
+
+// TranslationMuts returns one mutation per dirty translation: an upsert for
+// translations that were set and a delete for translations that were removed.
+func (r *ProductRepo) TranslationMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().HasChanges() {
+		return nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, field := range p.Changes().DirtyFields() {
+		locale, ok := domain.LocaleFromTranslationField(field)
+		if !ok {
+			continue
+		}
+		t, ok := p.Translation(locale)
+		if !ok {
+			muts = append(muts, m_product.TranslationDeleteMutation(p.ID(), locale.String()))
+			continue
+		}
+		var description *string
+		if d := t.Description(); d != "" {
+			description = &d
+		}
+		muts = append(muts, m_product.TranslationInsertMutation(p.ID(), locale.String(), t.Name(), description, p.UpdatedAt().UTC()))
+	}
+	return muts
+}
+
+// SlugHistoryMuts records the product's previous slug in the slug history when its
+// slug changed, and removes the new slug from the history in case the product had
+// it before. New products have no history.
+func (r *ProductRepo) SlugHistoryMuts(p *domain.Product) []*spanner.Mutation {
+	if p == nil || p.Changes() == nil || !p.Changes().Dirty(domain.FieldSlug) {
+		return nil
+	}
+	if p.PreviousSlug() == "" || p.PreviousSlug() == p.Slug() {
+		return nil
+	}
+	return []*spanner.Mutation{
+		m_product.SlugHistoryInsertMutation(p.PreviousSlug(), p.ID(), p.UpdatedAt().UTC()),
+		m_product.SlugHistoryDeleteMutation(p.Slug()),
+	}
 }
 
 // ArchiveMut returns a mutation to soft-delete the product (archive).
@@ -130,3 +473,31 @@ func (r *ProductRepo) UpdateMut(p *domain.Product) *spanner.Mutation {
 func (r *ProductRepo) ArchiveMut(p *domain.Product) *spanner.Mutation {
 	return r.UpdateMut(p)
 }
+
+// numericPrice renders a price for a NUMERIC column. The aggregates only accept
+// prices that Money.Decimal can represent exactly, so any other price is a broken
+// invariant: it panics here rather than being rounded or failing the commit later.
+func numericPrice(m *domain.Money) string {
+	s, err := m.Decimal()
+	if err != nil {
+		panic(fmt.Sprintf("repo: price %s has no exact NUMERIC representation", m.Rat().RatString()))
+	}
+	return s
+}
+
+// regionCodes returns region codes for an ARRAY<STRING> column.
+func regionCodes(regions []domain.Region) []string {
+	codes := make([]string, 0, len(regions))
+	for _, r := range regions {
+		codes = append(codes, r.String())
+	}
+	return codes
+}
+
+// utcOrNil returns an optional time for a nullable TIMESTAMP column.
+func utcOrNil(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
@@ -18,22 +18,34 @@ func TestInsertMut_NoDiscount(t *testing.T) {
 	now := time.Now().UTC()
 	base := domain.NewMoney(1999, 100) // $19.99
 
-	// NewProduct signature: NewProduct(id, name, description, category string, basePrice *Money, now time.Time)
-	p, err := domain.NewProduct("prod-no-discount", "Test Product", "a description", "electronics", base, now)
+	// NewProduct signature: NewProduct(id, name, description string, category *Category, basePrice *Money, now time.Time)
+	category, err := domain.NewCategory("cat-1", "Electronics", "", nil, now)
+	require.NoError(t, err)
+	p, err := domain.NewProduct("prod-no-discount", "Test Product", "a description", category, base, now)
 	require.NoError(t, err)
 
 	// Inspect values map (test-friendly)
 	values := buildInsertValues(p)
 	require.NotNil(t, values)
 
-	// base price fields should be present and equal to Money Numerator/Denominator
-	numVal, ok := values[m_product.ColBasePriceNumerator]
-	require.True(t, ok, "base price numerator missing")
-	denVal, ok := values[m_product.ColBasePriceDenominator]
-	require.True(t, ok, "base price denominator missing")
+	// base price is stored as an exact NUMERIC decimal
+	priceVal, ok := values[m_product.ColBasePrice]
+	require.True(t, ok, "base price missing")
+	assert.Equal(t, "19.99", priceVal)
+	assert.Equal(t, "standard", values[m_product.ColTaxCategory])
+	assert.Equal(t, "cat-1", values[m_product.ColCategoryID])
+	assert.Equal(t, "electronics", values[m_product.ColCategory])
+	assert.Equal(t, "test-product", values[m_product.ColSlug])
+	assert.Equal(t, "en", values[m_product.ColDefaultLocale])
+	assert.Equal(t, "simple", values[m_product.ColProductType])
+	assert.Nil(t, values[m_product.ColSKU])
+	assert.Nil(t, values[m_product.ColGTIN])
 
-	assert.Equal(t, base.Numerator(), numVal)
-	assert.Equal(t, base.Denominator(), denVal)
+	require.NoError(t, p.SetSKU("TP-1", now))
+	require.NoError(t, p.SetGTIN("4006381333931", now))
+	values = buildInsertValues(p)
+	assert.Equal(t, "TP-1", values[m_product.ColSKU])
+	assert.Equal(t, "04006381333931", values[m_product.ColGTIN])
 
 	// Discount columns should be present in map and be nil (no discount)
 	if v, ok := values[m_product.ColDiscountPercent]; ok {
@@ -92,3 +104,227 @@ func TestInsertMut_WithDiscount(t *testing.T) {
 	mut := r.InsertMut(p)
 	require.NotNil(t, mut)
 }
+
+// TestCurrencyPriceMuts verifies one mutation per changed per-currency price.
+func TestCurrencyPriceMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	base := domain.NewMoneyIn("EUR", 2500, 100) // €25.00
+	usd := domain.NewMoneyIn("USD", 2799, 100)
+	gbp := domain.NewMoneyIn("GBP", 2199, 100)
+
+	p := domain.ReconstructProduct("prod-currencies", "Multi", "desc", "gadgets", base, nil,
+		domain.ProductStatusActive, now, now, nil, domain.WithCurrencyPrices(gbp))
+
+	values := buildInsertValues(p)
+	assert.Equal(t, "EUR", values[m_product.ColCurrency])
+
+	// No changes yet: nothing to write.
+	assert.Empty(t, r.CurrencyPriceMuts(p))
+
+	require.NoError(t, p.SetCurrencyPrice(usd, now))
+	require.NoError(t, p.RemoveCurrencyPrice("GBP", now))
+	assert.Len(t, r.CurrencyPriceMuts(p), 2)
+
+	// The product row is still stamped even though only per-currency prices changed.
+	require.NotNil(t, r.UpdateMut(p))
+
+	// Prices in the primary currency go through UpdatePrice and are not per-currency rows.
+	assert.ErrorIs(t, p.RemoveCurrencyPrice("EUR", now), domain.ErrCannotRemovePrimaryPrice)
+}
+
+func TestScheduledPriceMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	base := domain.NewMoneyIn("EUR", 2500, 100)
+	pending := domain.ReconstructScheduledPriceChange("sched-1", domain.NewMoneyIn("EUR", 2700, 100),
+		now.Add(time.Hour), domain.ScheduledPricePending, false, now, now)
+
+	p := domain.ReconstructProduct("prod-scheduled", "Multi", "desc", "gadgets", base, nil,
+		domain.ProductStatusActive, now, now, nil, domain.WithScheduledPriceChanges(pending))
+
+	// Loaded changes are not rewritten.
+	assert.Empty(t, r.ScheduledPriceMuts(p))
+
+	_, err := p.SchedulePriceChange("sched-2", domain.NewMoneyIn("EUR", 2900, 100), now.Add(2*time.Hour), now)
+	require.NoError(t, err)
+	require.NoError(t, p.CancelScheduledPriceChange("sched-1", now))
+	assert.Len(t, r.ScheduledPriceMuts(p), 2)
+
+	// Scheduling alone does not change the stored base price.
+	assert.False(t, p.Changes().Dirty(domain.FieldBasePrice))
+	require.NotNil(t, r.UpdateMut(p))
+}
+
+func TestVariantMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	base := domain.NewMoneyIn("EUR", 2500, 100)
+	loaded := domain.ReconstructVariant("var-1", "TEE-S", domain.VariantOptions{"size": "S"}, nil,
+		domain.VariantStatusActive, now, now)
+
+	p := domain.ReconstructProduct("prod-variants", "Tee", "desc", "apparel", base, nil,
+		domain.ProductStatusActive, now, now, nil, domain.WithVariants(loaded))
+
+	// Loaded variants are not rewritten.
+	assert.Empty(t, r.VariantMuts(p))
+
+	_, err := p.AddVariant("var-2", "TEE-M", map[string]string{"size": "M"}, domain.NewMoneyIn("EUR", 2700, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, p.RemoveVariant("var-1", now))
+
+	muts := r.VariantMuts(p)
+	assert.Len(t, muts, 2)
+}
+
+func TestAttributeMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	schema := domain.ReconstructAttributeSchema("laptops",
+		domain.ReconstructAttributeDefinition("screen_size", domain.AttributeTypeNumber, false, nil, "in", now, now),
+		domain.ReconstructAttributeDefinition("panel", domain.AttributeTypeEnum, false, []string{"IPS", "OLED"}, "", now, now))
+	p := domain.ReconstructProduct("prod-attrs", "Ultrabook", "desc", "laptops", domain.NewMoney(999, 1), nil,
+		domain.ProductStatusActive, now, now, nil,
+		domain.WithAttributes(map[string]domain.AttributeValue{"panel": domain.ReconstructAttributeValue("IPS", nil)}))
+
+	// Loaded attributes are not rewritten.
+	assert.Empty(t, r.AttributeMuts(p))
+
+	require.NoError(t, p.SetAttributes(map[string]string{"screen_size": "13.3"}, schema, now))
+	muts := r.AttributeMuts(p)
+	assert.Len(t, muts, 2) // upsert screen_size, delete panel
+}
+
+func TestTagMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	p := domain.ReconstructProduct("prod-tags", "Ultrabook", "desc", "laptops", domain.NewMoney(999, 1), nil,
+		domain.ProductStatusActive, now, now, nil, domain.WithTags("eco", "new"))
+
+	// Loaded tags are not rewritten.
+	assert.Empty(t, r.TagMuts(p))
+
+	require.NoError(t, p.AddTags([]string{"holiday-2026"}, now))
+	require.NoError(t, p.RemoveTags([]string{"new"}, now))
+	muts := r.TagMuts(p)
+	assert.Len(t, muts, 2) // insert holiday-2026, delete new
+}
+
+func TestSlugHistoryMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	p := domain.ReconstructProduct("prod-slug", "Ultrabook", "desc", "laptops", domain.NewMoney(999, 1), nil,
+		domain.ProductStatusActive, now, now, nil, domain.WithSlug("ultrabook"))
+
+	require.NoError(t, p.UpdateDetails("Ultrabook", "new description", nil, now))
+	assert.Empty(t, r.SlugHistoryMuts(p))
+
+	require.NoError(t, p.UpdateDetails("Ultrabook Pro", "", nil, now))
+	assert.Equal(t, "ultrabook-pro", p.Slug())
+	require.NotNil(t, r.UpdateMut(p))
+	assert.Len(t, r.SlugHistoryMuts(p), 2) // retire ultrabook, drop ultrabook-pro from the history
+}
+
+func TestTranslationMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	fr, err := domain.NewTranslation("Ultraportable", "")
+	require.NoError(t, err)
+	p := domain.ReconstructProduct("prod-i18n", "Ultrabook", "desc", "laptops", domain.NewMoney(999, 1), nil,
+		domain.ProductStatusActive, now, now, nil, domain.WithLocalization("en", map[domain.Locale]domain.Translation{"fr": fr}))
+
+	// Loaded translations are not rewritten.
+	assert.Empty(t, r.TranslationMuts(p))
+
+	require.NoError(t, p.SetTranslation("de", "Ultrabook", "Leicht", now))
+	require.NoError(t, p.RemoveTranslation("fr", now))
+	muts := r.TranslationMuts(p)
+	assert.Len(t, muts, 2) // upsert de, delete fr
+}
+
+func TestMediaMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	front := domain.ReconstructMedia("media-1", "https://cdn.example.com/front.jpg", domain.MediaKindImage,
+		map[domain.Locale]string{"en": "Front"}, 0, true, now, now)
+	p := domain.ReconstructProduct("prod-media", "Ultrabook", "desc", "laptops", domain.NewMoney(999, 1), nil,
+		domain.ProductStatusActive, now, now, nil, domain.WithMedia(front))
+
+	// Loaded media are not rewritten.
+	assert.Empty(t, r.MediaMuts(p))
+
+	_, err := p.AddMedia("media-2", "https://cdn.example.com/side.jpg", domain.MediaKindImage, nil, true, now)
+	require.NoError(t, err)
+	assert.Len(t, r.MediaMuts(p), 2) // insert media-2, media-1 no longer primary
+
+	require.NoError(t, p.RemoveMedia("media-1", now))
+	assert.Len(t, r.MediaMuts(p), 2) // upsert media-2, delete media-1
+}
+
+func TestRelationMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	p := domain.ReconstructProduct("prod-lens", "Lens", "desc", "cameras", domain.NewMoney(299, 1), nil,
+		domain.ProductStatusActive, now, now, nil,
+		domain.WithRelations(domain.ReconstructProductRelation(domain.RelationAccessoryOf, "prod-camera", now)))
+
+	// Loaded relations are not rewritten.
+	assert.Empty(t, r.RelationMuts(p))
+
+	require.NoError(t, p.AddRelation(domain.RelationFrequentlyBoughtWith, "prod-bag", domain.ProductStatusActive, now))
+	require.NoError(t, p.RemoveRelation(domain.RelationAccessoryOf, "prod-camera", now))
+	assert.Len(t, r.RelationMuts(p), 2) // insert prod-bag, delete prod-camera
+}
+
+func TestBundleComponentMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	p := domain.ReconstructProduct("prod-gift", "Gift set", "desc", "gifts", domain.NewMoney(30, 1), nil,
+		domain.ProductStatusActive, now, now, nil,
+		domain.WithBundle(domain.BundlePricingFixed, nil, domain.ReconstructBundleComponent("prod-soap", 2)))
+
+	// Loaded components are not rewritten.
+	assert.Empty(t, r.BundleComponentMuts(p))
+
+	towel, err := domain.NewBundleComponent("prod-towel", 1)
+	require.NoError(t, err)
+	require.NoError(t, p.SetBundle(domain.BundlePricingFixed, nil, []*domain.BundleComponent{towel},
+		map[string]domain.ComponentProduct{"prod-towel": {ID: "prod-towel", Type: domain.ProductTypeSimple,
+			Status: domain.ProductStatusActive, BasePrice: domain.NewMoney(20, 1)}}, now))
+	assert.Len(t, r.BundleComponentMuts(p), 2) // upsert prod-towel, delete prod-soap
+}
+
+func TestChannelMuts(t *testing.T) {
+	r := NewProductRepo()
+
+	now := time.Now().UTC()
+	p := domain.ReconstructProduct("prod-lamp", "Lamp", "desc", "lighting", domain.NewMoney(40, 1), nil,
+		domain.ProductStatusActive, now, now, nil,
+		domain.WithChannels(domain.ReconstructChannelAssignment(domain.ChannelWeb, true, nil, now)))
+
+	// Loaded assignments are not rewritten.
+	assert.Empty(t, r.ChannelMuts(p))
+
+	require.NoError(t, p.DeactivateOnChannel(domain.ChannelWeb, now))
+	require.NoError(t, p.SetChannelPrice("marketplace-x", domain.NewMoney(45, 1), now))
+	assert.Len(t, r.ChannelMuts(p), 2) // upsert web and marketplace-x
+}
+
+func TestNumericPrice(t *testing.T) {
+	assert.Equal(t, "12.5", numericPrice(domain.NewMoney(25, 2)))
+
+	// A price the aggregates should have rejected is a broken invariant.
+	assert.PanicsWithValue(t, "repo: price 1/3 has no exact NUMERIC representation", func() {
+		numericPrice(domain.NewMoney(1, 3))
+	})
+}
@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/models/m_stock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// StockRepo is the Spanner implementation of the stock repository.
+// It returns *spanner.Mutation objects but never applies them.
+type StockRepo struct{}
+
+func NewStockRepo() *StockRepo {
+	return &StockRepo{}
+}
+
+// SaveMut builds an InsertOrUpdate mutation writing the whole stock level with its
+// version bumped. Pair it with VersionCheck.
+func (r *StockRepo) SaveMut(s *domain.StockLevel) *spanner.Mutation {
+	if s == nil {
+		return nil
+	}
+	return m_stock.UpsertMutation(s.ProductID(), s.LocationID(), s.OnHand(), s.Reserved(), s.LowStockThreshold(),
+		s.Version()+1, s.UpdatedAt().UTC())
+}
+
+// VersionCheck builds a check that re-reads the stock level's version inside the
+// commit's transaction and fails with domain.ErrStockConflict when another writer
+// saved the level since it was loaded. A missing row counts as version zero.
+func (r *StockRepo) VersionCheck(s *domain.StockLevel) commitplan.Check {
+	if s == nil {
+		return nil
+	}
+	productID, locationID, version := s.ProductID(), s.LocationID(), s.Version()
+	return func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		var current int64
+		row, err := tx.ReadRow(ctx, m_stock.TableName, m_stock.Key(productID, locationID), []string{m_stock.ColVersion})
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+		case err != nil:
+			return err
+		default:
+			if err := row.Column(0, &current); err != nil {
+				return err
+			}
+		}
+		if current != version {
+			return domain.ErrStockConflict
+		}
+		return nil
+	}
+}
+
+// ReservationInsertMut builds an Insert mutation for a new reservation.
+func (r *StockRepo) ReservationInsertMut(res *domain.StockReservation) *spanner.Mutation {
+	if res == nil {
+		return nil
+	}
+	return m_stock.ReservationInsertMutation(res.ID(), res.ProductID(), res.LocationID(), res.Quantity(), res.CreatedAt().UTC())
+}
+
+// ReservationDeleteMut builds a Delete mutation for a released or fulfilled reservation.
+func (r *StockRepo) ReservationDeleteMut(res *domain.StockReservation) *spanner.Mutation {
+	if res == nil {
+		return nil
+	}
+	return m_stock.ReservationDeleteMutation(res.ID())
+}
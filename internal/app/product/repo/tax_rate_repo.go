@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	domain "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/models/m_tax_rate"
+)
+
+// TaxRateRepo is the Spanner implementation of the tax rate repository.
+// It returns *spanner.Mutation objects but never applies them.
+type TaxRateRepo struct{}
+
+func NewTaxRateRepo() *TaxRateRepo {
+	return &TaxRateRepo{}
+}
+
+// UpsertMut builds an InsertOrUpdate mutation for the rate.
+func (r *TaxRateRepo) UpsertMut(rate *domain.TaxRate, now time.Time) *spanner.Mutation {
+	if rate == nil {
+		return nil
+	}
+	return m_tax_rate.UpsertMutation(rate.Region().String(), rate.Category().String(),
+		rate.EffectiveAt().UTC(), rate.RateString(), now.UTC())
+}
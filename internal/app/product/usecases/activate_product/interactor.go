@@ -40,7 +40,7 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	now := it.Clock.Now()
 
 	// 1. Load aggregate via ReadModel and reconstruct
-	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID)
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
 	if err != nil {
 		return err
 	}
@@ -49,7 +49,15 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	updatedAtPtr := utils.ParseTimePtr(dto.UpdatedAt)
 	archivedAtPtr := utils.ParseTimePtr(dto.ArchivedAt)
 
-	base := domain.NewMoney(dto.BasePriceNum, dto.BasePriceDen)
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(dto.Currency), dto.BasePrice)
+	if err != nil {
+		return err
+	}
+	// Allowed regions must have a price in their currency.
+	prices, err := shared.CurrencyPricesFromDTO(dto)
+	if err != nil {
+		return err
+	}
 	product := domain.ReconstructProduct(
 		dto.ProductID,
 		dto.Name,
@@ -61,6 +69,9 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 		utils.TimeOrZero(createdAtPtr),
 		utils.TimeOrZero(updatedAtPtr),
 		archivedAtPtr,
+		shared.AvailabilityFromDTO(dto),
+		domain.WithCurrencyPrices(prices...),
+		shared.RegionsFromDTO(dto),
 	)
 
 	// 2. Domain call
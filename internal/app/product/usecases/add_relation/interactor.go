@@ -0,0 +1,113 @@
+package add_relation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request links a product to a related product.
+type Request struct {
+	ProductID        string
+	RelatedProductID string
+	Type             string // "frequently_bought_with", "accessory_of" or "replacement_for"
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		Clock:       clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The related product must exist; the aggregate checks it is not archived.
+	related, err := it.ReadModel.GetProduct(ctx, req.RelatedProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return err
+	}
+
+	description := ""
+	if dto.Description != nil {
+		description = *dto.Description
+	}
+
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(dto.Currency), dto.BasePrice)
+	if err != nil {
+		return err
+	}
+
+	product := domain.ReconstructProduct(
+		dto.ProductID,
+		dto.Name,
+		description,
+		dto.Category,
+		base,
+		nil,
+		domain.ProductStatus(dto.Status),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.UpdatedAt)),
+		utils.ParseTimePtr(dto.ArchivedAt),
+		domain.WithRelations(shared.RelationsFromDTO(dto)...),
+	)
+
+	// 2. Domain call
+	if err := product.AddRelation(domain.RelationType(req.Type), related.ProductID, domain.ProductStatus(related.Status), now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo mutations
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.RelationMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	for _, ev := range product.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
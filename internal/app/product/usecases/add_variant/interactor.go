@@ -0,0 +1,197 @@
+package add_variant
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request adds a variant to a product.
+type Request struct {
+	ProductID string
+	SKU       string
+	Options   map[string]string // option name -> value, e.g. "size" -> "M"
+
+	// Optional own base price in the product's primary currency; both parts must be set.
+	// Without it the variant sells at the product's base price.
+	PriceNum *int64
+	PriceDen *int64
+	Currency string // ISO 4217 code of the price; empty means the product's primary currency
+	// OverrideMargin lets the variant's price breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+	// Actor identifies the requester of a price held back for approval.
+	Actor string
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	Requests    contracts.PriceChangeRequestRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Margins     *domain.MarginPolicy
+	Approvals   *domain.ApprovalPolicy
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, requests contracts.PriceChangeRequestRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, margins *domain.MarginPolicy, approvals *domain.ApprovalPolicy, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		Requests:    requests,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		Margins:     margins,
+		Approvals:   approvals,
+		Clock:       clk,
+	}
+}
+
+// Execute adds the variant and returns its id. An own price beyond the approval
+// policy's thresholds, measured from the product's base price the variant would
+// inherit, is held back: the variant is added at the base price and the price is
+// stored as a PriceChangeRequest, whose id is returned as pendingID.
+func (it *Interactor) Execute(ctx context.Context, req Request) (variantID, pendingID string, err error) {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return "", "", err
+	}
+
+	product, err := productFromDTO(dto)
+	if err != nil {
+		return "", "", err
+	}
+
+	// 2. Domain call
+	var price *domain.Money
+	if req.PriceNum != nil && req.PriceDen != nil {
+		currency, err := shared.PriceCurrency(req.Currency, product)
+		if err != nil {
+			return "", "", err
+		}
+		price = domain.NewMoneyIn(currency, *req.PriceNum, *req.PriceDen)
+	}
+	id := uuid.New().String()
+	current := product.BasePrice()
+	margins := shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)
+	variant, err := product.AddVariant(id, req.SKU, req.Options, price, now, margins...)
+	if err != nil {
+		return "", "", err
+	}
+
+	// A price far from the base price was only validated above; it waits for approval
+	// while a fresh copy of the product gets the variant at the base price.
+	var request *domain.PriceChangeRequest
+	if it.Approvals.PriceChangeNeedsApproval(current, price) {
+		request, err = domain.NewVariantPriceChangeRequest(uuid.New().String(), product.ID(), id, current, price,
+			req.OverrideMargin, req.Actor, req.Role, now)
+		if err != nil {
+			return "", "", err
+		}
+		if product, err = productFromDTO(dto); err != nil {
+			return "", "", err
+		}
+		if variant, err = product.AddVariant(id, req.SKU, req.Options, nil, now, margins...); err != nil {
+			return "", "", err
+		}
+		pendingID = request.ID()
+	}
+	if err := shared.CheckSKUUnused(ctx, it.ReadModel, product.ID(), variant.SKU()); err != nil {
+		return "", "", err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo mutations
+	if request != nil {
+		plan.Add(it.Requests.InsertMut(request))
+	}
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.VariantMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	var events []domain.DomainEvent
+	if request != nil {
+		events = append(events, request.DomainEvents()...)
+	}
+	events = append(events, product.DomainEvents()...)
+	for _, ev := range events {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return "", "", err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	if err := it.Committer.Apply(ctx, plan); err != nil {
+		return "", "", err
+	}
+	return id, pendingID, nil
+}
+
+// productFromDTO rebuilds the product with what adding a variant checks: existing
+// variants and the product's SKU keep SKUs and option values unique; the discount
+// and cost price feed the margin check of the variant's price.
+func productFromDTO(in *dto.ProductDTO) (*domain.Product, error) {
+	description := ""
+	if in.Description != nil {
+		description = *in.Description
+	}
+
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(in.Currency), in.BasePrice)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := shared.VariantsFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := shared.DiscountFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := shared.CostPriceFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructProduct(
+		in.ProductID,
+		in.Name,
+		description,
+		in.Category,
+		base,
+		discount,
+		domain.ProductStatus(in.Status),
+		utils.TimeOrZero(utils.ParseTimePtr(in.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(in.UpdatedAt)),
+		utils.ParseTimePtr(in.ArchivedAt),
+		domain.WithCostPrice(cost),
+		domain.WithIdentifiers(shared.IdentifiersFromDTO(in)),
+		domain.WithVariants(variants...),
+	), nil
+}
@@ -0,0 +1,98 @@
+package adjust_stock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request changes the units of a product on hand at a location by Delta (negative
+// for write-offs) and, when LowStockThreshold is set, the level's low-stock threshold.
+type Request struct {
+	ProductID         string
+	LocationID        string
+	Delta             int64
+	LowStockThreshold *int64
+}
+
+type Interactor struct {
+	StockRepo      contracts.StockRepo
+	OutboxRepo     contracts.OutboxRepo
+	Committer      contracts.Committer
+	ReadModel      contracts.ReadModel
+	StockReadModel contracts.StockReadModel
+	Clock          clock.Clock
+}
+
+func NewInteractor(repo contracts.StockRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, stockReadModel contracts.StockReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		StockRepo:      repo,
+		OutboxRepo:     outboxRepo,
+		Committer:      committer,
+		ReadModel:      readModel,
+		StockReadModel: stockReadModel,
+		Clock:          clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	// Archived products take no more stock.
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return err
+	}
+	if domain.ProductStatus(dto.Status) == domain.ProductStatusArchived {
+		return domain.ErrProductArchived
+	}
+
+	return shared.RetryStockConflicts(ctx, func(ctx context.Context) error {
+		return it.adjust(ctx, req)
+	})
+}
+
+func (it *Interactor) adjust(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	level, err := shared.LoadStockLevel(ctx, it.StockReadModel, req.ProductID, req.LocationID, now)
+	if err != nil {
+		return err
+	}
+
+	// 2. Domain call
+	if err := level.Adjust(req.Delta, req.LowStockThreshold, now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan; the version check makes the write fail if the level moved.
+	plan := commitplan.NewPlan()
+	plan.AddCheck(it.StockRepo.VersionCheck(level))
+
+	// 4. Repo mutation
+	plan.Add(it.StockRepo.SaveMut(level))
+
+	// 5. Outbox events
+	for _, ev := range level.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
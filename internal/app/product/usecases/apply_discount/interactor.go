@@ -10,7 +10,6 @@ import (
 	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
 	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
-	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
 	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
 	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
 )
@@ -18,92 +17,80 @@ import (
 // Request to apply a discount
 type Request struct {
 	ProductID  string
-	Percentage float64 // 0-100 scale as domain.NewDiscount expects
+	Percentage *big.Rat // exact percentage, 0-100 scale (e.g. 12.345 for 12.345% off)
 	StartDate  time.Time
 	EndDate    time.Time
+	// OverrideMargin lets the discounted price breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+	// Actor identifies the caller; it is recorded when the discount needs approval.
+	Actor string
 }
 
 type Interactor struct {
 	ProductRepo contracts.ProductRepo
+	Requests    contracts.PriceChangeRequestRepo
 	OutboxRepo  contracts.OutboxRepo
 	Committer   contracts.Committer
 	ReadModel   contracts.ReadModel
+	Margins     *domain.MarginPolicy
+	Approvals   *domain.ApprovalPolicy
 	Clock       clock.Clock
 }
 
-func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, clk clock.Clock) *Interactor {
+func NewInteractor(repo contracts.ProductRepo, requests contracts.PriceChangeRequestRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, margins *domain.MarginPolicy, approvals *domain.ApprovalPolicy, clk clock.Clock) *Interactor {
 	return &Interactor{
 		ProductRepo: repo,
+		Requests:    requests,
 		OutboxRepo:  outboxRepo,
 		Committer:   committer,
 		ReadModel:   readModel,
+		Margins:     margins,
+		Approvals:   approvals,
 		Clock:       clk,
 	}
 }
 
-func (it *Interactor) Execute(ctx context.Context, req Request) error {
+// Execute applies the discount. A discount deeper than the approval policy allows
+// is not applied but stored as a PriceChangeRequest, whose id is returned; the id
+// is empty when the discount was applied right away.
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
 	now := it.Clock.Now()
 
 	// 1. Load aggregate
-	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID)
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	createdAtPtr := utils.ParseTimePtr(dto.CreatedAt)
-	updatedAtPtr := utils.ParseTimePtr(dto.UpdatedAt)
-	archivedAtPtr := utils.ParseTimePtr(dto.ArchivedAt)
-
-	description := ""
-	if dto.Description != nil {
-		description = *dto.Description
+	product, err := shared.PricedProductFromDTO(dto)
+	if err != nil {
+		return "", err
 	}
 
-	base := domain.NewMoney(dto.BasePriceNum, dto.BasePriceDen)
-
-	// Reconstruct existing discount (if any) so the domain can enforce
-	// "only one active discount" properly.
-	var existingDiscount *domain.Discount
-	if dto.DiscountPct != nil && dto.DiscountStart != nil && dto.DiscountEnd != nil {
-		pct := new(big.Rat)
-		if _, ok := pct.SetString(*dto.DiscountPct); ok {
-			// If the stored value is > 1, treat it as percent (e.g. 25 => 0.25)
-			if pct.Cmp(big.NewRat(1, 1)) == 1 {
-				pct = new(big.Rat).Quo(pct, big.NewRat(100, 1))
-			}
-			start := utils.ParseTimePtr(dto.DiscountStart)
-			end := utils.ParseTimePtr(dto.DiscountEnd)
-			if start != nil && end != nil {
-				d, err := domain.NewDiscountFromRat(pct, *start, *end)
-				if err != nil {
-					return err
-				}
-				existingDiscount = d
-			}
-		}
+	// 2. Create discount domain object (the domain stores a 0-1 fraction)
+	if req.Percentage == nil {
+		return "", domain.ErrInvalidDiscountPercentage
 	}
-	product := domain.ReconstructProduct(
-		dto.ProductID,
-		dto.Name,
-		description,
-		dto.Category,
-		base,
-		existingDiscount,
-		domain.ProductStatus(dto.Status),
-		utils.TimeOrZero(createdAtPtr),
-		utils.TimeOrZero(updatedAtPtr),
-		archivedAtPtr,
-	)
-
-	// 2. Create discount domain object
-	discount, err := domain.NewDiscount(req.Percentage, req.StartDate, req.EndDate)
+	fraction := new(big.Rat).Quo(req.Percentage, big.NewRat(100, 1))
+	discount, err := domain.NewDiscountFromRat(fraction, req.StartDate, req.EndDate)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// 2b. Domain call
-	if err := product.ApplyDiscount(discount, now); err != nil {
-		return err
+	if err := product.ApplyDiscount(discount, now, shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)...); err != nil {
+		return "", err
+	}
+
+	// A deep discount was only validated above; it waits for approval.
+	if it.Approvals.DiscountNeedsApproval(discount) {
+		request, err := domain.NewDiscountChangeRequest(uuid.New().String(), product.ID(), discount,
+			req.OverrideMargin, req.Actor, req.Role, now)
+		if err != nil {
+			return "", err
+		}
+		return shared.SubmitPriceChangeRequest(ctx, it.Requests, it.OutboxRepo, it.Committer, request, now)
 	}
 
 	// 3. Build commit plan
@@ -117,7 +104,7 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 		eventID := uuid.New().String()
 		payload, err := shared.MarshalDomainEventPayload(ev)
 		if err != nil {
-			return err
+			return "", err
 		}
 		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
 			EventID:      eventID,
@@ -130,5 +117,5 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	}
 
 	// 6. Apply plan
-	return it.Committer.Apply(ctx, plan)
+	return "", it.Committer.Apply(ctx, plan)
 }
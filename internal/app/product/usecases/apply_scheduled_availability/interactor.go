@@ -0,0 +1,80 @@
+package apply_scheduled_availability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+)
+
+// DefaultBatchSize bounds how many due transitions one run performs.
+const DefaultBatchSize = 100
+
+// Transitions the scheduler performs, as listed by ListDueAvailabilityTransitions.
+const (
+	TransitionActivate   = "activate"
+	TransitionDeactivate = "deactivate"
+)
+
+// Request performs due launches and discontinuations.
+type Request struct {
+	// BatchSize is the maximum number of transitions to perform; zero means DefaultBatchSize.
+	BatchSize int
+}
+
+// Interactor is run periodically by the scheduler. It performs each due transition
+// through the regular activate and deactivate interactors, so each is committed in its
+// own transaction with the usual ProductActivatedEvent or ProductDeactivatedEvent;
+// failed transitions stay due and are retried on the next run.
+type Interactor struct {
+	Activate     *activate_product.Interactor
+	Deactivate   *deactivate_product.Interactor
+	Availability contracts.ScheduledAvailabilityReadModel
+	Clock        clock.Clock
+}
+
+func NewInteractor(activate *activate_product.Interactor, deactivate *deactivate_product.Interactor, availability contracts.ScheduledAvailabilityReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		Activate:     activate,
+		Deactivate:   deactivate,
+		Availability: availability,
+		Clock:        clk,
+	}
+}
+
+// Execute performs the due transitions and returns how many were committed. Errors of
+// individual transitions are joined into the returned error.
+func (it *Interactor) Execute(ctx context.Context, req Request) (int, error) {
+	limit := req.BatchSize
+	if limit <= 0 {
+		limit = DefaultBatchSize
+	}
+
+	due, err := it.Availability.ListDueAvailabilityTransitions(ctx, it.Clock.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	performed := 0
+	var errs []error
+	for _, d := range due {
+		switch d.Transition {
+		case TransitionActivate:
+			err = it.Activate.Execute(ctx, activate_product.Request{ProductID: d.ProductID})
+		case TransitionDeactivate:
+			err = it.Deactivate.Execute(ctx, deactivate_product.Request{ProductID: d.ProductID})
+		default:
+			err = fmt.Errorf("unknown transition %q", d.Transition)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("product %s %s: %w", d.ProductID, d.Transition, err))
+			continue
+		}
+		performed++
+	}
+	return performed, errors.Join(errs...)
+}
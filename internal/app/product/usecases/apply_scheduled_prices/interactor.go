@@ -0,0 +1,146 @@
+package apply_scheduled_prices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// DefaultBatchSize bounds how many due changes one run applies.
+const DefaultBatchSize = 100
+
+// Request applies due scheduled price changes.
+type Request struct {
+	// BatchSize is the maximum number of changes to apply; zero means DefaultBatchSize.
+	BatchSize int
+}
+
+// Interactor is run periodically by the scheduler. Each due change is committed in its
+// own transaction together with its PriceChangedEvent, so one failing product does not
+// hold back the others. Changes that can never apply are discarded; other failed
+// changes stay pending and are retried on the next run.
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Schedules   contracts.ScheduledPriceReadModel
+	Margins     *domain.MarginPolicy
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, schedules contracts.ScheduledPriceReadModel, margins *domain.MarginPolicy, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		Schedules:   schedules,
+		Margins:     margins,
+		Clock:       clk,
+	}
+}
+
+// Execute applies the due changes and returns how many were committed. Errors of
+// individual changes are joined into the returned error.
+func (it *Interactor) Execute(ctx context.Context, req Request) (int, error) {
+	now := it.Clock.Now()
+
+	limit := req.BatchSize
+	if limit <= 0 {
+		limit = DefaultBatchSize
+	}
+
+	due, err := it.Schedules.ListDueScheduledPriceChanges(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	var errs []error
+	for _, d := range due {
+		if err := it.applyOne(ctx, d.ProductID, d.ScheduleID); err != nil {
+			errs = append(errs, fmt.Errorf("product %s schedule %s: %w", d.ProductID, d.ScheduleID, err))
+			continue
+		}
+		applied++
+	}
+	return applied, errors.Join(errs...)
+}
+
+// applyOne makes one due change the product's base price. A change on an archived
+// product is discarded, so it stops showing up as due. So is a change the product no
+// longer allows, because it became a components_sum bundle or the price would breach
+// the margin floor; the reason is still returned.
+func (it *Interactor) applyOne(ctx context.Context, productID, scheduleID string) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	dto, err := it.ReadModel.GetProduct(ctx, productID, contracts.ProductReadOptions{})
+	if err != nil {
+		return err
+	}
+
+	product, err := shared.PricedProductFromDTO(dto)
+	if err != nil {
+		return err
+	}
+
+	// 2. Domain call
+	var discarded error
+	if product.Status() == domain.ProductStatusArchived {
+		err = product.DiscardScheduledPriceChange(scheduleID, domain.ErrProductArchived, now)
+	} else {
+		err = product.ApplyScheduledPriceChange(scheduleID, now, shared.MarginOptions(it.Margins, false, "")...)
+		if errors.Is(err, domain.ErrBundlePriceDerived) || errors.Is(err, domain.ErrMarginViolation) {
+			discarded = err
+			err = product.DiscardScheduledPriceChange(scheduleID, discarded, now)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo mutations
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.ScheduledPriceMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	for _, ev := range product.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	if err := it.Committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+	if discarded != nil {
+		return fmt.Errorf("change discarded: %w", discarded)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package approve_price_change
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request approves a pending price change request.
+type Request struct {
+	RequestID string
+	Actor     string
+	Role      domain.Role
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	Requests    contracts.PriceChangeRequestRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	RequestRM   contracts.PriceChangeRequestReadModel
+	Margins     *domain.MarginPolicy
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, requests contracts.PriceChangeRequestRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer,
+	readModel contracts.ReadModel, requestRM contracts.PriceChangeRequestReadModel, margins *domain.MarginPolicy, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		Requests:    requests,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		RequestRM:   requestRM,
+		Margins:     margins,
+		Clock:       clk,
+	}
+}
+
+// Execute approves the request and applies the held-back change to the product
+// in the same commit. The margin check runs again against the product as it is
+// now, with the requester's override flag and role.
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregates
+	requestDTO, err := it.RequestRM.GetPriceChangeRequest(ctx, req.RequestID)
+	if err != nil {
+		return err
+	}
+	request, err := shared.PriceChangeRequestFromDTO(requestDTO)
+	if err != nil {
+		return err
+	}
+
+	dto, err := it.ReadModel.GetProduct(ctx, request.ProductID(), contracts.ProductReadOptions{})
+	if err != nil {
+		return err
+	}
+
+	product, err := shared.PricedProductFromDTO(dto)
+	if err != nil {
+		return err
+	}
+
+	// 2. Domain calls
+	if err := request.Approve(req.Actor, req.Role, now); err != nil {
+		return err
+	}
+	margins := shared.MarginOptions(it.Margins, request.OverrideMargin(), request.RequestedRole())
+	if err := request.ApplyTo(product, now, margins...); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan; the pending check makes the write fail if the request was decided meanwhile.
+	plan := commitplan.NewPlan()
+	plan.AddCheck(it.Requests.PendingCheck(request))
+
+	// 4. Repo mutations
+	plan.Add(it.Requests.UpdateMut(request))
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.CurrencyPriceMuts(product) {
+		plan.Add(m)
+	}
+	for _, m := range it.ProductRepo.VariantMuts(product) {
+		plan.Add(m)
+	}
+	for _, m := range it.ProductRepo.ChannelMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	events := make([]domain.DomainEvent, 0, len(request.DomainEvents())+len(product.DomainEvents()))
+	events = append(events, request.DomainEvents()...)
+	events = append(events, product.DomainEvents()...)
+	for _, ev := range events {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
@@ -0,0 +1,122 @@
+package archive_product
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	ProductID string
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{ProductRepo: repo, OutboxRepo: outboxRepo, Committer: committer, ReadModel: readModel, Clock: clk}
+}
+
+// Execute archives an inactive product and, in the same commit, deactivates the
+// active bundles that contain it.
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return err
+	}
+	product, err := reconstruct(dto)
+	if err != nil {
+		return err
+	}
+
+	if err := product.Archive(now); err != nil {
+		return err
+	}
+
+	bundleIDs, err := it.ReadModel.FindBundleIDsByComponent(ctx, product.ID())
+	if err != nil {
+		return err
+	}
+	var bundles []*domain.Product
+	for _, id := range bundleIDs {
+		bundleDTO, err := it.ReadModel.GetProduct(ctx, id, contracts.ProductReadOptions{})
+		if err != nil {
+			return err
+		}
+		bundle, err := reconstruct(bundleDTO)
+		if err != nil {
+			return err
+		}
+		if err := bundle.DeactivateBundle(product.ID(), now); err != nil {
+			return err
+		}
+		bundles = append(bundles, bundle)
+	}
+
+	plan := commitplan.NewPlan()
+	plan.Add(it.ProductRepo.ArchiveMut(product))
+	for _, b := range bundles {
+		plan.Add(it.ProductRepo.UpdateMut(b))
+	}
+
+	for _, p := range append([]*domain.Product{product}, bundles...) {
+		for _, ev := range p.DomainEvents() {
+			eventID := uuid.New().String()
+			payload, err := shared.MarshalDomainEventPayload(ev)
+			if err != nil {
+				return err
+			}
+			plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+				EventID:      eventID,
+				EventType:    ev.EventType(),
+				AggregateID:  ev.AggregateID(),
+				PayloadJSON:  payload,
+				Status:       "pending",
+				CreatedAtUTC: now,
+			}))
+		}
+	}
+
+	return it.Committer.Apply(ctx, plan)
+}
+
+// reconstruct rebuilds the product with its bundle components, the only state
+// archiving and bundle deactivation look at besides the status.
+func reconstruct(in *dto.ProductDTO) (*domain.Product, error) {
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(in.Currency), in.BasePrice)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := shared.BundleFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructProduct(
+		in.ProductID,
+		in.Name,
+		"",
+		in.Category,
+		base,
+		nil,
+		domain.ProductStatus(in.Status),
+		utils.TimeOrZero(utils.ParseTimePtr(in.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(in.UpdatedAt)),
+		utils.ParseTimePtr(in.ArchivedAt),
+		bundle,
+	), nil
+}
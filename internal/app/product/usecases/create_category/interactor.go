@@ -0,0 +1,97 @@
+package create_category
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request is the application-level create-category request.
+type Request struct {
+	Name     string
+	Slug     string // optional; derived from Name when empty
+	ParentID string // empty creates a root category
+}
+
+// Interactor implements the create-category usecase following the Golden Mutation pattern.
+type Interactor struct {
+	CategoryRepo contracts.CategoryRepo
+	OutboxRepo   contracts.OutboxRepo
+	Committer    contracts.Committer
+	ReadModel    contracts.CategoryReadModel
+	Clock        clock.Clock
+}
+
+// NewInteractor constructs the interactor.
+func NewInteractor(repo contracts.CategoryRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.CategoryReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		CategoryRepo: repo,
+		OutboxRepo:   outboxRepo,
+		Committer:    committer,
+		ReadModel:    readModel,
+		Clock:        clk,
+	}
+}
+
+// Execute creates a new category, persists it and writes outbox events in a single commit.
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+	now := it.Clock.Now()
+
+	// 1. Load the parent, if any
+	var parent *domain.Category
+	if req.ParentID != "" {
+		in, err := it.ReadModel.GetCategory(ctx, req.ParentID)
+		if err != nil {
+			return "", err
+		}
+		parent = shared.CategoryFromDTO(in)
+	}
+
+	// 2. Build domain aggregate
+	id := uuid.New().String()
+	category, err := domain.NewCategory(id, req.Name, req.Slug, parent, now)
+	if err != nil {
+		return "", err
+	}
+
+	// 3. Slugs are unique (also enforced by a unique index)
+	if _, err := it.ReadModel.GetCategoryBySlug(ctx, category.Slug()); err == nil {
+		return "", domain.ErrCategorySlugTaken
+	} else if !errors.Is(err, domain.ErrCategoryNotFound) {
+		return "", err
+	}
+
+	// 4. Build commit plan
+	plan := commitplan.NewPlan()
+	plan.Add(it.CategoryRepo.InsertMut(category))
+
+	// 5. Add outbox events
+	for _, ev := range category.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return "", err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan via Committer
+	if err := it.Committer.Apply(ctx, plan); err != nil {
+		return "", err
+	}
+
+	return category.ID(), nil
+}
@@ -0,0 +1,90 @@
+package create_price_list
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request is the application-level create-price-list request.
+type Request struct {
+	Segment     string
+	Name        string
+	Description string
+}
+
+// Interactor implements the create-price-list usecase following the Golden Mutation pattern.
+type Interactor struct {
+	PriceListRepo contracts.PriceListRepo
+	OutboxRepo    contracts.OutboxRepo
+	Committer     contracts.Committer
+	ReadModel     contracts.PriceListReadModel
+	Clock         clock.Clock
+}
+
+// NewInteractor constructs the interactor.
+func NewInteractor(repo contracts.PriceListRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.PriceListReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		PriceListRepo: repo,
+		OutboxRepo:    outboxRepo,
+		Committer:     committer,
+		ReadModel:     readModel,
+		Clock:         clk,
+	}
+}
+
+// Execute creates a new price list, persists it and writes outbox events in a single commit.
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+	now := it.Clock.Now()
+
+	// 1. Build domain aggregate
+	id := uuid.New().String()
+	priceList, err := domain.NewPriceList(id, req.Segment, req.Name, req.Description, now)
+	if err != nil {
+		return "", err
+	}
+
+	// 2. Segments are unique (also enforced by a unique index)
+	if _, err := it.ReadModel.GetPriceListBySegment(ctx, priceList.Segment()); err == nil {
+		return "", domain.ErrPriceListSegmentTaken
+	} else if !errors.Is(err, domain.ErrPriceListNotFound) {
+		return "", err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo insert mutation
+	plan.Add(it.PriceListRepo.InsertMut(priceList))
+
+	// 5. Add outbox events
+	for _, ev := range priceList.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return "", err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan via Committer
+	if err := it.Committer.Apply(ctx, plan); err != nil {
+		return "", err
+	}
+
+	return priceList.ID(), nil
+}
@@ -2,6 +2,7 @@ package create_product
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/google/uuid"
 
@@ -16,9 +17,32 @@ import (
 type Request struct {
 	Name         string
 	Description  string
-	Category     string
-	BasePriceNum int64 // numerator
-	BasePriceDen int64 // denominator
+	CategoryID   string // the product's category
+	Category     string // slug (or name) of the category; used when CategoryID is empty
+	BasePriceNum int64  // numerator
+	BasePriceDen int64  // denominator
+	Currency     string // ISO 4217 code; empty means domain.DefaultCurrency
+	TaxCategory  string // empty means domain.DefaultTaxCategory
+	// DefaultLocale is the locale of Name and Description; empty means domain.DefaultLocale.
+	DefaultLocale string
+
+	// Optional identifiers; the GTIN may be given in any of its 8-14 digit forms.
+	SKU  string
+	GTIN string
+
+	// Optional package size (e.g. 500 "g"); both parts must be set.
+	PackageQuantity *big.Rat
+	UnitOfMeasure   string
+
+	// Optional cost price in Currency; both parts must be set.
+	CostPriceNum *int64
+	CostPriceDen *int64
+	// OverrideMargin lets the cost breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+
+	// Attributes are custom attribute values by name, validated against the category's schema.
+	Attributes map[string]string
 }
 
 // Interactor implements the create-product usecase following the Golden Mutation pattern.
@@ -26,15 +50,23 @@ type Interactor struct {
 	ProductRepo contracts.ProductRepo
 	OutboxRepo  contracts.OutboxRepo
 	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Categories  contracts.CategoryReadModel
+	Schemas     contracts.AttributeSchemaReadModel
+	Margins     *domain.MarginPolicy
 	Clock       clock.Clock
 }
 
 // NewInteractor constructs the interactor.
-func NewInteractor(prodRepo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, clk clock.Clock) *Interactor {
+func NewInteractor(prodRepo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, categories contracts.CategoryReadModel, schemas contracts.AttributeSchemaReadModel, margins *domain.MarginPolicy, clk clock.Clock) *Interactor {
 	return &Interactor{
 		ProductRepo: prodRepo,
 		OutboxRepo:  outboxRepo,
 		Committer:   committer,
+		ReadModel:   readModel,
+		Categories:  categories,
+		Schemas:     schemas,
+		Margins:     margins,
 		Clock:       clk,
 	}
 }
@@ -45,11 +77,71 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (string, error)
 
 	// 1. Build domain aggregate
 	id := uuid.New().String()
-	baseMoney := domain.NewMoney(req.BasePriceNum, req.BasePriceDen)
-	product, err := domain.NewProduct(id, req.Name, req.Description, req.Category, baseMoney, now)
+	currency, err := domain.ParseCurrency(req.Currency)
+	if err != nil {
+		return "", err
+	}
+	baseMoney := domain.NewMoneyIn(currency, req.BasePriceNum, req.BasePriceDen)
+	category, err := shared.ResolveCategory(ctx, it.Categories, req.CategoryID, req.Category)
+	if err != nil {
+		return "", err
+	}
+	product, err := domain.NewProduct(id, req.Name, req.Description, category, baseMoney, now)
 	if err != nil {
 		return "", err
 	}
+	taxCategory, err := domain.ParseTaxCategory(req.TaxCategory)
+	if err != nil {
+		return "", err
+	}
+	if err := product.SetTaxCategory(taxCategory, now); err != nil {
+		return "", err
+	}
+	if req.DefaultLocale != "" {
+		locale, err := domain.ParseLocale(req.DefaultLocale)
+		if err != nil {
+			return "", err
+		}
+		if err := product.SetDefaultLocale(locale, now); err != nil {
+			return "", err
+		}
+	}
+	if err := product.SetSKU(req.SKU, now); err != nil {
+		return "", err
+	}
+	if err := product.SetGTIN(req.GTIN, now); err != nil {
+		return "", err
+	}
+	if err := shared.CheckIdentifiersUnique(ctx, it.ReadModel, product); err != nil {
+		return "", err
+	}
+	if err := shared.ResolveSlug(ctx, it.ReadModel, product); err != nil {
+		return "", err
+	}
+	if req.PackageQuantity != nil || req.UnitOfMeasure != "" {
+		size, err := shared.NewPackageSize(req.PackageQuantity, req.UnitOfMeasure)
+		if err != nil {
+			return "", err
+		}
+		if err := product.SetPackageSize(size, now); err != nil {
+			return "", err
+		}
+	}
+	if req.CostPriceNum != nil && req.CostPriceDen != nil {
+		cost := domain.NewMoneyIn(currency, *req.CostPriceNum, *req.CostPriceDen)
+		if err := product.SetCostPrice(cost, now, shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)...); err != nil {
+			return "", err
+		}
+	}
+
+	// Attributes are checked even when none are given: the category may require some.
+	schema, err := shared.LoadAttributeSchema(ctx, it.Schemas, product.Category())
+	if err != nil {
+		return "", err
+	}
+	if err := product.SetAttributes(req.Attributes, schema, now); err != nil {
+		return "", err
+	}
 
 	// 2. Domain validation done in constructor
 
@@ -58,6 +150,9 @@ func (it *Interactor) Execute(ctx context.Context, req Request) (string, error)
 
 	// 4. Repo insert mutation
 	plan.Add(it.ProductRepo.InsertMut(product))
+	for _, mut := range it.ProductRepo.AttributeMuts(product) {
+		plan.Add(mut)
+	}
 
 	// 5. Add outbox events (enriched)
 	for _, ev := range product.DomainEvents() {
@@ -32,7 +32,7 @@ func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo,
 func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	now := it.Clock.Now()
 
-	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID)
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
 	if err != nil {
 		return err
 	}
@@ -41,7 +41,10 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	updatedAtPtr := utils.ParseTimePtr(dto.UpdatedAt)
 	archivedAtPtr := utils.ParseTimePtr(dto.ArchivedAt)
 
-	base := domain.NewMoney(dto.BasePriceNum, dto.BasePriceDen)
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(dto.Currency), dto.BasePrice)
+	if err != nil {
+		return err
+	}
 	product := domain.ReconstructProduct(
 		dto.ProductID,
 		dto.Name,
@@ -53,6 +56,7 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 		utils.TimeOrZero(createdAtPtr),
 		utils.TimeOrZero(updatedAtPtr),
 		archivedAtPtr,
+		shared.AvailabilityFromDTO(dto),
 	)
 
 	if err := product.Deactivate(now); err != nil {
@@ -0,0 +1,92 @@
+package define_attribute
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request defines a custom attribute for the products of a category, replacing
+// any definition with the same name.
+type Request struct {
+	Category   string // slug (or name) of an existing category
+	Name       string
+	Type       string   // string, number, boolean or enum
+	Required   bool     // products in the category must carry the attribute
+	EnumValues []string // allowed values of enum attributes
+	Unit       string   // optional unit of number attributes, e.g. "in"
+}
+
+type Interactor struct {
+	SchemaRepo contracts.AttributeSchemaRepo
+	OutboxRepo contracts.OutboxRepo
+	Committer  contracts.Committer
+	Categories contracts.CategoryReadModel
+	Schemas    contracts.AttributeSchemaReadModel
+	Clock      clock.Clock
+}
+
+func NewInteractor(repo contracts.AttributeSchemaRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, categories contracts.CategoryReadModel, schemas contracts.AttributeSchemaReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		SchemaRepo: repo,
+		OutboxRepo: outboxRepo,
+		Committer:  committer,
+		Categories: categories,
+		Schemas:    schemas,
+		Clock:      clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate; only existing categories get attributes
+	category, err := shared.ResolveCategory(ctx, it.Categories, "", req.Category)
+	if err != nil {
+		return err
+	}
+	schema, err := shared.LoadAttributeSchema(ctx, it.Schemas, category.Slug())
+	if err != nil {
+		return err
+	}
+
+	// 2. Domain call
+	typ, err := domain.ParseAttributeType(req.Type)
+	if err != nil {
+		return err
+	}
+	if _, err := schema.Define(req.Name, typ, req.Required, req.EnumValues, req.Unit, now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+	for _, mut := range it.SchemaRepo.DefinitionMuts(schema) {
+		plan.Add(mut)
+	}
+
+	// 4. Outbox events
+	for _, ev := range schema.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 5. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
@@ -0,0 +1,72 @@
+package delete_category
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request deletes an empty category.
+type Request struct {
+	CategoryID string
+}
+
+type Interactor struct {
+	CategoryRepo contracts.CategoryRepo
+	OutboxRepo   contracts.OutboxRepo
+	Committer    contracts.Committer
+	ReadModel    contracts.CategoryReadModel
+	Clock        clock.Clock
+}
+
+func NewInteractor(repo contracts.CategoryRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.CategoryReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{CategoryRepo: repo, OutboxRepo: outboxRepo, Committer: committer, ReadModel: readModel, Clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	in, err := it.ReadModel.GetCategory(ctx, req.CategoryID)
+	if err != nil {
+		return err
+	}
+	category := shared.CategoryFromDTO(in)
+
+	children, err := it.ReadModel.ListCategories(ctx, category.ID())
+	if err != nil {
+		return err
+	}
+	products, err := it.ReadModel.CountCategoryProducts(ctx, category.ID())
+	if err != nil {
+		return err
+	}
+
+	if err := category.Delete(len(children), products, now); err != nil {
+		return err
+	}
+
+	plan := commitplan.NewPlan()
+	plan.Add(it.CategoryRepo.DeleteMut(category))
+
+	for _, ev := range category.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	return it.Committer.Apply(ctx, plan)
+}
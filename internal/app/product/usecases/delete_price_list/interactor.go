@@ -0,0 +1,71 @@
+package delete_price_list
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+type Request struct {
+	PriceListID string
+}
+
+type Interactor struct {
+	PriceListRepo contracts.PriceListRepo
+	OutboxRepo    contracts.OutboxRepo
+	Committer     contracts.Committer
+	ReadModel     contracts.PriceListReadModel
+	Clock         clock.Clock
+}
+
+func NewInteractor(repo contracts.PriceListRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.PriceListReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{PriceListRepo: repo, OutboxRepo: outboxRepo, Committer: committer, ReadModel: readModel, Clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	dto, err := it.ReadModel.GetPriceList(ctx, req.PriceListID)
+	if err != nil {
+		return err
+	}
+
+	priceList := domain.ReconstructPriceList(
+		dto.PriceListID,
+		dto.Segment,
+		dto.Name,
+		"",
+		utils.TimeOrZero(utils.ParseTimePtr(dto.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.UpdatedAt)),
+	)
+
+	priceList.Delete(now)
+
+	plan := commitplan.NewPlan()
+	plan.Add(it.PriceListRepo.DeleteMut(priceList))
+
+	for _, ev := range priceList.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	return it.Committer.Apply(ctx, plan)
+}
@@ -0,0 +1,104 @@
+package import_exchange_rates
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Rate is one exchange rate to import.
+type Rate struct {
+	From        string // ISO 4217 code
+	To          string // ISO 4217 code
+	Rate        *big.Rat
+	EffectiveAt time.Time
+}
+
+// Request imports a batch of exchange rates.
+// The batch is validated as a whole and stored in a single commit.
+type Request struct {
+	Rates []Rate
+}
+
+type Interactor struct {
+	ExchangeRateRepo contracts.ExchangeRateRepo
+	OutboxRepo       contracts.OutboxRepo
+	Committer        contracts.Committer
+	Clock            clock.Clock
+}
+
+func NewInteractor(repo contracts.ExchangeRateRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ExchangeRateRepo: repo,
+		OutboxRepo:       outboxRepo,
+		Committer:        committer,
+		Clock:            clk,
+	}
+}
+
+// Execute stores the rates and returns how many were imported.
+func (it *Interactor) Execute(ctx context.Context, req Request) (int, error) {
+	now := it.Clock.Now()
+
+	// 1. Build domain value objects
+	rates := make([]*domain.ExchangeRate, 0, len(req.Rates))
+	for _, in := range req.Rates {
+		// Both sides of a rate are required; ParseCurrency would default an empty code.
+		if in.From == "" || in.To == "" {
+			return 0, domain.ErrUnsupportedCurrency
+		}
+		from, err := domain.ParseCurrency(in.From)
+		if err != nil {
+			return 0, err
+		}
+		to, err := domain.ParseCurrency(in.To)
+		if err != nil {
+			return 0, err
+		}
+		rate, err := domain.NewExchangeRate(from, to, in.Rate, in.EffectiveAt)
+		if err != nil {
+			return 0, err
+		}
+		rates = append(rates, rate)
+	}
+	if len(rates) == 0 {
+		return 0, nil
+	}
+
+	// 2. Build commit plan
+	plan := commitplan.NewPlan()
+
+	for _, rate := range rates {
+		// 3. Repo mutation
+		plan.Add(it.ExchangeRateRepo.UpsertMut(rate, now))
+
+		// 4. Outbox event
+		ev := rate.SetEvent(now)
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return 0, err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 5. Apply plan
+	if err := it.Committer.Apply(ctx, plan); err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
@@ -0,0 +1,94 @@
+package move_category
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request moves a category, with its subtree, below a new parent.
+type Request struct {
+	CategoryID string
+	ParentID   string // empty makes the category a root category
+}
+
+type Interactor struct {
+	CategoryRepo contracts.CategoryRepo
+	OutboxRepo   contracts.OutboxRepo
+	Committer    contracts.Committer
+	ReadModel    contracts.CategoryReadModel
+	Clock        clock.Clock
+}
+
+func NewInteractor(repo contracts.CategoryRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.CategoryReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{CategoryRepo: repo, OutboxRepo: outboxRepo, Committer: committer, ReadModel: readModel, Clock: clk}
+}
+
+// Execute re-parents the category and rewrites the paths of all its descendants in
+// one commit. Products keep their category, so subtree listings follow the move.
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load the category, its subtree and the new parent
+	in, err := it.ReadModel.GetCategory(ctx, req.CategoryID)
+	if err != nil {
+		return err
+	}
+	category := shared.CategoryFromDTO(in)
+
+	var parent *domain.Category
+	if req.ParentID != "" {
+		p, err := it.ReadModel.GetCategory(ctx, req.ParentID)
+		if err != nil {
+			return err
+		}
+		parent = shared.CategoryFromDTO(p)
+	}
+
+	rows, err := it.ReadModel.ListCategoryDescendants(ctx, category.ID())
+	if err != nil {
+		return err
+	}
+	descendants := make([]*domain.Category, 0, len(rows))
+	for _, d := range rows {
+		descendants = append(descendants, shared.CategoryFromDTO(d))
+	}
+
+	// 2. Domain call
+	if err := category.Move(parent, descendants, now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+	for _, c := range append([]*domain.Category{category}, descendants...) {
+		if mut := it.CategoryRepo.UpdateMut(c); mut != nil {
+			plan.Add(mut)
+		}
+	}
+
+	// 4. Outbox events
+	for _, ev := range category.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 5. Apply
+	return it.Committer.Apply(ctx, plan)
+}
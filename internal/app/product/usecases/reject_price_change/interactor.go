@@ -0,0 +1,87 @@
+package reject_price_change
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request rejects (or, for the requester, withdraws) a pending price change request.
+type Request struct {
+	RequestID string
+	Actor     string
+	Role      domain.Role
+	Reason    string
+}
+
+type Interactor struct {
+	Requests   contracts.PriceChangeRequestRepo
+	OutboxRepo contracts.OutboxRepo
+	Committer  contracts.Committer
+	RequestRM  contracts.PriceChangeRequestReadModel
+	Clock      clock.Clock
+}
+
+func NewInteractor(requests contracts.PriceChangeRequestRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer,
+	requestRM contracts.PriceChangeRequestReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		Requests:   requests,
+		OutboxRepo: outboxRepo,
+		Committer:  committer,
+		RequestRM:  requestRM,
+		Clock:      clk,
+	}
+}
+
+// Execute rejects the request; the product is left untouched.
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	dto, err := it.RequestRM.GetPriceChangeRequest(ctx, req.RequestID)
+	if err != nil {
+		return err
+	}
+	request, err := shared.PriceChangeRequestFromDTO(dto)
+	if err != nil {
+		return err
+	}
+
+	// 2. Domain call
+	if err := request.Reject(req.Actor, req.Role, req.Reason, now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan; the pending check makes the write fail if the request was decided meanwhile.
+	plan := commitplan.NewPlan()
+	plan.AddCheck(it.Requests.PendingCheck(request))
+
+	// 4. Repo mutation
+	plan.Add(it.Requests.UpdateMut(request))
+
+	// 5. Outbox events
+	for _, ev := range request.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
@@ -0,0 +1,93 @@
+package release_reservation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request ends a stock reservation. Fulfilled means the order shipped: the units
+// leave the location instead of becoming available again.
+type Request struct {
+	ReservationID string
+	Fulfilled     bool
+}
+
+type Interactor struct {
+	StockRepo      contracts.StockRepo
+	OutboxRepo     contracts.OutboxRepo
+	Committer      contracts.Committer
+	StockReadModel contracts.StockReadModel
+	Clock          clock.Clock
+}
+
+func NewInteractor(repo contracts.StockRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, stockReadModel contracts.StockReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		StockRepo:      repo,
+		OutboxRepo:     outboxRepo,
+		Committer:      committer,
+		StockReadModel: stockReadModel,
+		Clock:          clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	return shared.RetryStockConflicts(ctx, func(ctx context.Context) error {
+		return it.release(ctx, req)
+	})
+}
+
+func (it *Interactor) release(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregates; a reservation released meanwhile is gone on retry.
+	in, err := it.StockReadModel.GetStockReservation(ctx, req.ReservationID)
+	if err != nil {
+		return err
+	}
+	reservation := domain.ReconstructStockReservation(in.ReservationID, in.ProductID, in.LocationID, in.Quantity,
+		utils.TimeOrZero(utils.ParseTimePtr(&in.CreatedAt)))
+	level, err := shared.LoadStockLevel(ctx, it.StockReadModel, in.ProductID, in.LocationID, now)
+	if err != nil {
+		return err
+	}
+
+	// 2. Domain call
+	if err := level.Release(reservation, req.Fulfilled, now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan; the version check makes the write fail if the level moved.
+	plan := commitplan.NewPlan()
+	plan.AddCheck(it.StockRepo.VersionCheck(level))
+
+	// 4. Repo mutations
+	plan.Add(it.StockRepo.SaveMut(level))
+	plan.Add(it.StockRepo.ReservationDeleteMut(reservation))
+
+	// 5. Outbox events
+	for _, ev := range level.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
@@ -0,0 +1,77 @@
+package remove_attribute
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request removes a custom attribute definition from a category.
+type Request struct {
+	Category string
+	Name     string
+}
+
+type Interactor struct {
+	SchemaRepo contracts.AttributeSchemaRepo
+	OutboxRepo contracts.OutboxRepo
+	Committer  contracts.Committer
+	Schemas    contracts.AttributeSchemaReadModel
+	Clock      clock.Clock
+}
+
+func NewInteractor(repo contracts.AttributeSchemaRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, schemas contracts.AttributeSchemaReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		SchemaRepo: repo,
+		OutboxRepo: outboxRepo,
+		Committer:  committer,
+		Schemas:    schemas,
+		Clock:      clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	schema, err := shared.LoadAttributeSchema(ctx, it.Schemas, strings.TrimSpace(req.Category))
+	if err != nil {
+		return err
+	}
+
+	// 2. Domain call
+	if err := schema.Remove(req.Name, now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+	for _, mut := range it.SchemaRepo.DefinitionMuts(schema) {
+		plan.Add(mut)
+	}
+
+	// 4. Outbox events
+	for _, ev := range schema.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 5. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
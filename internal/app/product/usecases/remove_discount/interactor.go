@@ -2,7 +2,6 @@ package remove_discount
 
 import (
 	"context"
-	"math/big"
 
 	"github.com/google/uuid"
 
@@ -33,7 +32,7 @@ func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo,
 func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	now := it.Clock.Now()
 
-	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID)
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
 	if err != nil {
 		return err
 	}
@@ -47,27 +46,15 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 		desc = *dto.Description
 	}
 
-	base := domain.NewMoney(dto.BasePriceNum, dto.BasePriceDen)
-
-	var existingDiscount *domain.Discount
-	if dto.DiscountPct != nil && dto.DiscountStart != nil && dto.DiscountEnd != nil {
-		pct := new(big.Rat)
-		if _, ok := pct.SetString(*dto.DiscountPct); ok {
-			if pct.Cmp(big.NewRat(1, 1)) == 1 {
-				pct = new(big.Rat).Quo(pct, big.NewRat(100, 1))
-			}
-			start := utils.ParseTimePtr(dto.DiscountStart)
-			end := utils.ParseTimePtr(dto.DiscountEnd)
-			if start != nil && end != nil {
-				d, err := domain.NewDiscountFromRat(pct, *start, *end)
-				if err != nil {
-					return err
-				}
-				existingDiscount = d
-			}
-		}
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(dto.Currency), dto.BasePrice)
+	if err != nil {
+		return err
 	}
 
+	existingDiscount, err := shared.DiscountFromDTO(dto)
+	if err != nil {
+		return err
+	}
 	product := domain.ReconstructProduct(
 		dto.ProductID,
 		dto.Name,
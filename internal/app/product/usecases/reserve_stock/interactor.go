@@ -0,0 +1,101 @@
+package reserve_stock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request holds Quantity available units of an active product at a location for an order.
+type Request struct {
+	ProductID  string
+	LocationID string
+	Quantity   int64
+}
+
+type Interactor struct {
+	StockRepo      contracts.StockRepo
+	OutboxRepo     contracts.OutboxRepo
+	Committer      contracts.Committer
+	ReadModel      contracts.ReadModel
+	StockReadModel contracts.StockReadModel
+	Clock          clock.Clock
+}
+
+func NewInteractor(repo contracts.StockRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, stockReadModel contracts.StockReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		StockRepo:      repo,
+		OutboxRepo:     outboxRepo,
+		Committer:      committer,
+		ReadModel:      readModel,
+		StockReadModel: stockReadModel,
+		Clock:          clk,
+	}
+}
+
+// Execute reserves the units and returns the reservation ID to release it with.
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return "", err
+	}
+	status := domain.ProductStatus(dto.Status)
+
+	reservationID := uuid.New().String()
+	err = shared.RetryStockConflicts(ctx, func(ctx context.Context) error {
+		return it.reserve(ctx, req, reservationID, status)
+	})
+	if err != nil {
+		return "", err
+	}
+	return reservationID, nil
+}
+
+func (it *Interactor) reserve(ctx context.Context, req Request, reservationID string, status domain.ProductStatus) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	level, err := shared.LoadStockLevel(ctx, it.StockReadModel, req.ProductID, req.LocationID, now)
+	if err != nil {
+		return err
+	}
+
+	// 2. Domain call
+	reservation, err := level.Reserve(reservationID, req.Quantity, status, now)
+	if err != nil {
+		return err
+	}
+
+	// 3. Build commit plan; the version check makes the write fail if the level moved.
+	plan := commitplan.NewPlan()
+	plan.AddCheck(it.StockRepo.VersionCheck(level))
+
+	// 4. Repo mutations
+	plan.Add(it.StockRepo.SaveMut(level))
+	plan.Add(it.StockRepo.ReservationInsertMut(reservation))
+
+	// 5. Outbox events
+	for _, ev := range level.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
@@ -0,0 +1,120 @@
+package schedule_price_change
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request schedules a change of a product's primary base price.
+type Request struct {
+	ProductID   string
+	PriceNum    int64
+	PriceDen    int64
+	Currency    string // ISO 4217 code; empty means the product's primary currency
+	EffectiveAt time.Time
+	// OverrideMargin lets the new price breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Margins     *domain.MarginPolicy
+	Approvals   *domain.ApprovalPolicy
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, margins *domain.MarginPolicy, approvals *domain.ApprovalPolicy, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		Margins:     margins,
+		Approvals:   approvals,
+		Clock:       clk,
+	}
+}
+
+// Execute schedules the change and returns its id. A change beyond the approval
+// policy's thresholds fails with domain.ErrScheduledPriceNeedsApproval, since the
+// scheduler applies changes without a second actor.
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+	now := it.Clock.Now()
+
+	var currency domain.Currency
+	if req.Currency != "" {
+		c, err := domain.ParseCurrency(req.Currency)
+		if err != nil {
+			return "", err
+		}
+		currency = c
+	}
+
+	// 1. Load aggregate
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	product, err := shared.PricedProductFromDTO(dto)
+	if err != nil {
+		return "", err
+	}
+
+	// 2. Domain call
+	if currency == "" {
+		currency = product.Currency()
+	}
+	id := uuid.New().String()
+	price := domain.NewMoneyIn(currency, req.PriceNum, req.PriceDen)
+	if current, ok := product.PriceIn(currency); ok && it.Approvals.PriceChangeNeedsApproval(current, price) {
+		return "", domain.ErrScheduledPriceNeedsApproval
+	}
+	if _, err := product.SchedulePriceChange(id, price, req.EffectiveAt, now, shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)...); err != nil {
+		return "", err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo mutations
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.ScheduledPriceMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	for _, ev := range product.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return "", err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	if err := it.Committer.Apply(ctx, plan); err != nil {
+		return "", err
+	}
+	return id, nil
+}
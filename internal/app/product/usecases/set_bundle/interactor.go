@@ -0,0 +1,181 @@
+package set_bundle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Component is a quantity of a component product in a bundle.
+type Component struct {
+	ProductID string
+	Quantity  int64
+}
+
+// Request makes a product a bundle of components, replacing any components it had.
+type Request struct {
+	ProductID  string
+	Pricing    string // "components_sum" or "fixed"
+	Components []Component
+	// DiscountPercent is the exact percentage, 0-100 scale, taken off the components'
+	// prices of a components_sum bundle; nil means none.
+	DiscountPercent *big.Rat
+	// OverrideMargin lets a components_sum price breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Margins     *domain.MarginPolicy
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, margins *domain.MarginPolicy, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		Margins:     margins,
+		Clock:       clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return err
+	}
+
+	// A product that is itself a component of a bundle cannot become one.
+	containing, err := it.ReadModel.FindBundleIDsByComponent(ctx, dto.ProductID)
+	if err != nil {
+		return err
+	}
+	if len(containing) > 0 {
+		return domain.ErrNestedBundle
+	}
+
+	components := make([]*domain.BundleComponent, 0, len(req.Components))
+	products := make(map[string]domain.ComponentProduct, len(req.Components))
+	for _, c := range req.Components {
+		component, err := domain.NewBundleComponent(c.ProductID, c.Quantity)
+		if err != nil {
+			return err
+		}
+		components = append(components, component)
+		if _, ok := products[component.ProductID()]; ok || component.ProductID() == dto.ProductID {
+			continue // the aggregate rejects duplicates and the bundle itself
+		}
+
+		// The component must exist; the aggregate checks its type, status and currency.
+		cp, err := it.ReadModel.GetProduct(ctx, component.ProductID(), contracts.ProductReadOptions{})
+		if err != nil {
+			return err
+		}
+		price, err := domain.NewMoneyFromDecimalIn(domain.Currency(cp.Currency), cp.BasePrice)
+		if err != nil {
+			return err
+		}
+		products[cp.ProductID] = domain.ComponentProduct{
+			ID:        cp.ProductID,
+			Type:      domain.ProductType(cp.ProductType),
+			Status:    domain.ProductStatus(cp.Status),
+			BasePrice: price,
+		}
+	}
+
+	var discount *big.Rat
+	if req.DiscountPercent != nil {
+		discount = new(big.Rat).Quo(req.DiscountPercent, big.NewRat(100, 1))
+	}
+
+	description := ""
+	if dto.Description != nil {
+		description = *dto.Description
+	}
+
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(dto.Currency), dto.BasePrice)
+	if err != nil {
+		return err
+	}
+
+	// The discount and cost price feed the margin check of a components_sum price.
+	productDiscount, err := shared.DiscountFromDTO(dto)
+	if err != nil {
+		return err
+	}
+	cost, err := shared.CostPriceFromDTO(dto)
+	if err != nil {
+		return err
+	}
+	bundle, err := shared.BundleFromDTO(dto)
+	if err != nil {
+		return err
+	}
+
+	product := domain.ReconstructProduct(
+		dto.ProductID,
+		dto.Name,
+		description,
+		dto.Category,
+		base,
+		productDiscount,
+		domain.ProductStatus(dto.Status),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.UpdatedAt)),
+		utils.ParseTimePtr(dto.ArchivedAt),
+		domain.WithCostPrice(cost),
+		bundle,
+	)
+
+	// 2. Domain call
+	if err := product.SetBundle(domain.BundlePricingMode(req.Pricing), discount, components, products, now,
+		shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)...); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo mutations
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.BundleComponentMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	for _, ev := range product.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
@@ -0,0 +1,175 @@
+package set_channel_price
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request sets or clears a product's price on a sales channel.
+type Request struct {
+	ProductID string
+	Channel   string
+
+	// Price in the product's primary currency; both parts must be set.
+	// ClearPrice makes the channel sell at the base price instead.
+	PriceNum   *int64
+	PriceDen   *int64
+	Currency   string // ISO 4217 code of the price; empty means the product's primary currency
+	ClearPrice bool
+	// OverrideMargin lets the channel's price breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+	// Actor identifies the requester of a change held back for approval.
+	Actor string
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	Requests    contracts.PriceChangeRequestRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Margins     *domain.MarginPolicy
+	Approvals   *domain.ApprovalPolicy
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, requests contracts.PriceChangeRequestRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, margins *domain.MarginPolicy, approvals *domain.ApprovalPolicy, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		Requests:    requests,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		Margins:     margins,
+		Approvals:   approvals,
+		Clock:       clk,
+	}
+}
+
+// Execute sets the channel's price. A change beyond the approval policy's thresholds
+// is not applied but stored as a PriceChangeRequest, whose id is returned; the id is
+// empty when the price was set right away. Clearing the price returns the channel to
+// the already approved base price and never waits for approval.
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	description := ""
+	if dto.Description != nil {
+		description = *dto.Description
+	}
+
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(dto.Currency), dto.BasePrice)
+	if err != nil {
+		return "", err
+	}
+	channels, err := shared.ChannelsFromDTO(dto)
+	if err != nil {
+		return "", err
+	}
+
+	// The discount and cost price feed the margin check.
+	discount, err := shared.DiscountFromDTO(dto)
+	if err != nil {
+		return "", err
+	}
+	cost, err := shared.CostPriceFromDTO(dto)
+	if err != nil {
+		return "", err
+	}
+	// components_sum bundles take their price from their components.
+	bundle, err := shared.BundleFromDTO(dto)
+	if err != nil {
+		return "", err
+	}
+
+	product := domain.ReconstructProduct(
+		dto.ProductID,
+		dto.Name,
+		description,
+		dto.Category,
+		base,
+		discount,
+		domain.ProductStatus(dto.Status),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.UpdatedAt)),
+		utils.ParseTimePtr(dto.ArchivedAt),
+		domain.WithCostPrice(cost),
+		domain.WithChannels(channels...),
+		bundle,
+	)
+
+	// 2. Domain call
+	var price *domain.Money
+	if !req.ClearPrice {
+		if req.PriceNum == nil || req.PriceDen == nil {
+			return "", domain.ErrZeroPrice
+		}
+		currency, err := shared.PriceCurrency(req.Currency, product)
+		if err != nil {
+			return "", err
+		}
+		price = domain.NewMoneyIn(currency, *req.PriceNum, *req.PriceDen)
+	}
+	channel, err := domain.NormalizeChannel(req.Channel)
+	if err != nil {
+		return "", err
+	}
+	current := product.ChannelPrice(channel)
+	if err := product.SetChannelPrice(channel, price, now, shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)...); err != nil {
+		return "", err
+	}
+
+	// A large change of the channel's price was only validated above; it waits for approval.
+	if price != nil && it.Approvals.PriceChangeNeedsApproval(current, price) {
+		request, err := domain.NewChannelPriceChangeRequest(uuid.New().String(), product.ID(), channel, current, price,
+			req.OverrideMargin, req.Actor, req.Role, now)
+		if err != nil {
+			return "", err
+		}
+		return shared.SubmitPriceChangeRequest(ctx, it.Requests, it.OutboxRepo, it.Committer, request, now)
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo mutations
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.ChannelMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	for _, ev := range product.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return "", err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return "", it.Committer.Apply(ctx, plan)
+}
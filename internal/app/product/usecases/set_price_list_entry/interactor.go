@@ -0,0 +1,127 @@
+package set_price_list_entry
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request sets a product's entry in a price list.
+// Exactly one of OverridePrice (numerator/denominator) or Adjustment must be set.
+type Request struct {
+	PriceListID string
+	ProductID   string
+
+	OverridePriceNum *int64
+	OverridePriceDen *int64
+	// OverrideCurrency is the ISO 4217 code of the override price; empty means domain.DefaultCurrency.
+	OverrideCurrency string
+
+	// Adjustment is a fraction of the base price (e.g. -0.15 for 15% off).
+	Adjustment *big.Rat
+}
+
+type Interactor struct {
+	PriceListRepo      contracts.PriceListRepo
+	OutboxRepo         contracts.OutboxRepo
+	Committer          contracts.Committer
+	PriceListReadModel contracts.PriceListReadModel
+	ProductReadModel   contracts.ReadModel
+	Clock              clock.Clock
+}
+
+func NewInteractor(repo contracts.PriceListRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, priceListReadModel contracts.PriceListReadModel, productReadModel contracts.ReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		PriceListRepo:      repo,
+		OutboxRepo:         outboxRepo,
+		Committer:          committer,
+		PriceListReadModel: priceListReadModel,
+		ProductReadModel:   productReadModel,
+		Clock:              clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load price list and make sure the product exists
+	dto, err := it.PriceListReadModel.GetPriceList(ctx, req.PriceListID)
+	if err != nil {
+		return err
+	}
+	prod, err := it.ProductReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return err
+	}
+	if domain.ProductStatus(prod.Status) == domain.ProductStatusArchived {
+		return domain.ErrProductArchived
+	}
+
+	priceList := domain.ReconstructPriceList(
+		dto.PriceListID,
+		dto.Segment,
+		dto.Name,
+		"",
+		utils.TimeOrZero(utils.ParseTimePtr(dto.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.UpdatedAt)),
+	)
+
+	// 2. Build entry value object
+	var entry *domain.PriceListEntry
+	switch {
+	case req.OverridePriceNum != nil && req.OverridePriceDen != nil && req.Adjustment == nil:
+		if *req.OverridePriceDen == 0 {
+			return domain.ErrInvalidPriceListEntry
+		}
+		var currency domain.Currency
+		currency, err = domain.ParseCurrency(req.OverrideCurrency)
+		if err != nil {
+			return err
+		}
+		entry, err = domain.NewPriceListOverride(req.ProductID, domain.NewMoneyIn(currency, *req.OverridePriceNum, *req.OverridePriceDen))
+	case req.Adjustment != nil && req.OverridePriceNum == nil && req.OverridePriceDen == nil:
+		entry, err = domain.NewPriceListAdjustment(req.ProductID, req.Adjustment)
+	default:
+		err = domain.ErrInvalidPriceListEntry
+	}
+	if err != nil {
+		return err
+	}
+
+	// 3. Domain call
+	if err := priceList.SetEntry(entry, now); err != nil {
+		return err
+	}
+
+	// 4. Build commit plan
+	plan := commitplan.NewPlan()
+	plan.Add(it.PriceListRepo.UpsertEntryMut(priceList, entry))
+
+	// 5. Outbox events
+	for _, ev := range priceList.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
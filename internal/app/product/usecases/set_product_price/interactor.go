@@ -0,0 +1,120 @@
+package set_product_price
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request sets a product's base price in one currency.
+// A price in the product's primary currency replaces the primary base price.
+type Request struct {
+	ProductID string
+	PriceNum  int64
+	PriceDen  int64
+	Currency  string // ISO 4217 code; empty means domain.DefaultCurrency
+	// OverrideMargin lets a primary price breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+	// Actor identifies the caller; it is recorded when the change needs approval.
+	Actor string
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	Requests    contracts.PriceChangeRequestRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Margins     *domain.MarginPolicy
+	Approvals   *domain.ApprovalPolicy
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, requests contracts.PriceChangeRequestRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, margins *domain.MarginPolicy, approvals *domain.ApprovalPolicy, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		Requests:    requests,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		Margins:     margins,
+		Approvals:   approvals,
+		Clock:       clk,
+	}
+}
+
+// Execute sets the price. A change beyond the approval policy's
+// thresholds is not applied but stored as a PriceChangeRequest, whose id is
+// returned; the id is empty when the price was set right away.
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+	now := it.Clock.Now()
+
+	currency, err := domain.ParseCurrency(req.Currency)
+	if err != nil {
+		return "", err
+	}
+
+	// 1. Load aggregate
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	product, err := shared.PricedProductFromDTO(dto)
+	if err != nil {
+		return "", err
+	}
+	current, _ := product.PriceIn(currency)
+
+	// 2. Domain call
+	price := domain.NewMoneyIn(currency, req.PriceNum, req.PriceDen)
+	if err := product.SetCurrencyPrice(price, now, shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)...); err != nil {
+		return "", err
+	}
+
+	// A large change of an existing price was only validated above; it waits for approval.
+	if it.Approvals.PriceChangeNeedsApproval(current, price) {
+		request, err := domain.NewBasePriceChangeRequest(uuid.New().String(), product.ID(), current, price,
+			req.OverrideMargin, req.Actor, req.Role, now)
+		if err != nil {
+			return "", err
+		}
+		return shared.SubmitPriceChangeRequest(ctx, it.Requests, it.OutboxRepo, it.Committer, request, now)
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo mutations
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.CurrencyPriceMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	for _, ev := range product.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return "", err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return "", it.Committer.Apply(ctx, plan)
+}
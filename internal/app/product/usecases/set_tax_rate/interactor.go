@@ -0,0 +1,90 @@
+package set_tax_rate
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request stores the tax rate for one tax category in a region.
+// A zero EffectiveAt means the rate applies from now on.
+type Request struct {
+	Region      string
+	TaxCategory string
+	Rate        *big.Rat // fraction of the net price, e.g. 0.19
+	EffectiveAt time.Time
+}
+
+type Interactor struct {
+	TaxRateRepo contracts.TaxRateRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.TaxRateRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, clk clock.Clock) *Interactor {
+	return &Interactor{
+		TaxRateRepo: repo,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		Clock:       clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Build domain value object
+	region, err := domain.ParseTaxRegion(req.Region)
+	if err != nil {
+		return err
+	}
+	// An empty category would silently default; the rate must name its category.
+	if req.TaxCategory == "" {
+		return domain.ErrInvalidTaxCategory
+	}
+	category, err := domain.ParseTaxCategory(req.TaxCategory)
+	if err != nil {
+		return err
+	}
+	effectiveAt := req.EffectiveAt
+	if effectiveAt.IsZero() {
+		effectiveAt = now
+	}
+	rate, err := domain.NewTaxRate(region, category, req.Rate, effectiveAt)
+	if err != nil {
+		return err
+	}
+
+	// 2. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 3. Repo mutation
+	plan.Add(it.TaxRateRepo.UpsertMut(rate, now))
+
+	// 4. Outbox event
+	ev := rate.SetEvent(now)
+	payload, err := shared.MarshalDomainEventPayload(ev)
+	if err != nil {
+		return err
+	}
+	plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+		EventID:      uuid.New().String(),
+		EventType:    ev.EventType(),
+		AggregateID:  ev.AggregateID(),
+		PayloadJSON:  payload,
+		Status:       "pending",
+		CreatedAtUTC: now,
+	}))
+
+	// 5. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
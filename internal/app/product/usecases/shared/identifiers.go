@@ -0,0 +1,76 @@
+package shared
+
+import (
+	"context"
+	"errors"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+)
+
+// IdentifiersFromDTO returns the product's SKU and GTIN ("" when unset) for domain.WithIdentifiers.
+func IdentifiersFromDTO(in *dto.ProductDTO) (sku, gtin string) {
+	if in.SKU != nil {
+		sku = *in.SKU
+	}
+	if in.GTIN != nil {
+		gtin = *in.GTIN
+	}
+	return sku, gtin
+}
+
+// CheckIdentifiersUnique rejects a changed SKU or GTIN that another product already
+// uses with domain.ErrDuplicateSKU or domain.ErrDuplicateGTIN. SKUs also clash with
+// other products' variant SKUs. The unique indexes on products catch concurrent writers.
+func CheckIdentifiersUnique(ctx context.Context, products contracts.ReadModel, p *domain.Product) error {
+	if p.SKU() != "" && p.Changes().Dirty(domain.FieldSKU) {
+		if err := CheckSKUUnused(ctx, products, p.ID(), p.SKU()); err != nil {
+			return err
+		}
+	}
+	if p.GTIN() != "" && p.Changes().Dirty(domain.FieldGTIN) {
+		owner, err := products.FindProductIDByGTIN(ctx, p.GTIN())
+		if err := checkOwner(p.ID(), owner, err, domain.ErrDuplicateGTIN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckSKUUnused rejects a product or variant SKU with domain.ErrDuplicateSKU when a
+// product other than productID uses it, as its own SKU or a variant's.
+func CheckSKUUnused(ctx context.Context, products contracts.ReadModel, productID, sku string) error {
+	owner, err := products.FindProductIDBySKU(ctx, sku)
+	return checkOwner(productID, owner, err, domain.ErrDuplicateSKU)
+}
+
+// checkOwner returns duplicate when an identifier lookup found a product other than productID.
+func checkOwner(productID, owner string, lookupErr, duplicate error) error {
+	switch {
+	case errors.Is(lookupErr, domain.ErrProductNotFound):
+		return nil
+	case lookupErr != nil:
+		return lookupErr
+	case owner != productID:
+		return duplicate
+	}
+	return nil
+}
+
+// ResolveSlug keeps the slug of a new product, or a changed slug, unique: when another
+// product uses or used it, the slug is disambiguated with the product ID, and domain.ErrProductSlugTaken
+// is returned should that be taken too. The unique index on products catches
+// concurrent writers.
+func ResolveSlug(ctx context.Context, products contracts.ReadModel, p *domain.Product) error {
+	if !p.Changes().Dirty(domain.FieldSlug) && p.PreviousSlug() != "" {
+		return nil
+	}
+	owner, _, err := products.FindProductIDBySlug(ctx, p.Slug())
+	if err := checkOwner(p.ID(), owner, err, domain.ErrProductSlugTaken); !errors.Is(err, domain.ErrProductSlugTaken) {
+		return err
+	}
+	p.DisambiguateSlug()
+	owner, _, err = products.FindProductIDBySlug(ctx, p.Slug())
+	return checkOwner(p.ID(), owner, err, domain.ErrProductSlugTaken)
+}
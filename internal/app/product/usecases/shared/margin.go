@@ -0,0 +1,14 @@
+package shared
+
+import "github.com/murkotick/product-catalog-service/internal/app/product/domain"
+
+// MarginOptions builds the margin guardrail options for a price change: the
+// configured policy, plus an override when the caller requested one. The domain
+// decides whether role may override.
+func MarginOptions(policy *domain.MarginPolicy, override bool, role domain.Role) []domain.PriceChangeOption {
+	opts := []domain.PriceChangeOption{domain.WithMarginPolicy(policy)}
+	if override {
+		opts = append(opts, domain.WithMarginOverride(role))
+	}
+	return opts
+}
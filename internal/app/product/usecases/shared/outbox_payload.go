@@ -19,14 +19,14 @@ func MarshalDomainEventPayload(ev domain.DomainEvent) (string, error) {
 	switch e := ev.(type) {
 	case *domain.ProductCreatedEvent:
 		payload := map[string]interface{}{
-			"product_id": e.ProductID,
-			"name":       e.Name,
-			"category":   e.Category,
-			"base_price": map[string]interface{}{
-				"numerator":   e.BasePrice.Numerator(),
-				"denominator": e.BasePrice.Denominator(),
-			},
-			"created_at": e.CreatedAt,
+			"product_id":   e.ProductID,
+			"name":         e.Name,
+			"category":     e.Category,
+			"category_id":  e.CategoryID,
+			"tax_category": e.TaxCategory.String(),
+			"slug":         e.Slug,
+			"base_price":   moneyPayload(e.BasePrice),
+			"created_at":   e.CreatedAt,
 		}
 		b, err := json.Marshal(payload)
 		return string(b), err
@@ -38,6 +38,9 @@ func MarshalDomainEventPayload(ev domain.DomainEvent) (string, error) {
 			"updated_at":  e.UpdatedAt,
 			"occurred_at": e.OccurredAt(),
 		}
+		if e.Locale != "" {
+			payload["locale"] = e.Locale.String()
+		}
 		b, err := json.Marshal(payload)
 		return string(b), err
 
@@ -58,6 +61,12 @@ func MarshalDomainEventPayload(ev domain.DomainEvent) (string, error) {
 			"deactivated_at": e.DeactivatedAt,
 			"occurred_at":    e.OccurredAt(),
 		}
+		if e.Reason != "" {
+			payload["reason"] = e.Reason
+		}
+		if e.ComponentProductID != "" {
+			payload["component_product_id"] = e.ComponentProductID
+		}
 		b, err := json.Marshal(payload)
 		return string(b), err
 
@@ -77,6 +86,7 @@ func MarshalDomainEventPayload(ev domain.DomainEvent) (string, error) {
 			"discount_start_date": e.DiscountStartDate,
 			"discount_end_date":   e.DiscountEndDate,
 			"applied_at":          e.AppliedAt,
+			"margin_override":     e.MarginOverride,
 			"occurred_at":         e.OccurredAt(),
 		}
 		b, err := json.Marshal(payload)
@@ -91,22 +101,367 @@ func MarshalDomainEventPayload(ev domain.DomainEvent) (string, error) {
 		b, err := json.Marshal(payload)
 		return string(b), err
 
+	case *domain.ProductChannelActivatedEvent:
+		payload := map[string]interface{}{
+			"product_id":   e.ProductID,
+			"channel":      e.Channel,
+			"activated_at": e.ActivatedAt,
+			"occurred_at":  e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.ProductChannelDeactivatedEvent:
+		payload := map[string]interface{}{
+			"product_id":     e.ProductID,
+			"channel":        e.Channel,
+			"deactivated_at": e.DeactivatedAt,
+			"occurred_at":    e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
 	case *domain.PriceChangedEvent:
 		payload := map[string]interface{}{
-			"product_id": e.ProductID,
-			"old_price": map[string]interface{}{
-				"numerator":   e.OldPrice.Numerator(),
-				"denominator": e.OldPrice.Denominator(),
-			},
-			"new_price": map[string]interface{}{
-				"numerator":   e.NewPrice.Numerator(),
-				"denominator": e.NewPrice.Denominator(),
-			},
-			"changed_at":  e.ChangedAt,
+			"product_id":      e.ProductID,
+			"old_price":       moneyPayload(e.OldPrice),
+			"new_price":       moneyPayload(e.NewPrice),
+			"changed_at":      e.ChangedAt,
+			"margin_override": e.MarginOverride,
+			"occurred_at":     e.OccurredAt(),
+		}
+		if e.ScheduleID != "" {
+			payload["schedule_id"] = e.ScheduleID
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.ChannelPriceChangedEvent:
+		payload := map[string]interface{}{
+			"product_id":      e.ProductID,
+			"channel":         e.Channel,
+			"old_price":       moneyPayload(e.OldPrice),
+			"new_price":       moneyPayload(e.NewPrice),
+			"changed_at":      e.ChangedAt,
+			"margin_override": e.MarginOverride,
+			"occurred_at":     e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.CurrencyPriceRemovedEvent:
+		payload := map[string]interface{}{
+			"product_id":  e.ProductID,
+			"old_price":   moneyPayload(e.OldPrice),
+			"removed_at":  e.RemovedAt,
 			"occurred_at": e.OccurredAt(),
 		}
 		b, err := json.Marshal(payload)
 		return string(b), err
+
+	case *domain.PriceListCreatedEvent:
+		payload := map[string]interface{}{
+			"price_list_id": e.PriceListID,
+			"segment":       e.Segment,
+			"name":          e.Name,
+			"created_at":    e.CreatedAt,
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.PriceListUpdatedEvent:
+		payload := map[string]interface{}{
+			"price_list_id": e.PriceListID,
+			"changes":       e.Changes,
+			"updated_at":    e.UpdatedAt,
+			"occurred_at":   e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.PriceListDeletedEvent:
+		payload := map[string]interface{}{
+			"price_list_id": e.PriceListID,
+			"segment":       e.Segment,
+			"deleted_at":    e.DeletedAt,
+			"occurred_at":   e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.PriceListEntrySetEvent:
+		payload := map[string]interface{}{
+			"price_list_id": e.PriceListID,
+			"segment":       e.Segment,
+			"product_id":    e.ProductID,
+			"set_at":        e.SetAt,
+			"occurred_at":   e.OccurredAt(),
+		}
+		if e.Override != nil {
+			payload["override_price"] = moneyPayload(e.Override)
+		}
+		if e.Adjustment != nil {
+			payload["adjustment"] = e.Adjustment.FloatString(9)
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.PriceListEntryRemovedEvent:
+		payload := map[string]interface{}{
+			"price_list_id": e.PriceListID,
+			"segment":       e.Segment,
+			"product_id":    e.ProductID,
+			"removed_at":    e.RemovedAt,
+			"occurred_at":   e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.ExchangeRateSetEvent:
+		payload := map[string]interface{}{
+			"from_currency": e.From.String(),
+			"to_currency":   e.To.String(),
+			"rate":          e.Rate.FloatString(9),
+			"effective_at":  e.EffectiveAt,
+			"set_at":        e.SetAt,
+			"occurred_at":   e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.PriceChangeScheduledEvent:
+		payload := map[string]interface{}{
+			"product_id":      e.ProductID,
+			"schedule_id":     e.ScheduleID,
+			"new_price":       moneyPayload(e.NewPrice),
+			"effective_at":    e.EffectiveAt,
+			"scheduled_at":    e.ScheduledAt,
+			"margin_override": e.MarginOverride,
+			"occurred_at":     e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.ScheduledPriceChangeCancelledEvent:
+		payload := map[string]interface{}{
+			"product_id":   e.ProductID,
+			"schedule_id":  e.ScheduleID,
+			"cancelled_at": e.CancelledAt,
+			"occurred_at":  e.OccurredAt(),
+		}
+		if e.Reason != "" {
+			payload["reason"] = e.Reason
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.PriceChangeRequestedEvent:
+		payload := map[string]interface{}{
+			"request_id":   e.RequestID,
+			"product_id":   e.ProductID,
+			"kind":         string(e.Kind),
+			"requested_by": e.RequestedBy,
+			"requested_at": e.RequestedAt,
+			"occurred_at":  e.OccurredAt(),
+		}
+		if e.VariantID != "" {
+			payload["variant_id"] = e.VariantID
+		}
+		if e.Channel != "" {
+			payload["channel"] = e.Channel
+		}
+		if e.NewPrice != nil {
+			payload["old_price"] = moneyPayload(e.OldPrice)
+			payload["new_price"] = moneyPayload(e.NewPrice)
+		}
+		if e.Discount != nil {
+			payload["discount_percent"] = e.Discount.PercentageString()
+			payload["discount_start_date"] = e.Discount.StartDate()
+			payload["discount_end_date"] = e.Discount.EndDate()
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.PriceChangeApprovedEvent:
+		payload := map[string]interface{}{
+			"request_id":  e.RequestID,
+			"product_id":  e.ProductID,
+			"approved_by": e.ApprovedBy,
+			"approved_at": e.ApprovedAt,
+			"occurred_at": e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.PriceChangeRejectedEvent:
+		payload := map[string]interface{}{
+			"request_id":  e.RequestID,
+			"product_id":  e.ProductID,
+			"rejected_by": e.RejectedBy,
+			"reason":      e.Reason,
+			"rejected_at": e.RejectedAt,
+			"occurred_at": e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.VariantAddedEvent:
+		payload := map[string]interface{}{
+			"product_id":      e.ProductID,
+			"variant_id":      e.VariantID,
+			"sku":             e.SKU,
+			"options":         map[string]string(e.Options),
+			"price":           moneyPayload(e.Price),
+			"added_at":        e.AddedAt,
+			"margin_override": e.MarginOverride,
+			"occurred_at":     e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.VariantUpdatedEvent:
+		changes := make(map[string]interface{}, len(e.Changes))
+		for field, value := range e.Changes {
+			switch v := value.(type) {
+			case *domain.Money:
+				changes[field] = moneyPayload(v)
+			case domain.VariantOptions:
+				changes[field] = map[string]string(v)
+			default:
+				changes[field] = v
+			}
+		}
+		payload := map[string]interface{}{
+			"product_id":      e.ProductID,
+			"variant_id":      e.VariantID,
+			"changes":         changes,
+			"updated_at":      e.UpdatedAt,
+			"margin_override": e.MarginOverride,
+			"occurred_at":     e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.VariantRemovedEvent:
+		payload := map[string]interface{}{
+			"product_id":  e.ProductID,
+			"variant_id":  e.VariantID,
+			"sku":         e.SKU,
+			"removed_at":  e.RemovedAt,
+			"occurred_at": e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.AttributeDefinedEvent:
+		payload := map[string]interface{}{
+			"category":    e.Category,
+			"name":        e.Name,
+			"type":        string(e.Type),
+			"required":    e.Required,
+			"enum_values": e.EnumValues,
+			"unit":        e.Unit,
+			"defined_at":  e.DefinedAt,
+			"occurred_at": e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.AttributeRemovedEvent:
+		payload := map[string]interface{}{
+			"category":    e.Category,
+			"name":        e.Name,
+			"removed_at":  e.RemovedAt,
+			"occurred_at": e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.CategoryCreatedEvent:
+		payload := map[string]interface{}{
+			"category_id": e.CategoryID,
+			"parent_id":   e.ParentID,
+			"name":        e.Name,
+			"slug":        e.Slug,
+			"created_at":  e.CreatedAt,
+			"occurred_at": e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.CategoryUpdatedEvent:
+		payload := map[string]interface{}{
+			"category_id": e.CategoryID,
+			"changes":     e.Changes,
+			"updated_at":  e.UpdatedAt,
+			"occurred_at": e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.CategoryMovedEvent:
+		payload := map[string]interface{}{
+			"category_id":   e.CategoryID,
+			"old_parent_id": e.OldParentID,
+			"new_parent_id": e.NewParentID,
+			"moved_at":      e.MovedAt,
+			"occurred_at":   e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.CategoryDeletedEvent:
+		payload := map[string]interface{}{
+			"category_id": e.CategoryID,
+			"slug":        e.Slug,
+			"deleted_at":  e.DeletedAt,
+			"occurred_at": e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.TaxRateSetEvent:
+		payload := map[string]interface{}{
+			"region":       e.Region.String(),
+			"tax_category": e.Category.String(),
+			"rate":         e.Rate,
+			"effective_at": e.EffectiveAt,
+			"set_at":       e.SetAt,
+			"occurred_at":  e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.StockLevelChangedEvent:
+		payload := map[string]interface{}{
+			"product_id":          e.ProductID,
+			"location_id":         e.LocationID,
+			"reason":              string(e.Reason),
+			"on_hand_delta":       e.OnHandDelta,
+			"on_hand":             e.OnHand,
+			"reserved":            e.Reserved,
+			"available":           e.Available,
+			"low_stock_threshold": e.LowStockThreshold,
+			"changed_at":          e.ChangedAt,
+			"occurred_at":         e.OccurredAt(),
+		}
+		if e.ReservationID != "" {
+			payload["reservation_id"] = e.ReservationID
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
+
+	case *domain.LowStockEvent:
+		payload := map[string]interface{}{
+			"product_id":          e.ProductID,
+			"location_id":         e.LocationID,
+			"available":           e.Available,
+			"low_stock_threshold": e.LowStockThreshold,
+			"detected_at":         e.DetectedAt,
+			"occurred_at":         e.OccurredAt(),
+		}
+		b, err := json.Marshal(payload)
+		return string(b), err
 	}
 
 	// Fallback: try to marshal the event directly.
@@ -116,3 +471,18 @@ func MarshalDomainEventPayload(ev domain.DomainEvent) (string, error) {
 	}
 	return string(b), nil
 }
+
+// moneyPayload flattens Money into numerator/denominator/currency; nil stays nil.
+// The parts are emitted as arbitrary-precision JSON numbers so large rationals
+// are never truncated to int64.
+func moneyPayload(m *domain.Money) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	r := m.Rat()
+	return map[string]interface{}{
+		"numerator":   json.Number(r.Num().String()),
+		"denominator": json.Number(r.Denom().String()),
+		"currency":    m.Currency().String(),
+	}
+}
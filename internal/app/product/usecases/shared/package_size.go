@@ -0,0 +1,19 @@
+package shared
+
+import (
+	"math/big"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// NewPackageSize builds a package size from request fields; both must be set.
+func NewPackageSize(quantity *big.Rat, unit string) (*domain.PackageSize, error) {
+	if quantity == nil {
+		return nil, domain.ErrInvalidPackageSize
+	}
+	u, err := domain.ParseUnitOfMeasure(unit)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewPackageSize(quantity, u)
+}
@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// SubmitPriceChangeRequest stores a price change held back for approval, instead
+// of the change itself, together with its outbox events. It returns the request id.
+func SubmitPriceChangeRequest(ctx context.Context, requests contracts.PriceChangeRequestRepo, outbox contracts.OutboxRepo,
+	committer contracts.Committer, request *domain.PriceChangeRequest, now time.Time) (string, error) {
+	plan := commitplan.NewPlan()
+	plan.Add(requests.InsertMut(request))
+
+	for _, ev := range request.DomainEvents() {
+		payload, err := MarshalDomainEventPayload(ev)
+		if err != nil {
+			return "", err
+		}
+		plan.Add(outbox.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	if err := committer.Apply(ctx, plan); err != nil {
+		return "", err
+	}
+	return request.ID(), nil
+}
@@ -0,0 +1,386 @@
+package shared
+
+import (
+	"context"
+	"math/big"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+)
+
+// DiscountFromDTO rebuilds the product's stored discount, or nil if it has none
+// or the stored columns are incomplete.
+func DiscountFromDTO(in *dto.ProductDTO) (*domain.Discount, error) {
+	if in.DiscountPct == nil || in.DiscountStart == nil || in.DiscountEnd == nil {
+		return nil, nil
+	}
+	pct := new(big.Rat)
+	if _, ok := pct.SetString(*in.DiscountPct); !ok {
+		return nil, nil
+	}
+	// If the stored value is > 1, treat it as percent (e.g. 25 => 0.25)
+	if pct.Cmp(big.NewRat(1, 1)) == 1 {
+		pct = new(big.Rat).Quo(pct, big.NewRat(100, 1))
+	}
+	start := utils.ParseTimePtr(in.DiscountStart)
+	end := utils.ParseTimePtr(in.DiscountEnd)
+	if start == nil || end == nil {
+		return nil, nil
+	}
+	return domain.NewDiscountFromRat(pct, *start, *end)
+}
+
+// CostPriceFromDTO rebuilds the product's cost price, or nil if it has none.
+func CostPriceFromDTO(in *dto.ProductDTO) (*domain.Money, error) {
+	if in.CostPrice == nil {
+		return nil, nil
+	}
+	return domain.NewMoneyFromDecimalIn(domain.Currency(in.Currency), *in.CostPrice)
+}
+
+// CurrencyPricesFromDTO rebuilds the product's prices in additional currencies.
+func CurrencyPricesFromDTO(in *dto.ProductDTO) ([]*domain.Money, error) {
+	prices := make([]*domain.Money, 0, len(in.CurrencyPrices))
+	for _, p := range in.CurrencyPrices {
+		price, err := domain.NewMoneyFromDecimalIn(domain.Currency(p.Currency), p.Price)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+	return prices, nil
+}
+
+// PackageSizeFromDTO rebuilds the product's package size, or nil if it has none.
+func PackageSizeFromDTO(in *dto.ProductDTO) (*domain.PackageSize, error) {
+	if in.PackageQuantity == nil || in.UnitOfMeasure == nil {
+		return nil, nil
+	}
+	quantity, ok := new(big.Rat).SetString(*in.PackageQuantity)
+	if !ok {
+		return nil, domain.ErrInvalidPackageSize
+	}
+	return domain.NewPackageSize(quantity, domain.UnitOfMeasure(*in.UnitOfMeasure))
+}
+
+// ScheduledPriceChangesFromDTO rebuilds the product's scheduled price changes.
+func ScheduledPriceChangesFromDTO(in *dto.ProductDTO) ([]*domain.ScheduledPriceChange, error) {
+	out := make([]*domain.ScheduledPriceChange, 0, len(in.ScheduledPriceChanges))
+	for _, s := range in.ScheduledPriceChanges {
+		price, err := domain.NewMoneyFromDecimalIn(domain.Currency(s.Currency), s.Price)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, domain.ReconstructScheduledPriceChange(s.ScheduleID, price,
+			utils.TimeOrZero(utils.ParseTimePtr(&s.EffectiveAt)), domain.ScheduledPriceStatus(s.Status), s.MarginOverride,
+			utils.TimeOrZero(utils.ParseTimePtr(&s.CreatedAt)), utils.TimeOrZero(utils.ParseTimePtr(&s.UpdatedAt))))
+	}
+	return out, nil
+}
+
+// PriceCurrency parses the currency a request quotes a price in; empty means the
+// product's primary currency.
+func PriceCurrency(code string, p *domain.Product) (domain.Currency, error) {
+	if code == "" {
+		return p.Currency(), nil
+	}
+	return domain.ParseCurrency(code)
+}
+
+// VariantsFromDTO rebuilds the product's variants.
+func VariantsFromDTO(in *dto.ProductDTO) ([]*domain.Variant, error) {
+	out := make([]*domain.Variant, 0, len(in.Variants))
+	for _, v := range in.Variants {
+		var price *domain.Money
+		if v.Price != nil {
+			p, err := domain.NewMoneyFromDecimalIn(domain.Currency(in.Currency), *v.Price)
+			if err != nil {
+				return nil, err
+			}
+			price = p
+		}
+		out = append(out, domain.ReconstructVariant(v.VariantID, v.SKU, domain.VariantOptions(v.Options), price,
+			domain.VariantStatus(v.Status), utils.TimeOrZero(utils.ParseTimePtr(&v.CreatedAt)),
+			utils.TimeOrZero(utils.ParseTimePtr(&v.UpdatedAt))))
+	}
+	return out, nil
+}
+
+// MediaFromDTO rebuilds the product's media entries.
+func MediaFromDTO(in *dto.ProductDTO) []*domain.Media {
+	out := make([]*domain.Media, 0, len(in.Media))
+	for _, m := range in.Media {
+		altTexts := make(map[domain.Locale]string, len(m.AltTexts))
+		for l, t := range m.AltTexts {
+			altTexts[domain.Locale(l)] = t
+		}
+		out = append(out, domain.ReconstructMedia(m.MediaID, m.URL, domain.MediaKind(m.Kind), altTexts, int(m.SortOrder),
+			m.Primary, utils.TimeOrZero(utils.ParseTimePtr(&m.CreatedAt)), utils.TimeOrZero(utils.ParseTimePtr(&m.UpdatedAt))))
+	}
+	return out
+}
+
+// RelationsFromDTO rebuilds the product's links to related products.
+func RelationsFromDTO(in *dto.ProductDTO) []*domain.ProductRelation {
+	out := make([]*domain.ProductRelation, 0, len(in.Relations))
+	for _, r := range in.Relations {
+		out = append(out, domain.ReconstructProductRelation(domain.RelationType(r.Type), r.ProductID,
+			utils.TimeOrZero(utils.ParseTimePtr(&r.CreatedAt))))
+	}
+	return out
+}
+
+// ChannelsFromDTO rebuilds the product's sales channel assignments; price overrides
+// are in the product's primary currency.
+func ChannelsFromDTO(in *dto.ProductDTO) ([]*domain.ChannelAssignment, error) {
+	out := make([]*domain.ChannelAssignment, 0, len(in.Channels))
+	for _, c := range in.Channels {
+		var price *domain.Money
+		if c.PriceOverride != nil {
+			p, err := domain.NewMoneyFromDecimalIn(domain.Currency(in.Currency), *c.PriceOverride)
+			if err != nil {
+				return nil, err
+			}
+			price = p
+		}
+		out = append(out, domain.ReconstructChannelAssignment(c.Channel, c.Active, price,
+			utils.TimeOrZero(utils.ParseTimePtr(&c.UpdatedAt))))
+	}
+	return out, nil
+}
+
+// AvailabilityFromDTO returns the option restoring the product's availability window.
+func AvailabilityFromDTO(in *dto.ProductDTO) domain.ReconstructOption {
+	return domain.WithAvailability(utils.ParseTimePtr(in.AvailableFrom), utils.ParseTimePtr(in.AvailableUntil))
+}
+
+// RegionsFromDTO returns the option restoring the product's allowed and blocked regions.
+func RegionsFromDTO(in *dto.ProductDTO) domain.ReconstructOption {
+	allowed := make([]domain.Region, 0, len(in.AllowedRegions))
+	for _, r := range in.AllowedRegions {
+		allowed = append(allowed, domain.Region(r))
+	}
+	blocked := make([]domain.Region, 0, len(in.BlockedRegions))
+	for _, r := range in.BlockedRegions {
+		blocked = append(blocked, domain.Region(r))
+	}
+	return domain.WithRegions(allowed, blocked)
+}
+
+// BundleFromDTO returns the option restoring a bundle's pricing and components; it
+// leaves simple products as they are.
+func BundleFromDTO(in *dto.ProductDTO) (domain.ReconstructOption, error) {
+	if in.ProductType != string(domain.ProductTypeBundle) {
+		return func(*domain.Product) {}, nil
+	}
+	pricing := ""
+	if in.BundlePricing != nil {
+		pricing = *in.BundlePricing
+	}
+	var discount *big.Rat
+	if in.BundleDiscount != nil {
+		d, ok := new(big.Rat).SetString(*in.BundleDiscount)
+		if !ok {
+			return nil, domain.ErrInvalidBundleDiscount
+		}
+		discount = d
+	}
+	components := make([]*domain.BundleComponent, 0, len(in.BundleComponents))
+	for _, c := range in.BundleComponents {
+		components = append(components, domain.ReconstructBundleComponent(c.ProductID, c.Quantity))
+	}
+	return domain.WithBundle(domain.BundlePricingMode(pricing), discount, components...), nil
+}
+
+// PricedProductFromDTO rebuilds the product with everything price and discount
+// changes are checked against: its discount, cost price, prices in other
+// currencies, variants, scheduled price changes and bundle pricing. Changes made
+// now and changes approved later thus pass the same checks.
+func PricedProductFromDTO(in *dto.ProductDTO) (*domain.Product, error) {
+	description := ""
+	if in.Description != nil {
+		description = *in.Description
+	}
+
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(in.Currency), in.BasePrice)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := CurrencyPricesFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := DiscountFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := CostPriceFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	// Variants with their own price are margin-checked against the discount too.
+	variants, err := VariantsFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	// Channels with their own price too.
+	channels, err := ChannelsFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	scheduled, err := ScheduledPriceChangesFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	// components_sum bundles take their price from their components.
+	bundle, err := BundleFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructProduct(
+		in.ProductID,
+		in.Name,
+		description,
+		in.Category,
+		base,
+		discount,
+		domain.ProductStatus(in.Status),
+		utils.TimeOrZero(utils.ParseTimePtr(in.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(in.UpdatedAt)),
+		utils.ParseTimePtr(in.ArchivedAt),
+		domain.WithCurrencyPrices(prices...),
+		domain.WithCostPrice(cost),
+		domain.WithVariants(variants...),
+		domain.WithChannels(channels...),
+		domain.WithScheduledPriceChanges(scheduled...),
+		bundle,
+	), nil
+}
+
+// PriceChangeRequestFromDTO rebuilds a price change request aggregate.
+func PriceChangeRequestFromDTO(in *dto.PriceChangeRequestDTO) (*domain.PriceChangeRequest, error) {
+	var oldPrice, newPrice *domain.Money
+	if in.Currency != nil && in.OldPrice != nil && in.NewPrice != nil {
+		var err error
+		if oldPrice, err = domain.NewMoneyFromDecimalIn(domain.Currency(*in.Currency), *in.OldPrice); err != nil {
+			return nil, err
+		}
+		if newPrice, err = domain.NewMoneyFromDecimalIn(domain.Currency(*in.Currency), *in.NewPrice); err != nil {
+			return nil, err
+		}
+	}
+
+	var discount *domain.Discount
+	if in.DiscountPct != nil && in.DiscountStart != nil && in.DiscountEnd != nil {
+		pct, ok := new(big.Rat).SetString(*in.DiscountPct)
+		if !ok {
+			return nil, domain.ErrInvalidDiscountPercentage
+		}
+		d, err := domain.NewDiscountFromRat(pct, utils.TimeOrZero(utils.ParseTimePtr(in.DiscountStart)), utils.TimeOrZero(utils.ParseTimePtr(in.DiscountEnd)))
+		if err != nil {
+			return nil, err
+		}
+		discount = d
+	}
+
+	var variantID, channel string
+	if in.VariantID != nil {
+		variantID = *in.VariantID
+	}
+	if in.Channel != nil {
+		channel = *in.Channel
+	}
+
+	return domain.ReconstructPriceChangeRequest(in.RequestID, in.ProductID, domain.PriceChangeKind(in.Kind),
+		variantID, channel, oldPrice, newPrice, discount, in.OverrideMargin, in.RequestedBy, domain.Role(in.RequestedRole),
+		domain.PriceChangeRequestStatus(in.Status), in.DecidedBy, in.Reason,
+		utils.TimeOrZero(utils.ParseTimePtr(&in.CreatedAt)), utils.ParseTimePtr(in.DecidedAt)), nil
+}
+
+// AttributesFromDTO rebuilds the product's custom attribute values.
+func AttributesFromDTO(in *dto.ProductDTO) (map[string]domain.AttributeValue, error) {
+	out := make(map[string]domain.AttributeValue, len(in.Attributes))
+	for _, a := range in.Attributes {
+		var number *big.Rat
+		if a.Number != nil {
+			n, ok := new(big.Rat).SetString(*a.Number)
+			if !ok {
+				return nil, domain.ErrInvalidAttributeValue
+			}
+			number = n
+		}
+		out[a.Name] = domain.ReconstructAttributeValue(a.Value, number)
+	}
+	return out, nil
+}
+
+// TranslationsFromDTO rebuilds the product's translations for domain.WithLocalization.
+func TranslationsFromDTO(in *dto.ProductDTO) (map[domain.Locale]domain.Translation, error) {
+	out := make(map[domain.Locale]domain.Translation, len(in.Translations))
+	for _, t := range in.Translations {
+		description := ""
+		if t.Description != nil {
+			description = *t.Description
+		}
+		tr, err := domain.NewTranslation(t.Name, description)
+		if err != nil {
+			return nil, err
+		}
+		out[domain.Locale(t.Locale)] = tr
+	}
+	return out, nil
+}
+
+// AttributeSchemaFromDTO rebuilds a category's attribute schema from its definitions.
+func AttributeSchemaFromDTO(category string, in []*dto.AttributeDefinitionDTO) *domain.AttributeSchema {
+	defs := make([]*domain.AttributeDefinition, 0, len(in))
+	for _, d := range in {
+		defs = append(defs, domain.ReconstructAttributeDefinition(d.Name, domain.AttributeType(d.Type), d.Required,
+			d.EnumValues, d.Unit, utils.TimeOrZero(utils.ParseTimePtr(&d.CreatedAt)),
+			utils.TimeOrZero(utils.ParseTimePtr(&d.UpdatedAt))))
+	}
+	return domain.ReconstructAttributeSchema(category, defs...)
+}
+
+// LoadAttributeSchema reads the attribute schema of a category.
+func LoadAttributeSchema(ctx context.Context, schemas contracts.AttributeSchemaReadModel, category string) (*domain.AttributeSchema, error) {
+	defs, err := schemas.ListAttributeDefinitions(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	return AttributeSchemaFromDTO(category, defs), nil
+}
+
+// CategoryFromDTO rebuilds a category from its read model representation.
+func CategoryFromDTO(in *dto.CategoryDTO) *domain.Category {
+	parentID := ""
+	if in.ParentID != nil {
+		parentID = *in.ParentID
+	}
+	return domain.ReconstructCategory(in.CategoryID, parentID, in.Name, in.Slug, in.Path,
+		utils.TimeOrZero(utils.ParseTimePtr(&in.CreatedAt)), utils.TimeOrZero(utils.ParseTimePtr(&in.UpdatedAt)))
+}
+
+// ResolveCategory loads the category a request refers to, by ID when categoryID is
+// set and otherwise by slug (or a name that slugifies to an existing slug).
+func ResolveCategory(ctx context.Context, categories contracts.CategoryReadModel, categoryID, slug string) (*domain.Category, error) {
+	var (
+		in  *dto.CategoryDTO
+		err error
+	)
+	switch {
+	case categoryID != "":
+		in, err = categories.GetCategory(ctx, categoryID)
+	case domain.Slugify(slug) != "":
+		in, err = categories.GetCategoryBySlug(ctx, slug)
+	default:
+		return nil, domain.ErrEmptyProductCategory
+	}
+	if err != nil {
+		return nil, err
+	}
+	return CategoryFromDTO(in), nil
+}
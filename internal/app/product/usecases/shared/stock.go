@@ -0,0 +1,48 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+)
+
+// MaxStockAttempts bounds how often a stock command runs when its stock level keeps
+// changing under it.
+const MaxStockAttempts = 5
+
+// RetryStockConflicts runs attempt, which must load, change and commit a stock level,
+// again while it fails with domain.ErrStockConflict, at most MaxStockAttempts times.
+func RetryStockConflicts(ctx context.Context, attempt func(ctx context.Context) error) error {
+	var err error
+	for i := 0; i < MaxStockAttempts; i++ {
+		if err = attempt(ctx); !errors.Is(err, domain.ErrStockConflict) {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+	}
+	return err
+}
+
+// LoadStockLevel reconstructs the product's stock level at the location, or starts an
+// empty one when the product has no stock there yet.
+func LoadStockLevel(ctx context.Context, readModel contracts.StockReadModel, productID, locationID string, now time.Time) (*domain.StockLevel, error) {
+	locationID, err := domain.NormalizeLocationID(locationID)
+	if err != nil {
+		return nil, err
+	}
+	in, err := readModel.GetStockLevel(ctx, productID, locationID)
+	if errors.Is(err, domain.ErrStockLevelNotFound) {
+		return domain.NewStockLevel(productID, locationID, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructStockLevel(in.ProductID, in.LocationID, in.OnHand, in.Reserved, in.LowStockThreshold,
+		in.Version, utils.TimeOrZero(utils.ParseTimePtr(&in.UpdatedAt))), nil
+}
@@ -0,0 +1,71 @@
+package update_category
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request renames a category; its slug stays the same.
+type Request struct {
+	CategoryID string
+	Name       string
+}
+
+type Interactor struct {
+	CategoryRepo contracts.CategoryRepo
+	OutboxRepo   contracts.OutboxRepo
+	Committer    contracts.Committer
+	ReadModel    contracts.CategoryReadModel
+	Clock        clock.Clock
+}
+
+func NewInteractor(repo contracts.CategoryRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.CategoryReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{CategoryRepo: repo, OutboxRepo: outboxRepo, Committer: committer, ReadModel: readModel, Clock: clk}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	in, err := it.ReadModel.GetCategory(ctx, req.CategoryID)
+	if err != nil {
+		return err
+	}
+	category := shared.CategoryFromDTO(in)
+
+	// 2. Domain call
+	if err := category.Rename(req.Name, now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+	if mut := it.CategoryRepo.UpdateMut(category); mut != nil {
+		plan.Add(mut)
+	}
+
+	// 4. Outbox events
+	for _, ev := range category.DomainEvents() {
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      uuid.New().String(),
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 5. Apply
+	return it.Committer.Apply(ctx, plan)
+}
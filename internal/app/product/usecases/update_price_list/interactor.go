@@ -0,0 +1,102 @@
+package update_price_list
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the update price list request (partial updates allowed).
+type Request struct {
+	PriceListID string
+	Name        *string
+	Description *string
+}
+
+// Interactor applies partial updates using the Golden Mutation Pattern.
+type Interactor struct {
+	PriceListRepo contracts.PriceListRepo
+	OutboxRepo    contracts.OutboxRepo
+	Committer     contracts.Committer
+	ReadModel     contracts.PriceListReadModel
+	Clock         clock.Clock
+}
+
+func NewInteractor(repo contracts.PriceListRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.PriceListReadModel, clk clock.Clock) *Interactor {
+	return &Interactor{
+		PriceListRepo: repo,
+		OutboxRepo:    outboxRepo,
+		Committer:     committer,
+		ReadModel:     readModel,
+		Clock:         clk,
+	}
+}
+
+func (it *Interactor) Execute(ctx context.Context, req Request) error {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate via read model
+	dto, err := it.ReadModel.GetPriceList(ctx, req.PriceListID)
+	if err != nil {
+		return err
+	}
+
+	description := ""
+	if dto.Description != nil {
+		description = *dto.Description
+	}
+	priceList := domain.ReconstructPriceList(
+		dto.PriceListID,
+		dto.Segment,
+		dto.Name,
+		description,
+		utils.TimeOrZero(utils.ParseTimePtr(dto.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(dto.UpdatedAt)),
+	)
+
+	// 2. Domain call
+	updName := ""
+	if req.Name != nil {
+		updName = *req.Name
+	}
+	updDesc := ""
+	if req.Description != nil {
+		updDesc = *req.Description
+	}
+	if err := priceList.UpdateDetails(updName, updDesc, now); err != nil {
+		return err
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo update mutation
+	plan.Add(it.PriceListRepo.UpdateMut(priceList))
+
+	// 5. Outbox events
+	for _, ev := range priceList.DomainEvents() {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	return it.Committer.Apply(ctx, plan)
+}
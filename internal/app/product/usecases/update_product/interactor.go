@@ -2,6 +2,7 @@ package update_product
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/google/uuid"
 
@@ -18,7 +19,38 @@ type Request struct {
 	ProductID   string
 	Name        *string
 	Description *string
-	Category    *string
+	CategoryID  *string // moves the product to this category
+	Category    *string // slug (or name) of the category to move to; used when CategoryID is nil
+	TaxCategory *string
+	// DefaultLocale changes the locale of the name and description; a translation into
+	// it swaps places with them (see domain.Product.SetDefaultLocale). Name and
+	// Description are in the new default locale.
+	DefaultLocale *string
+
+	// Optional identifiers; an empty string clears them. The GTIN may be given in
+	// any of its 8-14 digit forms.
+	SKU  *string
+	GTIN *string
+
+	// Optional package size (e.g. 500 "g"); both parts must be set.
+	// ClearPackageSize removes it instead.
+	PackageQuantity  *big.Rat
+	UnitOfMeasure    *string
+	ClearPackageSize bool
+
+	// Optional cost price in the product's primary currency; both parts must be set.
+	// ClearCostPrice removes it instead.
+	CostPriceNum   *int64
+	CostPriceDen   *int64
+	ClearCostPrice bool
+	// OverrideMargin lets the cost breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+
+	// Optional custom attribute values replacing all current ones; ClearAttributes
+	// removes them instead. Changing the category revalidates the kept values.
+	Attributes      map[string]string
+	ClearAttributes bool
 }
 
 // Interactor applies partial updates using the Golden Mutation Pattern.
@@ -27,15 +59,21 @@ type Interactor struct {
 	OutboxRepo  contracts.OutboxRepo
 	Committer   contracts.Committer
 	ReadModel   contracts.ReadModel
+	Categories  contracts.CategoryReadModel
+	Schemas     contracts.AttributeSchemaReadModel
+	Margins     *domain.MarginPolicy
 	Clock       clock.Clock
 }
 
-func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, clk clock.Clock) *Interactor {
+func NewInteractor(repo contracts.ProductRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, categories contracts.CategoryReadModel, schemas contracts.AttributeSchemaReadModel, margins *domain.MarginPolicy, clk clock.Clock) *Interactor {
 	return &Interactor{
 		ProductRepo: repo,
 		OutboxRepo:  outboxRepo,
 		Committer:   committer,
 		ReadModel:   readModel,
+		Categories:  categories,
+		Schemas:     schemas,
+		Margins:     margins,
 		Clock:       clk,
 	}
 }
@@ -44,7 +82,7 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	now := it.Clock.Now()
 
 	// 1. Load aggregate via read model
-	dtoOut, err := it.ReadModel.GetProduct(ctx, req.ProductID)
+	dtoOut, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
 	if err != nil {
 		return err
 	}
@@ -58,20 +96,69 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 		description = *dtoOut.Description
 	}
 
-	base := domain.NewMoney(dtoOut.BasePriceNum, dtoOut.BasePriceDen)
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(dtoOut.Currency), dtoOut.BasePrice)
+	if err != nil {
+		return err
+	}
+	// The discount and cost price feed the margin check of a cost price change.
+	discount, err := shared.DiscountFromDTO(dtoOut)
+	if err != nil {
+		return err
+	}
+	cost, err := shared.CostPriceFromDTO(dtoOut)
+	if err != nil {
+		return err
+	}
+	size, err := shared.PackageSizeFromDTO(dtoOut)
+	if err != nil {
+		return err
+	}
+	attributes, err := shared.AttributesFromDTO(dtoOut)
+	if err != nil {
+		return err
+	}
+	// Variants keep the product's SKU out of their namespace.
+	variants, err := shared.VariantsFromDTO(dtoOut)
+	if err != nil {
+		return err
+	}
+	translations, err := shared.TranslationsFromDTO(dtoOut)
+	if err != nil {
+		return err
+	}
+
 	product := domain.ReconstructProduct(
 		dtoOut.ProductID,
 		dtoOut.Name,
 		description,
 		dtoOut.Category,
 		base,
-		nil, // discount left nil for update details (we don't need discount to update name/desc/cat)
+		discount,
 		domain.ProductStatus(dtoOut.Status),
 		utils.TimeOrZero(createdAtPtr),
 		utils.TimeOrZero(updatedAtPtr),
 		archivedAtPtr,
+		domain.WithCategoryID(dtoOut.CategoryID),
+		domain.WithCostPrice(cost),
+		domain.WithTaxCategory(domain.TaxCategory(dtoOut.TaxCategory)),
+		domain.WithPackageSize(size),
+		domain.WithAttributes(attributes),
+		domain.WithIdentifiers(shared.IdentifiersFromDTO(dtoOut)),
+		domain.WithSlug(dtoOut.Slug),
+		domain.WithLocalization(domain.Locale(dtoOut.DefaultLocale), translations),
+		domain.WithVariants(variants...),
 	)
 
+	if req.DefaultLocale != nil {
+		locale, err := domain.ParseLocale(*req.DefaultLocale)
+		if err != nil {
+			return err
+		}
+		if err := product.SetDefaultLocale(locale, now); err != nil {
+			return err
+		}
+	}
+
 	// 2. Domain method: pass provided fields or empty strings (UpdateDetails uses non-empty to decide)
 	updName := ""
 	if req.Name != nil {
@@ -81,20 +168,116 @@ func (it *Interactor) Execute(ctx context.Context, req Request) error {
 	if req.Description != nil {
 		updDesc = *req.Description
 	}
-	updCategory := ""
-	if req.Category != nil {
-		updCategory = *req.Category
+	var updCategory *domain.Category
+	if req.CategoryID != nil || req.Category != nil {
+		var id, slug string
+		if req.CategoryID != nil {
+			id = *req.CategoryID
+		} else {
+			slug = *req.Category
+		}
+		updCategory, err = shared.ResolveCategory(ctx, it.Categories, id, slug)
+		if err != nil {
+			return err
+		}
 	}
 
 	if err := product.UpdateDetails(updName, updDesc, updCategory, now); err != nil {
 		return err
 	}
 
+	if req.Attributes != nil || req.ClearAttributes || product.Changes().Dirty(domain.FieldCategory) {
+		values := product.Attributes()
+		switch {
+		case req.ClearAttributes:
+			values = nil
+		case req.Attributes != nil:
+			values = req.Attributes
+		}
+		schema, err := shared.LoadAttributeSchema(ctx, it.Schemas, product.Category())
+		if err != nil {
+			return err
+		}
+		if err := product.SetAttributes(values, schema, now); err != nil {
+			return err
+		}
+	}
+
+	if req.TaxCategory != nil {
+		if *req.TaxCategory == "" {
+			return domain.ErrInvalidTaxCategory
+		}
+		taxCategory, err := domain.ParseTaxCategory(*req.TaxCategory)
+		if err != nil {
+			return err
+		}
+		if err := product.SetTaxCategory(taxCategory, now); err != nil {
+			return err
+		}
+	}
+
+	if req.SKU != nil {
+		if err := product.SetSKU(*req.SKU, now); err != nil {
+			return err
+		}
+	}
+	if req.GTIN != nil {
+		if err := product.SetGTIN(*req.GTIN, now); err != nil {
+			return err
+		}
+	}
+	if err := shared.CheckIdentifiersUnique(ctx, it.ReadModel, product); err != nil {
+		return err
+	}
+	if err := shared.ResolveSlug(ctx, it.ReadModel, product); err != nil {
+		return err
+	}
+
+	switch {
+	case req.ClearPackageSize:
+		if err := product.SetPackageSize(nil, now); err != nil {
+			return err
+		}
+	case req.PackageQuantity != nil || req.UnitOfMeasure != nil:
+		unit := ""
+		if req.UnitOfMeasure != nil {
+			unit = *req.UnitOfMeasure
+		}
+		newSize, err := shared.NewPackageSize(req.PackageQuantity, unit)
+		if err != nil {
+			return err
+		}
+		if err := product.SetPackageSize(newSize, now); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case req.ClearCostPrice:
+		if err := product.SetCostPrice(nil, now); err != nil {
+			return err
+		}
+	case req.CostPriceNum != nil && req.CostPriceDen != nil:
+		newCost := domain.NewMoneyIn(product.Currency(), *req.CostPriceNum, *req.CostPriceDen)
+		if err := product.SetCostPrice(newCost, now, shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)...); err != nil {
+			return err
+		}
+	}
+
 	// 3. Collect mutations
 	plan := commitplan.NewPlan()
 
 	// 4. Repo update mutation
 	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, mut := range it.ProductRepo.AttributeMuts(product) {
+		plan.Add(mut)
+	}
+	for _, mut := range it.ProductRepo.TranslationMuts(product) {
+		plan.Add(mut)
+	}
+	for _, mut := range it.ProductRepo.SlugHistoryMuts(product) {
+		plan.Add(mut)
+	}
 
 	// 5. Outbox events
 	for _, ev := range product.DomainEvents() {
@@ -0,0 +1,214 @@
+package update_variant
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	shared "github.com/murkotick/product-catalog-service/internal/app/product/usecases/shared"
+	"github.com/murkotick/product-catalog-service/internal/app/product/utils"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	commitplan "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+// Request updates a variant (partial updates allowed).
+type Request struct {
+	ProductID string
+	VariantID string
+	SKU       *string
+	Options   map[string]string // replaces all option values when non-nil
+	Status    *string
+
+	// Optional own base price in the product's primary currency; both parts must be set.
+	// ClearPrice makes the variant inherit the product's base price instead.
+	PriceNum   *int64
+	PriceDen   *int64
+	Currency   string // ISO 4217 code of the price; empty means the product's primary currency
+	ClearPrice bool
+	// OverrideMargin lets the variant's price breach the margin floor; Role must allow it.
+	OverrideMargin bool
+	Role           domain.Role
+	// Actor identifies the requester of a price change held back for approval.
+	Actor string
+}
+
+type Interactor struct {
+	ProductRepo contracts.ProductRepo
+	Requests    contracts.PriceChangeRequestRepo
+	OutboxRepo  contracts.OutboxRepo
+	Committer   contracts.Committer
+	ReadModel   contracts.ReadModel
+	Margins     *domain.MarginPolicy
+	Approvals   *domain.ApprovalPolicy
+	Clock       clock.Clock
+}
+
+func NewInteractor(repo contracts.ProductRepo, requests contracts.PriceChangeRequestRepo, outboxRepo contracts.OutboxRepo, committer contracts.Committer, readModel contracts.ReadModel, margins *domain.MarginPolicy, approvals *domain.ApprovalPolicy, clk clock.Clock) *Interactor {
+	return &Interactor{
+		ProductRepo: repo,
+		Requests:    requests,
+		OutboxRepo:  outboxRepo,
+		Committer:   committer,
+		ReadModel:   readModel,
+		Margins:     margins,
+		Approvals:   approvals,
+		Clock:       clk,
+	}
+}
+
+// Execute updates the variant. A change of its price beyond the approval policy's
+// thresholds is not applied but stored as a PriceChangeRequest, whose id is returned,
+// while the rest of the update is applied; the id is empty when nothing waits for
+// approval. Clearing the price returns the variant to the already approved base price
+// and never waits for approval.
+func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+	now := it.Clock.Now()
+
+	// 1. Load aggregate
+	dto, err := it.ReadModel.GetProduct(ctx, req.ProductID, contracts.ProductReadOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	product, err := productFromDTO(dto)
+	if err != nil {
+		return "", err
+	}
+
+	// 2. Domain call
+	update := domain.VariantUpdate{
+		SKU:        req.SKU,
+		Options:    req.Options,
+		ClearPrice: req.ClearPrice,
+	}
+	if req.PriceNum != nil && req.PriceDen != nil {
+		currency, err := shared.PriceCurrency(req.Currency, product)
+		if err != nil {
+			return "", err
+		}
+		update.Price = domain.NewMoneyIn(currency, *req.PriceNum, *req.PriceDen)
+	}
+	if req.Status != nil {
+		status, err := domain.ParseVariantStatus(*req.Status)
+		if err != nil {
+			return "", err
+		}
+		update.Status = &status
+	}
+	current, _ := product.VariantPrice(req.VariantID)
+	margins := shared.MarginOptions(it.Margins, req.OverrideMargin, req.Role)
+	if err := product.UpdateVariant(req.VariantID, update, now, margins...); err != nil {
+		return "", err
+	}
+
+	// A large change of the variant's price was only validated above; it waits for
+	// approval while the rest of the update is applied to a fresh copy of the product.
+	var request *domain.PriceChangeRequest
+	if update.Price != nil && it.Approvals.PriceChangeNeedsApproval(current, update.Price) {
+		request, err = domain.NewVariantPriceChangeRequest(uuid.New().String(), product.ID(), req.VariantID, current, update.Price,
+			req.OverrideMargin, req.Actor, req.Role, now)
+		if err != nil {
+			return "", err
+		}
+		if product, err = productFromDTO(dto); err != nil {
+			return "", err
+		}
+		update.Price = nil
+		if err := product.UpdateVariant(req.VariantID, update, now, margins...); err != nil {
+			return "", err
+		}
+	}
+	if v, ok := product.Variant(req.VariantID); ok && req.SKU != nil {
+		if err := shared.CheckSKUUnused(ctx, it.ReadModel, product.ID(), v.SKU()); err != nil {
+			return "", err
+		}
+	}
+
+	// 3. Build commit plan
+	plan := commitplan.NewPlan()
+
+	// 4. Repo mutations
+	if request != nil {
+		plan.Add(it.Requests.InsertMut(request))
+	}
+	plan.Add(it.ProductRepo.UpdateMut(product))
+	for _, m := range it.ProductRepo.VariantMuts(product) {
+		plan.Add(m)
+	}
+
+	// 5. Outbox events
+	var events []domain.DomainEvent
+	if request != nil {
+		events = append(events, request.DomainEvents()...)
+	}
+	events = append(events, product.DomainEvents()...)
+	for _, ev := range events {
+		eventID := uuid.New().String()
+		payload, err := shared.MarshalDomainEventPayload(ev)
+		if err != nil {
+			return "", err
+		}
+		plan.Add(it.OutboxRepo.InsertMut(&contracts.OutboxEvent{
+			EventID:      eventID,
+			EventType:    ev.EventType(),
+			AggregateID:  ev.AggregateID(),
+			PayloadJSON:  payload,
+			Status:       "pending",
+			CreatedAtUTC: now,
+		}))
+	}
+
+	// 6. Apply plan
+	if err := it.Committer.Apply(ctx, plan); err != nil {
+		return "", err
+	}
+	if request == nil {
+		return "", nil
+	}
+	return request.ID(), nil
+}
+
+// productFromDTO rebuilds the product with what a variant update checks: the other
+// variants and the product's SKU for uniqueness, the discount and cost price for margins.
+func productFromDTO(in *dto.ProductDTO) (*domain.Product, error) {
+	description := ""
+	if in.Description != nil {
+		description = *in.Description
+	}
+
+	base, err := domain.NewMoneyFromDecimalIn(domain.Currency(in.Currency), in.BasePrice)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := shared.VariantsFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := shared.DiscountFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := shared.CostPriceFromDTO(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstructProduct(
+		in.ProductID,
+		in.Name,
+		description,
+		in.Category,
+		base,
+		discount,
+		domain.ProductStatus(in.Status),
+		utils.TimeOrZero(utils.ParseTimePtr(in.CreatedAt)),
+		utils.TimeOrZero(utils.ParseTimePtr(in.UpdatedAt)),
+		utils.ParseTimePtr(in.ArchivedAt),
+		domain.WithCostPrice(cost),
+		domain.WithIdentifiers(shared.IdentifiersFromDTO(in)),
+		domain.WithVariants(variants...),
+	), nil
+}
@@ -0,0 +1,25 @@
+package m_attribute_definition
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// UpsertMutation builds an InsertOrUpdate mutation for one attribute definition.
+// enumValues is nil for non-enum attributes; unit is nil when the attribute has none.
+func UpsertMutation(category, name, attributeType string, required bool, enumValues []string, unit *string,
+	createdAt, updatedAt time.Time) *spanner.Mutation {
+	var unitVal interface{}
+	if unit != nil {
+		unitVal = *unit
+	}
+	return spanner.InsertOrUpdate(TableName,
+		[]string{ColCategory, ColName, ColAttributeType, ColRequired, ColEnumValues, ColUnit, ColCreatedAt, ColUpdatedAt},
+		[]interface{}{category, name, attributeType, required, enumValues, unitVal, createdAt, updatedAt})
+}
+
+// DeleteMutation deletes one attribute definition.
+func DeleteMutation(category, name string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{category, name})
+}
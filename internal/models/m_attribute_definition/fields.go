@@ -0,0 +1,16 @@
+package m_attribute_definition
+
+// Field constants for the attribute_definitions table.
+// It holds the custom product attributes defined per category.
+const (
+	TableName = "attribute_definitions"
+
+	ColCategory      = "category"
+	ColName          = "name"
+	ColAttributeType = "attribute_type"
+	ColRequired      = "required"
+	ColEnumValues    = "enum_values"
+	ColUnit          = "unit"
+	ColCreatedAt     = "created_at"
+	ColUpdatedAt     = "updated_at"
+)
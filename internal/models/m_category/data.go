@@ -0,0 +1,57 @@
+package m_category
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// InsertMutation builds a spanner.Insert mutation for a category using a map of values.
+func InsertMutation(values map[string]interface{}) *spanner.Mutation {
+	cols := make([]string, 0, len(values))
+	vals := make([]interface{}, 0, len(values))
+	for col, v := range values {
+		cols = append(cols, col)
+		vals = append(vals, v)
+	}
+	return spanner.Insert(TableName, cols, vals)
+}
+
+// UpdateMutation builds a spanner.Update mutation for a category.
+// The values map should NOT include the category_id key.
+func UpdateMutation(categoryID string, values map[string]interface{}) *spanner.Mutation {
+	cols := []string{ColCategoryID}
+	vals := []interface{}{categoryID}
+
+	for col, v := range values {
+		cols = append(cols, col)
+		vals = append(vals, v)
+	}
+
+	return spanner.Update(TableName, cols, vals)
+}
+
+// DeleteMutation deletes a category.
+func DeleteMutation(categoryID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{categoryID})
+}
+
+// BuildInsertMap prepares the canonical fields for category insertion; parentID is nil for root categories.
+func BuildInsertMap(categoryID string, parentID *string, name, slug, path string, createdAt, updatedAt time.Time) map[string]interface{} {
+	m := map[string]interface{}{
+		ColCategoryID: categoryID,
+		ColName:       name,
+		ColSlug:       slug,
+		ColPath:       path,
+		ColCreatedAt:  createdAt,
+		ColUpdatedAt:  updatedAt,
+	}
+
+	if parentID != nil {
+		m[ColParentID] = *parentID
+	} else {
+		m[ColParentID] = nil
+	}
+
+	return m
+}
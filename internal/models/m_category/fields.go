@@ -0,0 +1,15 @@
+package m_category
+
+// Field constants for the categories table.
+// path holds the "/"-separated category IDs from the root down to the category.
+const (
+	TableName = "categories"
+
+	ColCategoryID = "category_id"
+	ColParentID   = "parent_id"
+	ColName       = "name"
+	ColSlug       = "slug"
+	ColPath       = "path"
+	ColCreatedAt  = "created_at"
+	ColUpdatedAt  = "updated_at"
+)
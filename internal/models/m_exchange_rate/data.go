@@ -0,0 +1,15 @@
+package m_exchange_rate
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// UpsertMutation builds an InsertOrUpdate mutation for one rate.
+// Re-importing a rate for the same pair and effective time replaces it.
+func UpsertMutation(fromCurrency, toCurrency string, effectiveAt time.Time, rate string, createdAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(TableName,
+		[]string{ColFromCurrency, ColToCurrency, ColEffectiveAt, ColRate, ColCreatedAt},
+		[]interface{}{fromCurrency, toCurrency, effectiveAt, rate, createdAt})
+}
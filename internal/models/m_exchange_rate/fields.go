@@ -0,0 +1,12 @@
+package m_exchange_rate
+
+// Field constants for the exchange_rates table.
+const (
+	TableName = "exchange_rates"
+
+	ColFromCurrency = "from_currency"
+	ColToCurrency   = "to_currency"
+	ColEffectiveAt  = "effective_at"
+	ColRate         = "rate"
+	ColCreatedAt    = "created_at"
+)
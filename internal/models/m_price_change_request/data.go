@@ -0,0 +1,35 @@
+package m_price_change_request
+
+import (
+	"cloud.google.com/go/spanner"
+)
+
+// InsertMutation builds a spanner.Insert mutation for a price change request using a map of values.
+func InsertMutation(values map[string]interface{}) *spanner.Mutation {
+	cols := make([]string, 0, len(values))
+	vals := make([]interface{}, 0, len(values))
+	for col, v := range values {
+		cols = append(cols, col)
+		vals = append(vals, v)
+	}
+	return spanner.Insert(TableName, cols, vals)
+}
+
+// Key is the primary key of a price change request.
+func Key(requestID string) spanner.Key {
+	return spanner.Key{requestID}
+}
+
+// UpdateMutation builds a spanner.Update mutation for a price change request.
+// The values map should NOT include the request_id key.
+func UpdateMutation(requestID string, values map[string]interface{}) *spanner.Mutation {
+	cols := []string{ColRequestID}
+	vals := []interface{}{requestID}
+
+	for col, v := range values {
+		cols = append(cols, col)
+		vals = append(vals, v)
+	}
+
+	return spanner.Update(TableName, cols, vals)
+}
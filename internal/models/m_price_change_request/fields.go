@@ -0,0 +1,26 @@
+package m_price_change_request
+
+// Field constants for the price_change_requests table.
+const (
+	TableName = "price_change_requests"
+
+	ColRequestID         = "request_id"
+	ColProductID         = "product_id"
+	ColKind              = "kind"
+	ColVariantID         = "variant_id"
+	ColChannel           = "channel"
+	ColCurrency          = "currency"
+	ColOldPrice          = "old_price"
+	ColNewPrice          = "new_price"
+	ColDiscountPercent   = "discount_percent"
+	ColDiscountStartDate = "discount_start_date"
+	ColDiscountEndDate   = "discount_end_date"
+	ColOverrideMargin    = "override_margin"
+	ColRequestedBy       = "requested_by"
+	ColRequestedRole     = "requested_role"
+	ColStatus            = "status"
+	ColDecidedBy         = "decided_by"
+	ColDecisionReason    = "decision_reason"
+	ColCreatedAt         = "created_at"
+	ColDecidedAt         = "decided_at"
+)
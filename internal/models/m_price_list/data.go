@@ -0,0 +1,91 @@
+package m_price_list
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// InsertMutation builds a spanner.Insert mutation for a price list using a map of values.
+func InsertMutation(values map[string]interface{}) *spanner.Mutation {
+	cols := make([]string, 0, len(values))
+	vals := make([]interface{}, 0, len(values))
+	for col, v := range values {
+		cols = append(cols, col)
+		vals = append(vals, v)
+	}
+	return spanner.Insert(TableName, cols, vals)
+}
+
+// UpdateMutation builds a spanner.Update mutation for a price list.
+// The values map should NOT include the price_list_id key.
+func UpdateMutation(priceListID string, values map[string]interface{}) *spanner.Mutation {
+	cols := []string{ColPriceListID}
+	vals := []interface{}{priceListID}
+
+	for col, v := range values {
+		cols = append(cols, col)
+		vals = append(vals, v)
+	}
+
+	return spanner.Update(TableName, cols, vals)
+}
+
+// DeleteMutation deletes a price list. Entries are removed by ON DELETE CASCADE.
+func DeleteMutation(priceListID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{priceListID})
+}
+
+// BuildInsertMap prepares the canonical fields for price list insertion.
+func BuildInsertMap(priceListID, segment, name string, description *string, createdAt, updatedAt time.Time) map[string]interface{} {
+	m := map[string]interface{}{
+		ColPriceListID: priceListID,
+		ColSegment:     segment,
+		ColName:        name,
+		ColCreatedAt:   createdAt,
+		ColUpdatedAt:   updatedAt,
+	}
+
+	if description != nil {
+		m[ColDescription] = *description
+	} else {
+		m[ColDescription] = nil
+	}
+
+	return m
+}
+
+// EntryUpsertMutation builds an InsertOrUpdate mutation for a price list entry.
+// Exactly one of the override pair or adjustmentPct is expected to be non-nil.
+func EntryUpsertMutation(priceListID, productID string, overridePrice, overrideCurrency, adjustmentPct *string, updatedAt time.Time) *spanner.Mutation {
+	values := map[string]interface{}{
+		ColEntryPriceListID:  priceListID,
+		ColEntryProductID:    productID,
+		ColOverridePrice:     nil,
+		ColOverrideCurrency:  nil,
+		ColAdjustmentPercent: nil,
+		ColEntryUpdatedAt:    updatedAt,
+	}
+	if overridePrice != nil {
+		values[ColOverridePrice] = *overridePrice
+	}
+	if overrideCurrency != nil {
+		values[ColOverrideCurrency] = *overrideCurrency
+	}
+	if adjustmentPct != nil {
+		values[ColAdjustmentPercent] = *adjustmentPct
+	}
+
+	cols := make([]string, 0, len(values))
+	vals := make([]interface{}, 0, len(values))
+	for col, v := range values {
+		cols = append(cols, col)
+		vals = append(vals, v)
+	}
+	return spanner.InsertOrUpdate(EntriesTableName, cols, vals)
+}
+
+// EntryDeleteMutation deletes a single price list entry.
+func EntryDeleteMutation(priceListID, productID string) *spanner.Mutation {
+	return spanner.Delete(EntriesTableName, spanner.Key{priceListID, productID})
+}
@@ -0,0 +1,25 @@
+package m_price_list
+
+// Field constants for the price_lists table.
+const (
+	TableName = "price_lists"
+
+	ColPriceListID = "price_list_id"
+	ColSegment     = "segment"
+	ColName        = "name"
+	ColDescription = "description"
+	ColCreatedAt   = "created_at"
+	ColUpdatedAt   = "updated_at"
+)
+
+// Field constants for the price_list_entries table (interleaved in price_lists).
+const (
+	EntriesTableName = "price_list_entries"
+
+	ColEntryPriceListID  = "price_list_id"
+	ColEntryProductID    = "product_id"
+	ColOverridePrice     = "override_price"
+	ColOverrideCurrency  = "override_currency"
+	ColAdjustmentPercent = "adjustment_percent"
+	ColEntryUpdatedAt    = "updated_at"
+)
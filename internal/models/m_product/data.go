@@ -36,20 +36,29 @@ func UpdateMutation(productID string, values map[string]interface{}) *spanner.Mu
 
 // BuildInsertMap prepares the canonical fields for insertion.
 // The caller should set created_at and updated_at (time.Time).
-func BuildInsertMap(productID, name string, description *string, category string,
-	baseNum, baseDen int64, discountPct *string,
-	discountStart, discountEnd *time.Time, status string, createdAt, updatedAt time.Time) map[string]interface{} {
+// basePrice and costPrice are exact NUMERIC decimal strings (see domain.Money.Decimal);
+// packageQuantity and unitOfMeasure are either both set or both nil.
+func BuildInsertMap(productID, name string, description *string, defaultLocale, categoryID, category, taxCategory, slug string,
+	sku, gtin *string, basePrice, currency string, costPrice, packageQuantity, unitOfMeasure, discountPct *string,
+	discountStart, discountEnd *time.Time, productType, status string, createdAt, updatedAt time.Time) map[string]interface{} {
 
 	m := map[string]interface{}{
-		ColProductID:            productID,
-		ColName:                 name,
-		ColCategory:             category,
-		ColBasePriceNumerator:   baseNum,
-		ColBasePriceDenominator: baseDen,
-		ColStatus:               status,
-		ColCreatedAt:            createdAt,
-		ColUpdatedAt:            updatedAt,
-		ColArchivedAt:           nil,
+		ColProductID:      productID,
+		ColName:           name,
+		ColDefaultLocale:  defaultLocale,
+		ColCategoryID:     categoryID,
+		ColCategory:       category,
+		ColTaxCategory:    taxCategory,
+		ColSlug:           slug,
+		ColBasePrice:      basePrice,
+		ColCurrency:       currency,
+		ColProductType:    productType,
+		ColBundlePricing:  nil,
+		ColBundleDiscount: nil,
+		ColStatus:         status,
+		ColCreatedAt:      createdAt,
+		ColUpdatedAt:      updatedAt,
+		ColArchivedAt:     nil,
 	}
 
 	if description != nil {
@@ -58,6 +67,32 @@ func BuildInsertMap(productID, name string, description *string, category string
 		m[ColDescription] = nil
 	}
 
+	if sku != nil {
+		m[ColSKU] = *sku
+	} else {
+		m[ColSKU] = nil
+	}
+
+	if gtin != nil {
+		m[ColGTIN] = *gtin
+	} else {
+		m[ColGTIN] = nil
+	}
+
+	if costPrice != nil {
+		m[ColCostPrice] = *costPrice
+	} else {
+		m[ColCostPrice] = nil
+	}
+
+	if packageQuantity != nil && unitOfMeasure != nil {
+		m[ColPackageQuantity] = *packageQuantity
+		m[ColUnitOfMeasure] = *unitOfMeasure
+	} else {
+		m[ColPackageQuantity] = nil
+		m[ColUnitOfMeasure] = nil
+	}
+
 	if discountPct != nil {
 		m[ColDiscountPercent] = *discountPct
 	} else {
@@ -87,3 +122,152 @@ func BuildUpdateMap(updatedAt time.Time) map[string]interface{} {
 		ColUpdatedAt: updatedAt,
 	}
 }
+
+// PriceUpsertMutation builds an InsertOrUpdate mutation for a product's price in one currency.
+// price is an exact NUMERIC decimal string.
+func PriceUpsertMutation(productID, currency, price string, updatedAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(PricesTableName,
+		[]string{ColPriceProductID, ColPriceCurrency, ColPriceAmount, ColPriceUpdatedAt},
+		[]interface{}{productID, currency, price, updatedAt})
+}
+
+// PriceDeleteMutation deletes a product's price in one currency.
+func PriceDeleteMutation(productID, currency string) *spanner.Mutation {
+	return spanner.Delete(PricesTableName, spanner.Key{productID, currency})
+}
+
+// ScheduledPriceUpsertMutation builds an InsertOrUpdate mutation for a scheduled price change.
+// price is an exact NUMERIC decimal string.
+func ScheduledPriceUpsertMutation(productID, scheduleID, price, currency string, effectiveAt time.Time,
+	status string, marginOverride bool, createdAt, updatedAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(ScheduledPricesTableName,
+		[]string{ColScheduleProductID, ColScheduleID, ColSchedulePrice, ColScheduleCurrency,
+			ColScheduleEffectiveAt, ColScheduleStatus, ColScheduleOverride, ColScheduleCreatedAt, ColScheduleUpdatedAt},
+		[]interface{}{productID, scheduleID, price, currency, effectiveAt, status, marginOverride, createdAt, updatedAt})
+}
+
+// VariantUpsertMutation builds an InsertOrUpdate mutation for a product variant.
+// price is an exact NUMERIC decimal string, or nil when the variant inherits the product's price.
+func VariantUpsertMutation(productID, variantID, sku string, options map[string]string, price *string,
+	status string, createdAt, updatedAt time.Time) *spanner.Mutation {
+	var priceVal interface{}
+	if price != nil {
+		priceVal = *price
+	}
+	return spanner.InsertOrUpdate(VariantsTableName,
+		[]string{ColVariantProductID, ColVariantID, ColVariantSKU, ColVariantOptions, ColVariantPrice,
+			ColVariantStatus, ColVariantCreatedAt, ColVariantUpdatedAt},
+		[]interface{}{productID, variantID, sku, spanner.NullJSON{Value: options, Valid: true}, priceVal,
+			status, createdAt, updatedAt})
+}
+
+// VariantDeleteMutation deletes a product variant.
+func VariantDeleteMutation(productID, variantID string) *spanner.Mutation {
+	return spanner.Delete(VariantsTableName, spanner.Key{productID, variantID})
+}
+
+// AttributeUpsertMutation builds an InsertOrUpdate mutation for a product attribute value.
+// numberValue is an exact NUMERIC decimal string for number attributes, otherwise nil.
+func AttributeUpsertMutation(productID, name, value string, numberValue *string, updatedAt time.Time) *spanner.Mutation {
+	var numberVal interface{}
+	if numberValue != nil {
+		numberVal = *numberValue
+	}
+	return spanner.InsertOrUpdate(AttributesTableName,
+		[]string{ColAttributeProductID, ColAttributeName, ColAttributeValue, ColAttributeNumberValue, ColAttributeUpdatedAt},
+		[]interface{}{productID, name, value, numberVal, updatedAt})
+}
+
+// AttributeDeleteMutation deletes a product attribute value.
+func AttributeDeleteMutation(productID, name string) *spanner.Mutation {
+	return spanner.Delete(AttributesTableName, spanner.Key{productID, name})
+}
+
+// TagInsertMutation builds an InsertOrUpdate mutation for a product tag.
+func TagInsertMutation(productID, tag string, createdAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(TagsTableName,
+		[]string{ColTagProductID, ColTag, ColTagCreatedAt},
+		[]interface{}{productID, tag, createdAt})
+}
+
+// TagDeleteMutation deletes a product tag.
+func TagDeleteMutation(productID, tag string) *spanner.Mutation {
+	return spanner.Delete(TagsTableName, spanner.Key{productID, tag})
+}
+
+// TranslationInsertMutation builds an InsertOrUpdate mutation for a product translation;
+// description is nil when empty.
+func TranslationInsertMutation(productID, locale, name string, description *string, updatedAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(TranslationsTableName,
+		[]string{ColTranslationProductID, ColTranslationLocale, ColTranslationName, ColTranslationDescription, ColTranslationUpdatedAt},
+		[]interface{}{productID, locale, name, description, updatedAt})
+}
+
+// TranslationDeleteMutation deletes a product translation.
+func TranslationDeleteMutation(productID, locale string) *spanner.Mutation {
+	return spanner.Delete(TranslationsTableName, spanner.Key{productID, locale})
+}
+
+// MediaUpsertMutation builds an InsertOrUpdate mutation for a product media entry.
+func MediaUpsertMutation(productID, mediaID, url, kind string, altTexts map[string]string, sortOrder int64,
+	primary bool, createdAt, updatedAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(MediaTableName,
+		[]string{ColMediaProductID, ColMediaID, ColMediaURL, ColMediaKind, ColMediaAltTexts, ColMediaSortOrder,
+			ColMediaPrimary, ColMediaCreatedAt, ColMediaUpdatedAt},
+		[]interface{}{productID, mediaID, url, kind, spanner.NullJSON{Value: altTexts, Valid: true}, sortOrder,
+			primary, createdAt, updatedAt})
+}
+
+// MediaDeleteMutation deletes a product media entry.
+func MediaDeleteMutation(productID, mediaID string) *spanner.Mutation {
+	return spanner.Delete(MediaTableName, spanner.Key{productID, mediaID})
+}
+
+// RelationInsertMutation builds an InsertOrUpdate mutation for a link to a related product.
+func RelationInsertMutation(productID, relationType, relatedProductID string, createdAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(RelationsTableName,
+		[]string{ColRelationProductID, ColRelationType, ColRelationRelatedProductID, ColRelationCreatedAt},
+		[]interface{}{productID, relationType, relatedProductID, createdAt})
+}
+
+// RelationDeleteMutation deletes a link to a related product.
+func RelationDeleteMutation(productID, relationType, relatedProductID string) *spanner.Mutation {
+	return spanner.Delete(RelationsTableName, spanner.Key{productID, relationType, relatedProductID})
+}
+
+// ChannelUpsertMutation builds an InsertOrUpdate mutation for a sales channel assignment;
+// priceOverride is an exact NUMERIC decimal string, or nil when the channel sells at the base price.
+func ChannelUpsertMutation(productID, channel string, active bool, priceOverride *string, updatedAt time.Time) *spanner.Mutation {
+	var priceVal interface{}
+	if priceOverride != nil {
+		priceVal = *priceOverride
+	}
+	return spanner.InsertOrUpdate(ChannelsTableName,
+		[]string{ColChannelProductID, ColChannel, ColChannelActive, ColChannelPriceOverride, ColChannelUpdatedAt},
+		[]interface{}{productID, channel, active, priceVal, updatedAt})
+}
+
+// BundleComponentUpsertMutation builds an InsertOrUpdate mutation for a component of a bundle.
+func BundleComponentUpsertMutation(productID, componentProductID string, quantity int64, updatedAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(BundleComponentsTableName,
+		[]string{ColBundleComponentProductID, ColBundleComponentComponentID, ColBundleComponentQuantity, ColBundleComponentUpdatedAt},
+		[]interface{}{productID, componentProductID, quantity, updatedAt})
+}
+
+// BundleComponentDeleteMutation removes a component from a bundle.
+func BundleComponentDeleteMutation(productID, componentProductID string) *spanner.Mutation {
+	return spanner.Delete(BundleComponentsTableName, spanner.Key{productID, componentProductID})
+}
+
+// SlugHistoryInsertMutation records a product's retired slug, taking it over from
+// any product that used it before.
+func SlugHistoryInsertMutation(slug, productID string, retiredAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(SlugHistoryTableName,
+		[]string{ColSlugHistorySlug, ColSlugHistoryProductID, ColSlugHistoryRetiredAt},
+		[]interface{}{slug, productID, retiredAt})
+}
+
+// SlugHistoryDeleteMutation removes a slug from the history, when its product takes it back.
+func SlugHistoryDeleteMutation(slug string) *spanner.Mutation {
+	return spanner.Delete(SlugHistoryTableName, spanner.Key{slug})
+}
@@ -4,17 +4,177 @@ package m_product
 const (
 	TableName = "products"
 
-	ColProductID            = "product_id"
-	ColName                 = "name"
-	ColDescription          = "description"
-	ColCategory             = "category"
-	ColBasePriceNumerator   = "base_price_numerator"
-	ColBasePriceDenominator = "base_price_denominator"
-	ColDiscountPercent      = "discount_percent"
-	ColDiscountStartDate    = "discount_start_date"
-	ColDiscountEndDate      = "discount_end_date"
-	ColStatus               = "status"
-	ColCreatedAt            = "created_at"
-	ColUpdatedAt            = "updated_at"
-	ColArchivedAt           = "archived_at"
+	ColProductID         = "product_id"
+	ColName              = "name"
+	ColDescription       = "description"
+	ColCategory          = "category"
+	ColCategoryID        = "category_id"
+	ColTaxCategory       = "tax_category"
+	ColSKU               = "sku"
+	ColGTIN              = "gtin"
+	ColSlug              = "slug"
+	ColDefaultLocale     = "default_locale"
+	ColBasePrice         = "base_price"
+	ColCostPrice         = "cost_price"
+	ColPackageQuantity   = "package_quantity"
+	ColUnitOfMeasure     = "unit_of_measure"
+	ColCurrency          = "currency"
+	ColDiscountPercent   = "discount_percent"
+	ColDiscountStartDate = "discount_start_date"
+	ColDiscountEndDate   = "discount_end_date"
+	ColStatus            = "status"
+	ColCreatedAt         = "created_at"
+	ColUpdatedAt         = "updated_at"
+	ColArchivedAt        = "archived_at"
+	// ColProductType is "simple" or "bundle"; bundles also set bundle_pricing and,
+	// for components_sum bundles with a discount, bundle_discount (a decimal fraction).
+	ColProductType    = "product_type"
+	ColBundlePricing  = "bundle_pricing"
+	ColBundleDiscount = "bundle_discount"
+	// ColAllowedRegions, when not empty, lists the only ISO 3166-1 alpha-2 regions
+	// the product ships to; ColBlockedRegions lists regions it never ships to.
+	ColAllowedRegions = "allowed_regions"
+	ColBlockedRegions = "blocked_regions"
+	// ColAvailableFrom and ColAvailableUntil bound when the product is on sale.
+	ColAvailableFrom  = "available_from"
+	ColAvailableUntil = "available_until"
+)
+
+// Field constants for the product_prices table (interleaved in products).
+// It holds base prices in currencies other than the product's primary currency.
+const (
+	PricesTableName = "product_prices"
+
+	ColPriceProductID = "product_id"
+	ColPriceCurrency  = "currency"
+	ColPriceAmount    = "price"
+	ColPriceUpdatedAt = "updated_at"
+)
+
+// Field constants for the scheduled_price_changes table (interleaved in products).
+// It holds base price changes planned for a later time.
+const (
+	ScheduledPricesTableName = "scheduled_price_changes"
+
+	ColScheduleProductID   = "product_id"
+	ColScheduleID          = "schedule_id"
+	ColSchedulePrice       = "price"
+	ColScheduleCurrency    = "currency"
+	ColScheduleEffectiveAt = "effective_at"
+	ColScheduleStatus      = "status"
+	ColScheduleOverride    = "margin_override"
+	ColScheduleCreatedAt   = "created_at"
+	ColScheduleUpdatedAt   = "updated_at"
+)
+
+// Field constants for the product_variants table (interleaved in products).
+// It holds the product's variants; option_values is a JSON object of option name to value.
+const (
+	VariantsTableName = "product_variants"
+
+	ColVariantProductID = "product_id"
+	ColVariantID        = "variant_id"
+	ColVariantSKU       = "sku"
+	ColVariantOptions   = "option_values"
+	ColVariantPrice     = "price"
+	ColVariantStatus    = "status"
+	ColVariantCreatedAt = "created_at"
+	ColVariantUpdatedAt = "updated_at"
+)
+
+// Field constants for the product_attributes table (interleaved in products).
+// It holds the product's custom attribute values; number_value is set for number attributes.
+const (
+	AttributesTableName = "product_attributes"
+
+	ColAttributeProductID   = "product_id"
+	ColAttributeName        = "name"
+	ColAttributeValue       = "value"
+	ColAttributeNumberValue = "number_value"
+	ColAttributeUpdatedAt   = "updated_at"
+)
+
+// Field constants for the product_tags table (interleaved in products).
+// It holds one row per product tag; idx_product_tags_tag indexes products by tag.
+const (
+	TagsTableName = "product_tags"
+
+	ColTagProductID = "product_id"
+	ColTag          = "tag"
+	ColTagCreatedAt = "created_at"
+)
+
+// Field constants for the product_translations table (interleaved in products).
+// It holds the product's name and description in locales other than its default locale.
+const (
+	TranslationsTableName = "product_translations"
+
+	ColTranslationProductID   = "product_id"
+	ColTranslationLocale      = "locale"
+	ColTranslationName        = "name"
+	ColTranslationDescription = "description"
+	ColTranslationUpdatedAt   = "updated_at"
+)
+
+// Field constants for the product_media table (interleaved in products).
+// It holds the product's media metadata; alt_texts is a JSON object of locale to text.
+const (
+	MediaTableName = "product_media"
+
+	ColMediaProductID = "product_id"
+	ColMediaID        = "media_id"
+	ColMediaURL       = "url"
+	ColMediaKind      = "kind"
+	ColMediaAltTexts  = "alt_texts"
+	ColMediaSortOrder = "sort_order"
+	ColMediaPrimary   = "is_primary"
+	ColMediaCreatedAt = "created_at"
+	ColMediaUpdatedAt = "updated_at"
+)
+
+// Field constants for the product_relations table (interleaved in products).
+// It holds the product's typed links to related products; idx_product_relations_related
+// finds the products linking to a product.
+const (
+	RelationsTableName = "product_relations"
+
+	ColRelationProductID        = "product_id"
+	ColRelationType             = "relation_type"
+	ColRelationRelatedProductID = "related_product_id"
+	ColRelationCreatedAt        = "created_at"
+)
+
+// Field constants for the product_bundle_components table (interleaved in products).
+// It holds a bundle's component products and their quantities;
+// idx_product_bundle_components_component finds the bundles containing a product.
+const (
+	BundleComponentsTableName = "product_bundle_components"
+
+	ColBundleComponentProductID   = "product_id"
+	ColBundleComponentComponentID = "component_product_id"
+	ColBundleComponentQuantity    = "quantity"
+	ColBundleComponentUpdatedAt   = "updated_at"
+)
+
+// Field constants for the product_channels table (interleaved in products).
+// It holds the product's sales channel assignments; price_override is NULL when
+// the channel sells at the base price.
+const (
+	ChannelsTableName = "product_channels"
+
+	ColChannelProductID     = "product_id"
+	ColChannel              = "channel"
+	ColChannelActive        = "active"
+	ColChannelPriceOverride = "price_override"
+	ColChannelUpdatedAt     = "updated_at"
+)
+
+// Field constants for the product_slug_history table, keyed by slug.
+// It maps the slugs products had before a rename to the product, for redirects.
+const (
+	SlugHistoryTableName = "product_slug_history"
+
+	ColSlugHistorySlug      = "slug"
+	ColSlugHistoryProductID = "product_id"
+	ColSlugHistoryRetiredAt = "retired_at"
 )
@@ -0,0 +1,31 @@
+package m_stock
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// UpsertMutation builds an InsertOrUpdate mutation writing a whole stock level.
+func UpsertMutation(productID, locationID string, onHand, reserved, lowStockThreshold, version int64, updatedAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(TableName,
+		[]string{ColProductID, ColLocationID, ColOnHand, ColReserved, ColLowStockThreshold, ColVersion, ColUpdatedAt},
+		[]interface{}{productID, locationID, onHand, reserved, lowStockThreshold, version, updatedAt})
+}
+
+// Key is the primary key of a product's stock level at a location.
+func Key(productID, locationID string) spanner.Key {
+	return spanner.Key{productID, locationID}
+}
+
+// ReservationInsertMutation builds an Insert mutation for a stock reservation.
+func ReservationInsertMutation(reservationID, productID, locationID string, quantity int64, createdAt time.Time) *spanner.Mutation {
+	return spanner.Insert(ReservationsTableName,
+		[]string{ColReservationID, ColReservationProductID, ColReservationLocation, ColQuantity, ColCreatedAt},
+		[]interface{}{reservationID, productID, locationID, quantity, createdAt})
+}
+
+// ReservationDeleteMutation deletes a stock reservation.
+func ReservationDeleteMutation(reservationID string) *spanner.Mutation {
+	return spanner.Delete(ReservationsTableName, spanner.Key{reservationID})
+}
@@ -0,0 +1,25 @@
+package m_stock
+
+// Field constants for the stock_levels table (interleaved in products).
+const (
+	TableName = "stock_levels"
+
+	ColProductID         = "product_id"
+	ColLocationID        = "location_id"
+	ColOnHand            = "on_hand"
+	ColReserved          = "reserved"
+	ColLowStockThreshold = "low_stock_threshold"
+	ColVersion           = "version"
+	ColUpdatedAt         = "updated_at"
+)
+
+// Field constants for the stock_reservations table.
+const (
+	ReservationsTableName = "stock_reservations"
+
+	ColReservationID        = "reservation_id"
+	ColReservationProductID = "product_id"
+	ColReservationLocation  = "location_id"
+	ColQuantity             = "quantity"
+	ColCreatedAt            = "created_at"
+)
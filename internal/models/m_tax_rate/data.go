@@ -0,0 +1,15 @@
+package m_tax_rate
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// UpsertMutation builds an InsertOrUpdate mutation for one rate.
+// Storing a rate for the same region, category and effective time replaces it.
+func UpsertMutation(region, taxCategory string, effectiveAt time.Time, rate string, createdAt time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdate(TableName,
+		[]string{ColRegion, ColTaxCategory, ColEffectiveAt, ColRate, ColCreatedAt},
+		[]interface{}{region, taxCategory, effectiveAt, rate, createdAt})
+}
@@ -0,0 +1,12 @@
+package m_tax_rate
+
+// Field constants for the tax_rates table.
+const (
+	TableName = "tax_rates"
+
+	ColRegion      = "region"
+	ColTaxCategory = "tax_category"
+	ColEffectiveAt = "effective_at"
+	ColRate        = "rate"
+	ColCreatedAt   = "created_at"
+)
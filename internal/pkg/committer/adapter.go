@@ -25,6 +25,11 @@ func (a *Adapter) Apply(ctx context.Context, plan *Plan) error {
 	}
 
 	_, err := a.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		for _, check := range plan.Checks() {
+			if err := check(ctx, tx); err != nil {
+				return err
+			}
+		}
 		return tx.BufferWrite(plan.Mutations())
 	})
 	return err
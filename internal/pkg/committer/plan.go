@@ -1,9 +1,19 @@
 package committer
 
-import "cloud.google.com/go/spanner"
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Check is a precondition evaluated inside the commit's read-write transaction
+// before the mutations are written. An error aborts the commit and is returned
+// by Apply. Checks may run again when Spanner retries the transaction.
+type Check func(ctx context.Context, tx *spanner.ReadWriteTransaction) error
 
 type Plan struct {
 	mutations []*spanner.Mutation
+	checks    []Check
 }
 
 func NewPlan() *Plan {
@@ -19,6 +29,14 @@ func (p *Plan) Add(m *spanner.Mutation) {
 	p.mutations = append(p.mutations, m)
 }
 
+// AddCheck adds a precondition the commit must pass.
+func (p *Plan) AddCheck(c Check) {
+	if c == nil {
+		return
+	}
+	p.checks = append(p.checks, c)
+}
+
 func (p *Plan) IsEmpty() bool {
 	return len(p.mutations) == 0
 }
@@ -26,3 +44,7 @@ func (p *Plan) IsEmpty() bool {
 func (p *Plan) Mutations() []*spanner.Mutation {
 	return p.mutations
 }
+
+func (p *Plan) Checks() []Check {
+	return p.checks
+}
@@ -0,0 +1,49 @@
+// Package migration splits migration files into statements and classifies them,
+// so cmd/migrate and the e2e schema setup treat every statement the same way.
+package migration
+
+import "strings"
+
+// Kind is how a migration statement is applied.
+type Kind int
+
+const (
+	// KindDDL is a schema change, sent to UpdateDatabaseDdl in batches.
+	KindDDL Kind = iota
+	// KindDML is a data backfill (UPDATE, INSERT or DELETE).
+	KindDML
+	// KindCheck is a data check (a SELECT): the migration stops if it returns any row.
+	KindCheck
+)
+
+// Split splits a migration file into its statements, dropping empty ones.
+func Split(sql string) []string {
+	// Normalize line endings for Windows-authored files.
+	sql = strings.ReplaceAll(sql, "\r\n", "\n")
+
+	parts := strings.Split(sql, ";")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		stmt := strings.TrimSpace(p)
+		if stmt == "" {
+			continue
+		}
+		out = append(out, stmt)
+	}
+	return out
+}
+
+// Classify reports how stmt is applied, by its leading keyword.
+func Classify(stmt string) Kind {
+	fields := strings.Fields(stmt)
+	if len(fields) == 0 {
+		return KindDDL
+	}
+	switch strings.ToUpper(fields[0]) {
+	case "UPDATE", "INSERT", "DELETE":
+		return KindDML
+	case "SELECT":
+		return KindCheck
+	}
+	return KindDDL
+}
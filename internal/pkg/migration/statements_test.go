@@ -0,0 +1,25 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	sql := "CREATE TABLE t (id STRING(36)) PRIMARY KEY (id);\r\n\r\n  UPDATE t SET id = id WHERE TRUE ;\n;"
+	assert.Equal(t, []string{
+		"CREATE TABLE t (id STRING(36)) PRIMARY KEY (id)",
+		"UPDATE t SET id = id WHERE TRUE",
+	}, Split(sql))
+}
+
+func TestClassify(t *testing.T) {
+	assert.Equal(t, KindDDL, Classify("CREATE TABLE t (id STRING(36)) PRIMARY KEY (id)"))
+	assert.Equal(t, KindDDL, Classify("ALTER TABLE t ADD COLUMN name STRING(MAX)"))
+	assert.Equal(t, KindDML, Classify("update t SET id = id WHERE TRUE"))
+	assert.Equal(t, KindDML, Classify("INSERT INTO t (id) SELECT id FROM u"))
+	assert.Equal(t, KindDML, Classify("DELETE FROM t WHERE TRUE"))
+	assert.Equal(t, KindCheck, Classify("SELECT id FROM t WHERE id IS NULL"))
+	assert.Equal(t, KindCheck, Classify("\n  select 1"))
+}
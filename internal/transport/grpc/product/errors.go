@@ -26,10 +26,25 @@ func mapError(err error) error {
 	}
 
 	// Not found
-	if errors.Is(err, domain.ErrProductNotFound) || errors.Is(err, spanner.ErrRowNotFound) {
+	if errors.Is(err, domain.ErrProductNotFound) || errors.Is(err, spanner.ErrRowNotFound) ||
+		errors.Is(err, domain.ErrPriceListNotFound) || errors.Is(err, domain.ErrScheduledPriceChangeNotFound) ||
+		errors.Is(err, domain.ErrPriceChangeRequestNotFound) || errors.Is(err, domain.ErrVariantNotFound) ||
+		errors.Is(err, domain.ErrAttributeNotFound) || errors.Is(err, domain.ErrCategoryNotFound) ||
+		errors.Is(err, domain.ErrMediaNotFound) || errors.Is(err, domain.ErrStockLevelNotFound) ||
+		errors.Is(err, domain.ErrReservationNotFound) || errors.Is(err, domain.ErrChannelNotAssigned) ||
+		errors.Is(err, domain.ErrNotActiveOnChannel) || errors.Is(err, domain.ErrNotAvailableInRegion) {
 		return status.Error(codes.NotFound, err.Error())
 	}
 
+	// Already exists (uniqueness)
+	if errors.Is(err, domain.ErrPriceListSegmentTaken) || errors.Is(err, domain.ErrDuplicateVariantSKU) ||
+		errors.Is(err, domain.ErrDuplicateVariantOptions) || errors.Is(err, domain.ErrCategorySlugTaken) ||
+		errors.Is(err, domain.ErrDuplicateSKU) || errors.Is(err, domain.ErrDuplicateGTIN) ||
+		errors.Is(err, domain.ErrProductSlugTaken) || errors.Is(err, domain.ErrDuplicateMediaURL) ||
+		spanner.ErrCode(err) == codes.AlreadyExists {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
 	// Invalid argument (validation)
 	switch {
 	case errors.Is(err, domain.ErrEmptyProductName),
@@ -40,19 +55,122 @@ func mapError(err error) error {
 		errors.Is(err, domain.ErrInvalidDiscountPercentage),
 		errors.Is(err, domain.ErrInvalidDiscountPeriod),
 		errors.Is(err, domain.ErrNegativePrice),
-		errors.Is(err, domain.ErrZeroPrice):
+		errors.Is(err, domain.ErrZeroPrice),
+		errors.Is(err, domain.ErrCurrencyMismatch),
+		errors.Is(err, domain.ErrUnsupportedCurrency),
+		errors.Is(err, domain.ErrInvalidExchangeRate),
+		errors.Is(err, domain.ErrInvalidMarginRule),
+		errors.Is(err, domain.ErrInvalidRoundingMode),
+		errors.Is(err, domain.ErrInvalidRoundingRule),
+		errors.Is(err, domain.ErrInvalidTaxCategory),
+		errors.Is(err, domain.ErrInvalidTaxRegion),
+		errors.Is(err, domain.ErrInvalidTaxRate),
+		errors.Is(err, domain.ErrUnsupportedUnit),
+		errors.Is(err, domain.ErrInvalidPackageSize),
+		errors.Is(err, domain.ErrInvalidScheduleTime),
+		errors.Is(err, domain.ErrInvalidSKU),
+		errors.Is(err, domain.ErrInvalidVariantOptions),
+		errors.Is(err, domain.ErrInvalidVariantStatus),
+		errors.Is(err, domain.ErrInvalidVariantPrice),
+		errors.Is(err, domain.ErrInvalidAttributeName),
+		errors.Is(err, domain.ErrInvalidAttributeType),
+		errors.Is(err, domain.ErrInvalidAttributeDefinition),
+		errors.Is(err, domain.ErrUnknownAttribute),
+		errors.Is(err, domain.ErrInvalidAttributeValue),
+		errors.Is(err, domain.ErrMissingRequiredAttribute),
+		errors.Is(err, domain.ErrInvalidCategorySlug),
+		errors.Is(err, domain.ErrInvalidTag),
+		errors.Is(err, domain.ErrInvalidGTIN),
+		errors.Is(err, domain.ErrInvalidLocale),
+		errors.Is(err, domain.ErrDefaultLocaleTranslation),
+		errors.Is(err, domain.ErrInvalidMediaURL),
+		errors.Is(err, domain.ErrInvalidMediaKind),
+		errors.Is(err, domain.ErrInvalidAltText),
+		errors.Is(err, domain.ErrPrimaryMediaNotImage),
+		errors.Is(err, domain.ErrInvalidMediaOrder),
+		errors.Is(err, domain.ErrInvalidRelationType),
+		errors.Is(err, domain.ErrSelfRelation),
+		errors.Is(err, domain.ErrInvalidBundlePricingMode),
+		errors.Is(err, domain.ErrEmptyBundle),
+		errors.Is(err, domain.ErrInvalidBundleComponent),
+		errors.Is(err, domain.ErrInvalidBundleQuantity),
+		errors.Is(err, domain.ErrInvalidBundleDiscount),
+		errors.Is(err, domain.ErrInvalidLocation),
+		errors.Is(err, domain.ErrInvalidStockQuantity),
+		errors.Is(err, domain.ErrInvalidChannel),
+		errors.Is(err, domain.ErrInvalidRegion),
+		errors.Is(err, domain.ErrRegionAllowedAndBlocked),
+		errors.Is(err, domain.ErrInvalidAvailabilityWindow),
+		errors.Is(err, domain.ErrInvalidApprovalRule),
+		errors.Is(err, domain.ErrDecisionReasonTooLong),
+		errors.Is(err, domain.ErrEmptyPriceListSegment),
+		errors.Is(err, domain.ErrInvalidPriceListSegment),
+		errors.Is(err, domain.ErrEmptyPriceListName),
+		errors.Is(err, domain.ErrPriceListNameTooLong),
+		errors.Is(err, domain.ErrInvalidPriceListEntry),
+		errors.Is(err, domain.ErrInvalidPriceAdjustment):
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Unauthenticated (requests and decisions need a known caller)
+	if errors.Is(err, domain.ErrRequesterRequired) || errors.Is(err, domain.ErrDeciderRequired) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// Permission denied (role-gated overrides and approvals)
+	if errors.Is(err, domain.ErrMarginOverrideNotAllowed) || errors.Is(err, domain.ErrSelfApprovalNotAllowed) ||
+		errors.Is(err, domain.ErrApprovalNotAllowed) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// Out of range (amounts that cannot be stored or returned exactly)
+	if errors.Is(err, domain.ErrMoneyOverflow) {
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+
+	// Aborted (concurrent writers kept winning the retries)
+	if errors.Is(err, domain.ErrStockConflict) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+
 	// Failed precondition (business rules / state)
 	switch {
 	case errors.Is(err, domain.ErrProductNotActive),
+		errors.Is(err, domain.ErrCategoryCycle),
+		errors.Is(err, domain.ErrCategoryTooDeep),
+		errors.Is(err, domain.ErrCategoryNotEmpty),
+		errors.Is(err, domain.ErrAttributeSchemaMismatch),
+		errors.Is(err, domain.ErrTooManyTags),
+		errors.Is(err, domain.ErrTooManyMedia),
+		errors.Is(err, domain.ErrTooManyRelations),
+		errors.Is(err, domain.ErrRelatedProductArchived),
+		errors.Is(err, domain.ErrTooManyBundleComponents),
+		errors.Is(err, domain.ErrNestedBundle),
+		errors.Is(err, domain.ErrBundleComponentArchived),
+		errors.Is(err, domain.ErrBundleCurrencyMismatch),
+		errors.Is(err, domain.ErrBundlePriceDerived),
+		errors.Is(err, domain.ErrInsufficientStock),
 		errors.Is(err, domain.ErrProductArchived),
 		errors.Is(err, domain.ErrProductAlreadyActive),
 		errors.Is(err, domain.ErrProductAlreadyInactive),
+		errors.Is(err, domain.ErrChannelAlreadyActive),
+		errors.Is(err, domain.ErrChannelAlreadyInactive),
+		errors.Is(err, domain.ErrNoPriceInRegionCurrency),
+		errors.Is(err, domain.ErrPriceNeededByRegion),
+		errors.Is(err, domain.ErrAvailabilityEnded),
 		errors.Is(err, domain.ErrCannotArchiveActiveProduct),
 		errors.Is(err, domain.ErrDiscountNotValid),
-		errors.Is(err, domain.ErrDiscountAlreadyExists):
+		errors.Is(err, domain.ErrDiscountAlreadyExists),
+		errors.Is(err, domain.ErrNoPriceInCurrency),
+		errors.Is(err, domain.ErrExchangeRateNotFound),
+		errors.Is(err, domain.ErrTaxRateNotFound),
+		errors.Is(err, domain.ErrMarginViolation),
+		errors.Is(err, domain.ErrCannotRemovePrimaryPrice),
+		errors.Is(err, domain.ErrScheduledPriceChangeNotPending),
+		errors.Is(err, domain.ErrScheduledPriceChangeNotDue),
+		errors.Is(err, domain.ErrScheduledPriceNeedsApproval),
+		errors.Is(err, domain.ErrPriceChangeRequestNotPending),
+		errors.Is(err, domain.ErrPriceChangeRequestStale):
 		return status.Error(codes.FailedPrecondition, err.Error())
 	}
 
@@ -8,14 +8,60 @@ import (
 
 	productv1 "github.com/murkotick/product-catalog-service/proto/product/v1"
 
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/attribute_definitions"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/categories"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_price_lists"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/price_change_requests"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_channel"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_relation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_tags"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/adjust_stock"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_discount"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/approve_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/cancel_scheduled_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/deactivate_channel"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/define_attribute"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/delete_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/delete_price_list"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/move_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reject_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/release_reservation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_attribute"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_discount"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_price_list_entry"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_relation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_tags"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_translation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reorder_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reserve_stock"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_bundle"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_channel_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_regions"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_translation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_variant"
 )
 
 // Commands groups write interactors.
@@ -25,14 +71,79 @@ type Commands struct {
 	Update     *update_product.Interactor
 	Activate   *activate_product.Interactor
 	Deactivate *deactivate_product.Interactor
+	Archive    *archive_product.Interactor
 	ApplyDis   *apply_discount.Interactor
 	RemoveDis  *remove_discount.Interactor
+
+	SetPrice    *set_product_price.Interactor
+	RemovePrice *remove_product_price.Interactor
+
+	SchedulePrice        *schedule_price_change.Interactor
+	CancelScheduledPrice *cancel_scheduled_price_change.Interactor
+
+	ApproveChange *approve_price_change.Interactor
+	RejectChange  *reject_price_change.Interactor
+
+	AddVariant    *add_variant.Interactor
+	UpdateVariant *update_variant.Interactor
+	RemoveVariant *remove_variant.Interactor
+
+	AddTags    *add_tags.Interactor
+	RemoveTags *remove_tags.Interactor
+
+	SetTranslation    *set_translation.Interactor
+	RemoveTranslation *remove_translation.Interactor
+
+	AddMedia     *add_media.Interactor
+	ReorderMedia *reorder_media.Interactor
+	RemoveMedia  *remove_media.Interactor
+
+	AddRelation    *add_relation.Interactor
+	RemoveRelation *remove_relation.Interactor
+
+	SetBundle *set_bundle.Interactor
+
+	AdjustStock        *adjust_stock.Interactor
+	ReserveStock       *reserve_stock.Interactor
+	ReleaseReservation *release_reservation.Interactor
+
+	ActivateChannel   *activate_channel.Interactor
+	DeactivateChannel *deactivate_channel.Interactor
+	SetChannelPrice   *set_channel_price.Interactor
+
+	SetRegions      *set_product_regions.Interactor
+	SetAvailability *set_product_availability.Interactor
+
+	CreatePriceList  *create_price_list.Interactor
+	UpdatePriceList  *update_price_list.Interactor
+	DeletePriceList  *delete_price_list.Interactor
+	SetPriceEntry    *set_price_list_entry.Interactor
+	RemovePriceEntry *remove_price_list_entry.Interactor
+
+	SetTaxRate *set_tax_rate.Interactor
+
+	DefineAttribute *define_attribute.Interactor
+	RemoveAttribute *remove_attribute.Interactor
+
+	CreateCategory *create_category.Interactor
+	UpdateCategory *update_category.Interactor
+	MoveCategory   *move_category.Interactor
+	DeleteCategory *delete_category.Interactor
 }
 
 // Queries groups read handlers.
 type Queries struct {
 	Get  *get_product.Handler
 	List *list_products.Handler
+
+	GetPriceList   *get_price_list.Handler
+	ListPriceLists *list_price_lists.Handler
+
+	ListChangeRequests *price_change_requests.Handler
+
+	ListAttributes *attribute_definitions.Handler
+
+	Categories *categories.Handler
 }
 
 // Handler is a thin gRPC transport adapter.
@@ -57,6 +168,7 @@ func (h *Handler) CreateProduct(ctx context.Context, req *productv1.CreateProduc
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	appReq.Role = callerRole(ctx)
 
 	id, err := h.commands.Create.Execute(ctx, appReq)
 	if err != nil {
@@ -71,7 +183,11 @@ func (h *Handler) UpdateProduct(ctx context.Context, req *productv1.UpdateProduc
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	appReq := mapUpdateProductRequest(req)
+	appReq, err := mapUpdateProductRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	appReq.Role = callerRole(ctx)
 	if err := h.commands.Update.Execute(ctx, appReq); err != nil {
 		return nil, mapError(err)
 	}
@@ -100,6 +216,17 @@ func (h *Handler) DeactivateProduct(ctx context.Context, req *productv1.Deactiva
 	return &productv1.DeactivateProductReply{}, nil
 }
 
+func (h *Handler) ArchiveProduct(ctx context.Context, req *productv1.ArchiveProductRequest) (*productv1.ArchiveProductReply, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	if err := h.commands.Archive.Execute(ctx, archive_product.Request{ProductID: req.ProductId}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.ArchiveProductReply{}, nil
+}
+
 func (h *Handler) ApplyDiscount(ctx context.Context, req *productv1.ApplyDiscountRequest) (*productv1.ApplyDiscountReply, error) {
 	if err := validateApplyDiscount(req); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -109,11 +236,14 @@ func (h *Handler) ApplyDiscount(ctx context.Context, req *productv1.ApplyDiscoun
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	appReq.Role = callerRole(ctx)
+	appReq.Actor = callerID(ctx)
 
-	if err := h.commands.ApplyDis.Execute(ctx, appReq); err != nil {
+	pendingID, err := h.commands.ApplyDis.Execute(ctx, appReq)
+	if err != nil {
 		return nil, mapError(err)
 	}
-	return &productv1.ApplyDiscountReply{}, nil
+	return &productv1.ApplyDiscountReply{PendingChangeRequestId: pendingID}, nil
 }
 
 func (h *Handler) RemoveDiscount(ctx context.Context, req *productv1.RemoveDiscountRequest) (*productv1.RemoveDiscountReply, error) {
@@ -127,24 +257,503 @@ func (h *Handler) RemoveDiscount(ctx context.Context, req *productv1.RemoveDisco
 	return &productv1.RemoveDiscountReply{}, nil
 }
 
+func (h *Handler) SetProductPrice(ctx context.Context, req *productv1.SetProductPriceRequest) (*productv1.SetProductPriceReply, error) {
+	if err := validateSetProductPrice(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := mapSetProductPriceRequest(req)
+	appReq.Role = callerRole(ctx)
+	appReq.Actor = callerID(ctx)
+	pendingID, err := h.commands.SetPrice.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SetProductPriceReply{PendingChangeRequestId: pendingID}, nil
+}
+
+func (h *Handler) RemoveProductPrice(ctx context.Context, req *productv1.RemoveProductPriceRequest) (*productv1.RemoveProductPriceReply, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.CurrencyCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "currency_code is required")
+	}
+
+	if err := h.commands.RemovePrice.Execute(ctx, remove_product_price.Request{
+		ProductID: req.ProductId,
+		Currency:  req.CurrencyCode,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RemoveProductPriceReply{}, nil
+}
+
+func (h *Handler) SchedulePriceChange(ctx context.Context, req *productv1.SchedulePriceChangeRequest) (*productv1.SchedulePriceChangeReply, error) {
+	if err := validateSchedulePriceChange(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := mapSchedulePriceChangeRequest(req)
+	appReq.Role = callerRole(ctx)
+	id, err := h.commands.SchedulePrice.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SchedulePriceChangeReply{ScheduleId: id}, nil
+}
+
+func (h *Handler) CancelScheduledPriceChange(ctx context.Context, req *productv1.CancelScheduledPriceChangeRequest) (*productv1.CancelScheduledPriceChangeReply, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.ScheduleId == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule_id is required")
+	}
+
+	if err := h.commands.CancelScheduledPrice.Execute(ctx, cancel_scheduled_price_change.Request{
+		ProductID:  req.ProductId,
+		ScheduleID: req.ScheduleId,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.CancelScheduledPriceChangeReply{}, nil
+}
+
+func (h *Handler) ApproveChange(ctx context.Context, req *productv1.ApproveChangeRequest) (*productv1.ApproveChangeReply, error) {
+	if req == nil || req.ChangeRequestId == "" {
+		return nil, status.Error(codes.InvalidArgument, "change_request_id is required")
+	}
+
+	if err := h.commands.ApproveChange.Execute(ctx, approve_price_change.Request{
+		RequestID: req.ChangeRequestId,
+		Actor:     callerID(ctx),
+		Role:      callerRole(ctx),
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.ApproveChangeReply{}, nil
+}
+
+func (h *Handler) RejectChange(ctx context.Context, req *productv1.RejectChangeRequest) (*productv1.RejectChangeReply, error) {
+	if req == nil || req.ChangeRequestId == "" {
+		return nil, status.Error(codes.InvalidArgument, "change_request_id is required")
+	}
+
+	if err := h.commands.RejectChange.Execute(ctx, reject_price_change.Request{
+		RequestID: req.ChangeRequestId,
+		Actor:     callerID(ctx),
+		Role:      callerRole(ctx),
+		Reason:    req.Reason,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RejectChangeReply{}, nil
+}
+
+func (h *Handler) AddVariant(ctx context.Context, req *productv1.AddVariantRequest) (*productv1.AddVariantReply, error) {
+	if err := validateAddVariant(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := mapAddVariantRequest(req)
+	appReq.Role = callerRole(ctx)
+	appReq.Actor = callerID(ctx)
+	id, pendingID, err := h.commands.AddVariant.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.AddVariantReply{VariantId: id, PendingChangeRequestId: pendingID}, nil
+}
+
+func (h *Handler) UpdateVariant(ctx context.Context, req *productv1.UpdateVariantRequest) (*productv1.UpdateVariantReply, error) {
+	if err := validateUpdateVariant(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := mapUpdateVariantRequest(req)
+	appReq.Role = callerRole(ctx)
+	appReq.Actor = callerID(ctx)
+	pendingID, err := h.commands.UpdateVariant.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.UpdateVariantReply{PendingChangeRequestId: pendingID}, nil
+}
+
+func (h *Handler) RemoveVariant(ctx context.Context, req *productv1.RemoveVariantRequest) (*productv1.RemoveVariantReply, error) {
+	if req == nil || req.ProductId == "" || req.VariantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id and variant_id are required")
+	}
+
+	if err := h.commands.RemoveVariant.Execute(ctx, remove_variant.Request{
+		ProductID: req.ProductId,
+		VariantID: req.VariantId,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RemoveVariantReply{}, nil
+}
+
+func (h *Handler) AddTags(ctx context.Context, req *productv1.AddTagsRequest) (*productv1.AddTagsReply, error) {
+	if req == nil || req.ProductId == "" || len(req.Tags) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id and tags are required")
+	}
+
+	if err := h.commands.AddTags.Execute(ctx, add_tags.Request{
+		ProductID: req.ProductId,
+		Tags:      req.Tags,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.AddTagsReply{}, nil
+}
+
+func (h *Handler) RemoveTags(ctx context.Context, req *productv1.RemoveTagsRequest) (*productv1.RemoveTagsReply, error) {
+	if req == nil || req.ProductId == "" || len(req.Tags) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id and tags are required")
+	}
+
+	if err := h.commands.RemoveTags.Execute(ctx, remove_tags.Request{
+		ProductID: req.ProductId,
+		Tags:      req.Tags,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RemoveTagsReply{}, nil
+}
+
+func (h *Handler) SetTranslation(ctx context.Context, req *productv1.SetTranslationRequest) (*productv1.SetTranslationReply, error) {
+	if req == nil || req.ProductId == "" || req.Locale == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id, locale and name are required")
+	}
+
+	if err := h.commands.SetTranslation.Execute(ctx, set_translation.Request{
+		ProductID:   req.ProductId,
+		Locale:      req.Locale,
+		Name:        req.Name,
+		Description: req.Description,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SetTranslationReply{}, nil
+}
+
+func (h *Handler) RemoveTranslation(ctx context.Context, req *productv1.RemoveTranslationRequest) (*productv1.RemoveTranslationReply, error) {
+	if req == nil || req.ProductId == "" || req.Locale == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id and locale are required")
+	}
+
+	if err := h.commands.RemoveTranslation.Execute(ctx, remove_translation.Request{
+		ProductID: req.ProductId,
+		Locale:    req.Locale,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RemoveTranslationReply{}, nil
+}
+
+func (h *Handler) AddMedia(ctx context.Context, req *productv1.AddMediaRequest) (*productv1.AddMediaReply, error) {
+	if req == nil || req.ProductId == "" || req.Url == "" || req.Kind == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id, url and kind are required")
+	}
+
+	id, err := h.commands.AddMedia.Execute(ctx, add_media.Request{
+		ProductID: req.ProductId,
+		URL:       req.Url,
+		Kind:      req.Kind,
+		AltTexts:  req.AltTexts,
+		Primary:   req.Primary,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.AddMediaReply{MediaId: id}, nil
+}
+
+func (h *Handler) ReorderMedia(ctx context.Context, req *productv1.ReorderMediaRequest) (*productv1.ReorderMediaReply, error) {
+	if req == nil || req.ProductId == "" || (len(req.MediaIds) == 0 && req.PrimaryMediaId == "") {
+		return nil, status.Error(codes.InvalidArgument, "product_id and media_ids or primary_media_id are required")
+	}
+
+	if err := h.commands.ReorderMedia.Execute(ctx, reorder_media.Request{
+		ProductID:      req.ProductId,
+		MediaIDs:       req.MediaIds,
+		PrimaryMediaID: req.PrimaryMediaId,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.ReorderMediaReply{}, nil
+}
+
+func (h *Handler) RemoveMedia(ctx context.Context, req *productv1.RemoveMediaRequest) (*productv1.RemoveMediaReply, error) {
+	if req == nil || req.ProductId == "" || req.MediaId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id and media_id are required")
+	}
+
+	if err := h.commands.RemoveMedia.Execute(ctx, remove_media.Request{
+		ProductID: req.ProductId,
+		MediaID:   req.MediaId,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RemoveMediaReply{}, nil
+}
+
+func (h *Handler) AddProductRelation(ctx context.Context, req *productv1.AddProductRelationRequest) (*productv1.AddProductRelationReply, error) {
+	if req == nil || req.ProductId == "" || req.RelatedProductId == "" || req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id, related_product_id and type are required")
+	}
+
+	if err := h.commands.AddRelation.Execute(ctx, add_relation.Request{
+		ProductID:        req.ProductId,
+		RelatedProductID: req.RelatedProductId,
+		Type:             req.Type,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.AddProductRelationReply{}, nil
+}
+
+func (h *Handler) RemoveProductRelation(ctx context.Context, req *productv1.RemoveProductRelationRequest) (*productv1.RemoveProductRelationReply, error) {
+	if req == nil || req.ProductId == "" || req.RelatedProductId == "" || req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id, related_product_id and type are required")
+	}
+
+	if err := h.commands.RemoveRelation.Execute(ctx, remove_relation.Request{
+		ProductID:        req.ProductId,
+		RelatedProductID: req.RelatedProductId,
+		Type:             req.Type,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RemoveProductRelationReply{}, nil
+}
+
+func (h *Handler) SetBundle(ctx context.Context, req *productv1.SetBundleRequest) (*productv1.SetBundleReply, error) {
+	if req == nil || req.ProductId == "" || req.Pricing == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id and pricing are required")
+	}
+
+	appReq, err := mapSetBundleRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	appReq.Role = callerRole(ctx)
+
+	if err := h.commands.SetBundle.Execute(ctx, appReq); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SetBundleReply{}, nil
+}
+
+func (h *Handler) AdjustStock(ctx context.Context, req *productv1.AdjustStockRequest) (*productv1.AdjustStockReply, error) {
+	if req == nil || req.ProductId == "" || req.LocationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id and location_id are required")
+	}
+
+	appReq := adjust_stock.Request{ProductID: req.ProductId, LocationID: req.LocationId, Delta: req.Delta}
+	if req.LowStockThreshold != nil {
+		threshold := req.GetLowStockThreshold()
+		appReq.LowStockThreshold = &threshold
+	}
+	if err := h.commands.AdjustStock.Execute(ctx, appReq); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.AdjustStockReply{}, nil
+}
+
+func (h *Handler) ReserveStock(ctx context.Context, req *productv1.ReserveStockRequest) (*productv1.ReserveStockReply, error) {
+	if req == nil || req.ProductId == "" || req.LocationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id and location_id are required")
+	}
+
+	id, err := h.commands.ReserveStock.Execute(ctx, reserve_stock.Request{
+		ProductID:  req.ProductId,
+		LocationID: req.LocationId,
+		Quantity:   req.Quantity,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.ReserveStockReply{ReservationId: id}, nil
+}
+
+func (h *Handler) ReleaseReservation(ctx context.Context, req *productv1.ReleaseReservationRequest) (*productv1.ReleaseReservationReply, error) {
+	if req == nil || req.ReservationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reservation_id is required")
+	}
+
+	if err := h.commands.ReleaseReservation.Execute(ctx, release_reservation.Request{
+		ReservationID: req.ReservationId,
+		Fulfilled:     req.Fulfilled,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.ReleaseReservationReply{}, nil
+}
+
+func (h *Handler) ActivateProductOnChannel(ctx context.Context, req *productv1.ActivateProductOnChannelRequest) (*productv1.ActivateProductOnChannelReply, error) {
+	if req == nil || req.ProductId == "" || req.Channel == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id and channel are required")
+	}
+
+	if err := h.commands.ActivateChannel.Execute(ctx, activate_channel.Request{
+		ProductID: req.ProductId,
+		Channel:   req.Channel,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.ActivateProductOnChannelReply{}, nil
+}
+
+func (h *Handler) DeactivateProductOnChannel(ctx context.Context, req *productv1.DeactivateProductOnChannelRequest) (*productv1.DeactivateProductOnChannelReply, error) {
+	if req == nil || req.ProductId == "" || req.Channel == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id and channel are required")
+	}
+
+	if err := h.commands.DeactivateChannel.Execute(ctx, deactivate_channel.Request{
+		ProductID: req.ProductId,
+		Channel:   req.Channel,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.DeactivateProductOnChannelReply{}, nil
+}
+
+func (h *Handler) SetChannelPrice(ctx context.Context, req *productv1.SetChannelPriceRequest) (*productv1.SetChannelPriceReply, error) {
+	if err := validateSetChannelPrice(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := mapSetChannelPriceRequest(req)
+	appReq.Role = callerRole(ctx)
+	appReq.Actor = callerID(ctx)
+	pendingID, err := h.commands.SetChannelPrice.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SetChannelPriceReply{PendingChangeRequestId: pendingID}, nil
+}
+
+func (h *Handler) SetProductRegions(ctx context.Context, req *productv1.SetProductRegionsRequest) (*productv1.SetProductRegionsReply, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	if err := h.commands.SetRegions.Execute(ctx, set_product_regions.Request{
+		ProductID:      req.ProductId,
+		AllowedRegions: req.AllowedRegions,
+		BlockedRegions: req.BlockedRegions,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SetProductRegionsReply{}, nil
+}
+
+func (h *Handler) SetProductAvailability(ctx context.Context, req *productv1.SetProductAvailabilityRequest) (*productv1.SetProductAvailabilityReply, error) {
+	if err := validateSetProductAvailability(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.commands.SetAvailability.Execute(ctx, mapSetProductAvailabilityRequest(req)); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SetProductAvailabilityReply{}, nil
+}
+
 func (h *Handler) GetProduct(ctx context.Context, req *productv1.GetProductRequest) (*productv1.GetProductReply, error) {
 	if req == nil || req.ProductId == "" {
 		return nil, status.Error(codes.InvalidArgument, "product_id is required")
 	}
 
-	dtoOut, err := h.queries.Get.Execute(ctx, req.ProductId)
+	opts, err := mapReadOptions(req.GetSegment(), req.GetCurrency(), req.GetDisplayCurrency(), req.GetTaxRegion(), req.GetLocale(), req.GetChannel(), req.GetRegion(), req.GetAtTime())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dtoOut, err := h.queries.Get.Execute(ctx, req.ProductId, opts)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	pbProd, err := mapProductDTOToProto(dtoOut)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &productv1.GetProductReply{Product: pbProd}, nil
+}
+
+func (h *Handler) GetProductBySKU(ctx context.Context, req *productv1.GetProductBySKURequest) (*productv1.GetProductReply, error) {
+	if req == nil || req.Sku == "" {
+		return nil, status.Error(codes.InvalidArgument, "sku is required")
+	}
+
+	opts, err := mapReadOptions(req.GetSegment(), req.GetCurrency(), req.GetDisplayCurrency(), req.GetTaxRegion(), req.GetLocale(), req.GetChannel(), req.GetRegion(), req.GetAtTime())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dtoOut, err := h.queries.Get.ExecuteBySKU(ctx, req.Sku, opts)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	pbProd, err := mapProductDTOToProto(dtoOut)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &productv1.GetProductReply{Product: pbProd}, nil
+}
+
+func (h *Handler) GetProductByGTIN(ctx context.Context, req *productv1.GetProductByGTINRequest) (*productv1.GetProductReply, error) {
+	if req == nil || req.Gtin == "" {
+		return nil, status.Error(codes.InvalidArgument, "gtin is required")
+	}
+
+	opts, err := mapReadOptions(req.GetSegment(), req.GetCurrency(), req.GetDisplayCurrency(), req.GetTaxRegion(), req.GetLocale(), req.GetChannel(), req.GetRegion(), req.GetAtTime())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dtoOut, err := h.queries.Get.ExecuteByGTIN(ctx, req.Gtin, opts)
 	if err != nil {
 		return nil, mapError(err)
 	}
 
 	pbProd, err := mapProductDTOToProto(dtoOut)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, mapError(err)
 	}
 
 	return &productv1.GetProductReply{Product: pbProd}, nil
 }
 
+func (h *Handler) GetProductBySlug(ctx context.Context, req *productv1.GetProductBySlugRequest) (*productv1.GetProductBySlugReply, error) {
+	if req == nil || req.Slug == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug is required")
+	}
+
+	opts, err := mapReadOptions(req.GetSegment(), req.GetCurrency(), req.GetDisplayCurrency(), req.GetTaxRegion(), req.GetLocale(), req.GetChannel(), req.GetRegion(), req.GetAtTime())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dtoOut, redirect, err := h.queries.Get.ExecuteBySlug(ctx, req.Slug, opts)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	pbProd, err := mapProductDTOToProto(dtoOut)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &productv1.GetProductBySlugReply{Product: pbProd, Redirect: redirect}, nil
+}
+
 func (h *Handler) ListProducts(ctx context.Context, req *productv1.ListProductsRequest) (*productv1.ListProductsReply, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
@@ -163,22 +772,39 @@ func (h *Handler) ListProducts(ctx context.Context, req *productv1.ListProductsR
 		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
 	}
 
-	var category *string
+	var filter contracts.ProductFilter
 	if req.Category != nil {
 		c := req.GetCategory()
 		if c != "" {
-			category = &c
+			filter.Category = &c
+		}
+	}
+	if req.CategoryId != nil {
+		c := req.GetCategoryId()
+		if c != "" {
+			filter.CategoryID = &c
 		}
 	}
 
-	items, err := h.queries.List.Execute(ctx, category, limit, offset)
+	opts, err := mapReadOptions(req.GetSegment(), req.GetCurrency(), req.GetDisplayCurrency(), req.GetTaxRegion(), req.GetLocale(), req.GetChannel(), req.GetRegion(), req.GetAtTime())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter.Attributes, err = mapAttributeFilters(req.GetAttributeFilters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter.Tags, filter.TagMatch = req.GetTags(), mapTagMatch(req.GetTagMatch())
+	filter.InStock = req.GetInStock()
+
+	items, err := h.queries.List.Execute(ctx, filter, opts, limit, offset)
 	if err != nil {
 		return nil, mapError(err)
 	}
 
 	products, err := mapProductSummariesToProto(items)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, mapError(err)
 	}
 
 	next := ""
@@ -188,3 +814,358 @@ func (h *Handler) ListProducts(ctx context.Context, req *productv1.ListProductsR
 
 	return &productv1.ListProductsReply{Products: products, NextPageToken: next}, nil
 }
+
+func (h *Handler) ListRelatedProducts(ctx context.Context, req *productv1.ListRelatedProductsRequest) (*productv1.ListProductsReply, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 200 {
+		limit = 200
+	}
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	opts, err := mapReadOptions(req.GetSegment(), req.GetCurrency(), req.GetDisplayCurrency(), req.GetTaxRegion(), req.GetLocale(), req.GetChannel(), req.GetRegion(), req.GetAtTime())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	relTypes, err := mapRelationTypes(req.GetTypes())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	items, err := h.queries.List.ExecuteRelated(ctx, req.ProductId, relTypes, req.Inverse, opts, limit, offset)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	products, err := mapProductSummariesToProto(items)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	next := ""
+	if len(items) == limit {
+		next = encodePageToken(offset + len(items))
+	}
+
+	return &productv1.ListProductsReply{Products: products, NextPageToken: next}, nil
+}
+
+func (h *Handler) CreatePriceList(ctx context.Context, req *productv1.CreatePriceListRequest) (*productv1.CreatePriceListReply, error) {
+	if err := validateCreatePriceList(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := h.commands.CreatePriceList.Execute(ctx, create_price_list.Request{
+		Segment:     req.GetSegment(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &productv1.CreatePriceListReply{PriceListId: id}, nil
+}
+
+func (h *Handler) UpdatePriceList(ctx context.Context, req *productv1.UpdatePriceListRequest) (*productv1.UpdatePriceListReply, error) {
+	if err := validateUpdatePriceList(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.commands.UpdatePriceList.Execute(ctx, mapUpdatePriceListRequest(req)); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.UpdatePriceListReply{}, nil
+}
+
+func (h *Handler) DeletePriceList(ctx context.Context, req *productv1.DeletePriceListRequest) (*productv1.DeletePriceListReply, error) {
+	if req == nil || req.PriceListId == "" {
+		return nil, status.Error(codes.InvalidArgument, "price_list_id is required")
+	}
+
+	if err := h.commands.DeletePriceList.Execute(ctx, delete_price_list.Request{PriceListID: req.PriceListId}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.DeletePriceListReply{}, nil
+}
+
+func (h *Handler) SetPriceListEntry(ctx context.Context, req *productv1.SetPriceListEntryRequest) (*productv1.SetPriceListEntryReply, error) {
+	if err := validateSetPriceListEntry(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq, err := mapSetPriceListEntryRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.commands.SetPriceEntry.Execute(ctx, appReq); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SetPriceListEntryReply{}, nil
+}
+
+func (h *Handler) RemovePriceListEntry(ctx context.Context, req *productv1.RemovePriceListEntryRequest) (*productv1.RemovePriceListEntryReply, error) {
+	if req == nil || req.PriceListId == "" {
+		return nil, status.Error(codes.InvalidArgument, "price_list_id is required")
+	}
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	if err := h.commands.RemovePriceEntry.Execute(ctx, remove_price_list_entry.Request{
+		PriceListID: req.PriceListId,
+		ProductID:   req.ProductId,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RemovePriceListEntryReply{}, nil
+}
+
+func (h *Handler) SetTaxRate(ctx context.Context, req *productv1.SetTaxRateRequest) (*productv1.SetTaxRateReply, error) {
+	if err := validateSetTaxRate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq, err := mapSetTaxRateRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.commands.SetTaxRate.Execute(ctx, appReq); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.SetTaxRateReply{}, nil
+}
+
+func (h *Handler) DefineAttribute(ctx context.Context, req *productv1.DefineAttributeRequest) (*productv1.DefineAttributeReply, error) {
+	if err := validateDefineAttribute(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.commands.DefineAttribute.Execute(ctx, define_attribute.Request{
+		Category:   req.GetCategory(),
+		Name:       req.GetName(),
+		Type:       req.GetType(),
+		Required:   req.GetRequired(),
+		EnumValues: req.GetEnumValues(),
+		Unit:       req.GetUnit(),
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.DefineAttributeReply{}, nil
+}
+
+func (h *Handler) RemoveAttribute(ctx context.Context, req *productv1.RemoveAttributeRequest) (*productv1.RemoveAttributeReply, error) {
+	if req == nil || req.Category == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "category and name are required")
+	}
+
+	if err := h.commands.RemoveAttribute.Execute(ctx, remove_attribute.Request{
+		Category: req.Category,
+		Name:     req.Name,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.RemoveAttributeReply{}, nil
+}
+
+func (h *Handler) ListAttributeDefinitions(ctx context.Context, req *productv1.ListAttributeDefinitionsRequest) (*productv1.ListAttributeDefinitionsReply, error) {
+	if req == nil || req.Category == "" {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	defs, err := h.queries.ListAttributes.Execute(ctx, req.Category)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*productv1.AttributeDefinition, 0, len(defs))
+	for _, d := range defs {
+		pd, err := mapAttributeDefinitionToProto(d)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		out = append(out, pd)
+	}
+	return &productv1.ListAttributeDefinitionsReply{Definitions: out}, nil
+}
+
+func (h *Handler) CreateCategory(ctx context.Context, req *productv1.CreateCategoryRequest) (*productv1.CreateCategoryReply, error) {
+	if err := validateCreateCategory(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := h.commands.CreateCategory.Execute(ctx, create_category.Request{
+		Name:     req.GetName(),
+		Slug:     req.GetSlug(),
+		ParentID: req.GetParentId(),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &productv1.CreateCategoryReply{CategoryId: id}, nil
+}
+
+func (h *Handler) UpdateCategory(ctx context.Context, req *productv1.UpdateCategoryRequest) (*productv1.UpdateCategoryReply, error) {
+	if req == nil || req.CategoryId == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id and name are required")
+	}
+
+	if err := h.commands.UpdateCategory.Execute(ctx, update_category.Request{
+		CategoryID: req.CategoryId,
+		Name:       req.Name,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.UpdateCategoryReply{}, nil
+}
+
+func (h *Handler) MoveCategory(ctx context.Context, req *productv1.MoveCategoryRequest) (*productv1.MoveCategoryReply, error) {
+	if req == nil || req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+
+	if err := h.commands.MoveCategory.Execute(ctx, move_category.Request{
+		CategoryID: req.CategoryId,
+		ParentID:   req.ParentId,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.MoveCategoryReply{}, nil
+}
+
+func (h *Handler) DeleteCategory(ctx context.Context, req *productv1.DeleteCategoryRequest) (*productv1.DeleteCategoryReply, error) {
+	if req == nil || req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+
+	if err := h.commands.DeleteCategory.Execute(ctx, delete_category.Request{CategoryID: req.CategoryId}); err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.DeleteCategoryReply{}, nil
+}
+
+func (h *Handler) GetCategory(ctx context.Context, req *productv1.GetCategoryRequest) (*productv1.GetCategoryReply, error) {
+	if req == nil || req.CategoryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "category_id is required")
+	}
+
+	c, err := h.queries.Categories.Get(ctx, req.CategoryId)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	out, err := mapCategoryToProto(c)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &productv1.GetCategoryReply{Category: out}, nil
+}
+
+func (h *Handler) ListCategories(ctx context.Context, req *productv1.ListCategoriesRequest) (*productv1.ListCategoriesReply, error) {
+	list, err := h.queries.Categories.List(ctx, req.GetParentId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*productv1.Category, 0, len(list))
+	for _, c := range list {
+		pc, err := mapCategoryToProto(c)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		out = append(out, pc)
+	}
+	return &productv1.ListCategoriesReply{Categories: out}, nil
+}
+
+func (h *Handler) GetPriceList(ctx context.Context, req *productv1.GetPriceListRequest) (*productv1.GetPriceListReply, error) {
+	if req == nil || req.PriceListId == "" {
+		return nil, status.Error(codes.InvalidArgument, "price_list_id is required")
+	}
+
+	dtoOut, err := h.queries.GetPriceList.Execute(ctx, req.PriceListId)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	pbList, err := mapPriceListDTOToProto(dtoOut)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &productv1.GetPriceListReply{PriceList: pbList}, nil
+}
+
+func (h *Handler) ListPriceLists(ctx context.Context, req *productv1.ListPriceListsRequest) (*productv1.ListPriceListsReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	items, err := h.queries.ListPriceLists.Execute(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*productv1.PriceList, 0, len(items))
+	for _, it := range items {
+		pbList, err := mapPriceListDTOToProto(it)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		out = append(out, pbList)
+	}
+
+	return &productv1.ListPriceListsReply{PriceLists: out}, nil
+}
+
+func (h *Handler) ListPriceChangeRequests(ctx context.Context, req *productv1.ListPriceChangeRequestsRequest) (*productv1.ListPriceChangeRequestsReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	limit := int(req.PageSize)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 200 {
+		limit = 200
+	}
+
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	items, err := h.queries.ListChangeRequests.Execute(ctx, req.GetProductId(), req.GetStatus(), limit, offset)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*productv1.PriceChangeRequest, 0, len(items))
+	for _, it := range items {
+		pbReq, err := mapPriceChangeRequestToProto(it)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		out = append(out, pbReq)
+	}
+
+	next := ""
+	if len(items) == limit {
+		next = encodePageToken(offset + len(items))
+	}
+
+	return &productv1.ListPriceChangeRequestsReply{Requests: out, NextPageToken: next}, nil
+}
@@ -3,16 +3,29 @@ package product
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	productv1 "github.com/murkotick/product-catalog-service/proto/product/v1"
 
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_variant"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_discount"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_bundle"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_channel_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_variant"
 )
 
 func mapCreateProductRequest(req *productv1.CreateProductRequest) (create_product.Request, error) {
@@ -24,16 +37,39 @@ func mapCreateProductRequest(req *productv1.CreateProductRequest) (create_produc
 		return create_product.Request{}, fmt.Errorf("base_price.denominator must be non-zero")
 	}
 
-	return create_product.Request{
-		Name:         req.GetName(),
-		Description:  req.GetDescription(),
-		Category:     req.GetCategory(),
-		BasePriceNum: money.Numerator,
-		BasePriceDen: money.Denominator,
-	}, nil
+	out := create_product.Request{
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		CategoryID:     req.GetCategoryId(),
+		Category:       req.GetCategory(),
+		BasePriceNum:   money.Numerator,
+		BasePriceDen:   money.Denominator,
+		Currency:       money.GetCurrencyCode(),
+		TaxCategory:    req.GetTaxCategory(),
+		SKU:            req.GetSku(),
+		GTIN:           req.GetGtin(),
+		DefaultLocale:  req.GetDefaultLocale(),
+		OverrideMargin: req.GetOverrideMargin(),
+	}
+	if cost := req.GetCostPrice(); cost != nil {
+		if cost.GetCurrencyCode() != "" && cost.GetCurrencyCode() != money.GetCurrencyCode() {
+			return create_product.Request{}, fmt.Errorf("cost_price must be in base_price's currency")
+		}
+		num, den := cost.GetNumerator(), cost.GetDenominator()
+		out.CostPriceNum, out.CostPriceDen = &num, &den
+	}
+	if size := req.GetPackageSize(); size != nil {
+		quantity, err := parsePackageQuantity(size.GetQuantity())
+		if err != nil {
+			return create_product.Request{}, err
+		}
+		out.PackageQuantity, out.UnitOfMeasure = quantity, size.GetUnit()
+	}
+	out.Attributes = req.GetAttributes()
+	return out, nil
 }
 
-func mapUpdateProductRequest(req *productv1.UpdateProductRequest) update_product.Request {
+func mapUpdateProductRequest(req *productv1.UpdateProductRequest) (update_product.Request, error) {
 	out := update_product.Request{ProductID: req.GetProductId()}
 	if req.Name != nil {
 		v := req.GetName()
@@ -43,11 +79,146 @@ func mapUpdateProductRequest(req *productv1.UpdateProductRequest) update_product
 		v := req.GetDescription()
 		out.Description = &v
 	}
+	if req.CategoryId != nil {
+		v := req.GetCategoryId()
+		out.CategoryID = &v
+	}
 	if req.Category != nil {
 		v := req.GetCategory()
 		out.Category = &v
 	}
-	return out
+	if req.TaxCategory != nil {
+		v := req.GetTaxCategory()
+		out.TaxCategory = &v
+	}
+	if req.Sku != nil {
+		v := req.GetSku()
+		out.SKU = &v
+	}
+	if req.Gtin != nil {
+		v := req.GetGtin()
+		out.GTIN = &v
+	}
+	if req.DefaultLocale != nil {
+		v := req.GetDefaultLocale()
+		out.DefaultLocale = &v
+	}
+	if cost := req.GetCostPrice(); cost != nil {
+		num, den := cost.GetNumerator(), cost.GetDenominator()
+		out.CostPriceNum, out.CostPriceDen = &num, &den
+	}
+	out.ClearCostPrice = req.GetClearCostPrice()
+	out.OverrideMargin = req.GetOverrideMargin()
+	if size := req.GetPackageSize(); size != nil {
+		quantity, err := parsePackageQuantity(size.GetQuantity())
+		if err != nil {
+			return update_product.Request{}, err
+		}
+		unit := size.GetUnit()
+		out.PackageQuantity, out.UnitOfMeasure = quantity, &unit
+	}
+	out.ClearPackageSize = req.GetClearPackageSize()
+	if len(req.GetAttributes()) > 0 {
+		out.Attributes = req.GetAttributes()
+	}
+	out.ClearAttributes = req.GetClearAttributes()
+	return out, nil
+}
+
+// mapTagMatch maps the ListProducts tag match mode; unspecified means any.
+func mapTagMatch(in productv1.TagMatch) contracts.TagMatch {
+	if in == productv1.TagMatch_TAG_MATCH_ALL {
+		return contracts.TagMatchAll
+	}
+	return contracts.TagMatchAny
+}
+
+// mapAttributeFilters maps ListProducts attribute filters; bounds are parsed exactly.
+func mapAttributeFilters(in []*productv1.AttributeFilter) ([]contracts.AttributeFilter, error) {
+	out := make([]contracts.AttributeFilter, 0, len(in))
+	for _, f := range in {
+		if f.GetName() == "" {
+			return nil, fmt.Errorf("attribute_filters.name is required")
+		}
+		filter := contracts.AttributeFilter{Name: f.GetName()}
+		if f.Value != nil {
+			v := f.GetValue()
+			filter.Value = &v
+		}
+		for _, bound := range []struct {
+			in    *string
+			out   **big.Rat
+			field string
+		}{{f.Min, &filter.Min, "min"}, {f.Max, &filter.Max, "max"}} {
+			if bound.in == nil {
+				continue
+			}
+			r, ok := new(big.Rat).SetString(*bound.in)
+			if !ok {
+				return nil, fmt.Errorf("invalid attribute_filters.%s: %q", bound.field, *bound.in)
+			}
+			*bound.out = r
+		}
+		out = append(out, filter)
+	}
+	return out, nil
+}
+
+func mapAttributeDefinitionToProto(in *dto.AttributeDefinitionDTO) (*productv1.AttributeDefinition, error) {
+	out := &productv1.AttributeDefinition{
+		Category:   in.Category,
+		Name:       in.Name,
+		Type:       in.Type,
+		Required:   in.Required,
+		EnumValues: in.EnumValues,
+		Unit:       in.Unit,
+	}
+	for _, ts := range []struct {
+		in  string
+		out **timestamppb.Timestamp
+	}{{in.CreatedAt, &out.CreatedAt}, {in.UpdatedAt, &out.UpdatedAt}} {
+		t, err := time.Parse(time.RFC3339Nano, ts.in)
+		if err != nil {
+			return nil, err
+		}
+		*ts.out = timestamppb.New(t)
+	}
+	return out, nil
+}
+
+func mapCategoryToProto(in *dto.CategoryDTO) (*productv1.Category, error) {
+	out := &productv1.Category{
+		Id:   in.CategoryID,
+		Name: in.Name,
+		Slug: in.Slug,
+		Path: in.Path,
+	}
+	if in.ParentID != nil {
+		out.ParentId = *in.ParentID
+	}
+	for _, ts := range []struct {
+		in  string
+		out **timestamppb.Timestamp
+	}{{in.CreatedAt, &out.CreatedAt}, {in.UpdatedAt, &out.UpdatedAt}} {
+		t, err := time.Parse(time.RFC3339, ts.in)
+		if err != nil {
+			return nil, err
+		}
+		*ts.out = timestamppb.New(t)
+	}
+	return out, nil
+}
+
+// parsePackageQuantity parses package_size.quantity exactly ("0.75" stays 3/4).
+func parsePackageQuantity(s string) (*big.Rat, error) {
+	if s == "" {
+		return nil, fmt.Errorf("package_size.quantity is required")
+	}
+	q, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid package_size.quantity: %q", s)
+	}
+	return q, nil
 }
 
 func mapApplyDiscountRequest(req *productv1.ApplyDiscountRequest) (apply_discount.Request, error) {
@@ -71,38 +242,164 @@ func mapApplyDiscountRequest(req *productv1.ApplyDiscountRequest) (apply_discoun
 		return apply_discount.Request{}, fmt.Errorf("discount.end_date is required")
 	}
 
-	pct, err := parseDiscountPercentageToFloat(d.GetPercentage())
+	pct, err := parseDiscountPercentage(d.GetPercentage())
 	if err != nil {
 		return apply_discount.Request{}, err
 	}
 
 	return apply_discount.Request{
-		ProductID:  req.GetProductId(),
-		Percentage: pct,
-		StartDate:  start.UTC(),
-		EndDate:    end.UTC(),
+		ProductID:      req.GetProductId(),
+		Percentage:     pct,
+		StartDate:      start.UTC(),
+		EndDate:        end.UTC(),
+		OverrideMargin: req.GetOverrideMargin(),
 	}, nil
 }
 
-// parseDiscountPercentageToFloat accepts either "20" (20%) or "0.2" (20%).
-// The application-layer interactor expects 0-100 scale.
-func parseDiscountPercentageToFloat(s string) (float64, error) {
+// parseDiscountPercentage accepts either "20" (20%) or "0.2" (20%) and parses
+// it exactly, so "12.345" stays 12.345%. The interactor expects 0-100 scale.
+func parseDiscountPercentage(s string) (*big.Rat, error) {
 	if s == "" {
-		return 0, fmt.Errorf("discount.percentage is required")
+		return nil, fmt.Errorf("discount.percentage is required")
 	}
 
 	r := new(big.Rat)
 	if _, ok := r.SetString(s); !ok {
-		return 0, fmt.Errorf("invalid discount.percentage: %q", s)
+		return nil, fmt.Errorf("invalid discount.percentage: %q", s)
 	}
 
 	// If <= 1, treat as fraction (0.2 => 20%). Otherwise treat as percent.
 	if r.Cmp(big.NewRat(1, 1)) <= 0 {
-		r = new(big.Rat).Mul(r, big.NewRat(100, 1))
+		r.Mul(r, big.NewRat(100, 1))
 	}
 
-	f, _ := r.Float64()
-	return f, nil
+	return r, nil
+}
+
+// mapReadOptions builds read options from the optional pricing parameters of read RPCs.
+// A nil at evaluates prices at the current time.
+func mapReadOptions(segment, currency, displayCurrency, taxRegion, locale, channel, region string, at *timestamppb.Timestamp) (contracts.ProductReadOptions, error) {
+	opts := contracts.ProductReadOptions{}
+	if at != nil {
+		if err := at.CheckValid(); err != nil {
+			return contracts.ProductReadOptions{}, fmt.Errorf("invalid at_time: %w", err)
+		}
+		opts.At = at.AsTime()
+	}
+	if segment != "" {
+		normalized, err := domain.NormalizeSegment(segment)
+		if err != nil {
+			return contracts.ProductReadOptions{}, err
+		}
+		opts.Segment = normalized
+	}
+	if currency != "" {
+		c, err := domain.ParseCurrency(currency)
+		if err != nil {
+			return contracts.ProductReadOptions{}, err
+		}
+		opts.Currency = c.String()
+	}
+	if displayCurrency != "" {
+		c, err := domain.ParseCurrency(displayCurrency)
+		if err != nil {
+			return contracts.ProductReadOptions{}, err
+		}
+		opts.DisplayCurrency = c.String()
+	}
+	if taxRegion != "" {
+		r, err := domain.ParseTaxRegion(taxRegion)
+		if err != nil {
+			return contracts.ProductReadOptions{}, err
+		}
+		opts.TaxRegion = r.String()
+	}
+	if locale != "" {
+		locales, err := domain.ParseAcceptLanguage(locale)
+		if err != nil {
+			return contracts.ProductReadOptions{}, err
+		}
+		for _, l := range locales {
+			opts.Locales = append(opts.Locales, l.String())
+		}
+	}
+	if channel != "" {
+		normalized, err := domain.NormalizeChannel(channel)
+		if err != nil {
+			return contracts.ProductReadOptions{}, err
+		}
+		opts.Channel = normalized
+	}
+	if region != "" {
+		r, err := domain.ParseRegion(region)
+		if err != nil {
+			return contracts.ProductReadOptions{}, err
+		}
+		opts.Region = r.String()
+	}
+	return opts, nil
+}
+
+func mapSetProductPriceRequest(req *productv1.SetProductPriceRequest) set_product_price.Request {
+	price := req.GetPrice()
+	return set_product_price.Request{
+		ProductID:      req.GetProductId(),
+		PriceNum:       price.GetNumerator(),
+		PriceDen:       price.GetDenominator(),
+		Currency:       price.GetCurrencyCode(),
+		OverrideMargin: req.GetOverrideMargin(),
+	}
+}
+
+func mapSchedulePriceChangeRequest(req *productv1.SchedulePriceChangeRequest) schedule_price_change.Request {
+	price := req.GetPrice()
+	return schedule_price_change.Request{
+		ProductID:      req.GetProductId(),
+		PriceNum:       price.GetNumerator(),
+		PriceDen:       price.GetDenominator(),
+		Currency:       price.GetCurrencyCode(),
+		EffectiveAt:    req.GetEffectiveAt().AsTime(),
+		OverrideMargin: req.GetOverrideMargin(),
+	}
+}
+
+func mapAddVariantRequest(req *productv1.AddVariantRequest) add_variant.Request {
+	out := add_variant.Request{
+		ProductID:      req.GetProductId(),
+		SKU:            req.GetSku(),
+		Options:        req.GetOptions(),
+		OverrideMargin: req.GetOverrideMargin(),
+	}
+	if price := req.GetPrice(); price != nil {
+		num, den := price.GetNumerator(), price.GetDenominator()
+		out.PriceNum, out.PriceDen, out.Currency = &num, &den, price.GetCurrencyCode()
+	}
+	return out
+}
+
+func mapUpdateVariantRequest(req *productv1.UpdateVariantRequest) update_variant.Request {
+	out := update_variant.Request{
+		ProductID:      req.GetProductId(),
+		VariantID:      req.GetVariantId(),
+		ClearPrice:     req.GetClearPrice(),
+		OverrideMargin: req.GetOverrideMargin(),
+	}
+	if req.Sku != nil {
+		v := req.GetSku()
+		out.SKU = &v
+	}
+	if len(req.GetOptions()) > 0 {
+		out.Options = req.GetOptions()
+	}
+	if price := req.GetPrice(); price != nil {
+		num, den := price.GetNumerator(), price.GetDenominator()
+		out.PriceNum, out.PriceDen, out.Currency = &num, &den, price.GetCurrencyCode()
+	}
+	if req.Status != nil {
+		v := req.GetStatus()
+		out.Status = &v
+	}
+	return out
 }
 
 func mapProductDTOToProto(in *dto.ProductDTO) (*productv1.Product, error) {
@@ -110,12 +407,145 @@ func mapProductDTOToProto(in *dto.ProductDTO) (*productv1.Product, error) {
 		return nil, fmt.Errorf("nil product")
 	}
 
+	base, err := decimalToProtoMoney(in.BasePrice, in.Currency)
+	if err != nil {
+		return nil, err
+	}
 	out := &productv1.Product{
-		Id:        in.ProductID,
-		Name:      in.Name,
-		Category:  in.Category,
-		Status:    mapStatusToProto(in.Status),
-		BasePrice: &productv1.Money{Numerator: in.BasePriceNum, Denominator: in.BasePriceDen},
+		Id:            in.ProductID,
+		Name:          in.Name,
+		Category:      in.Category,
+		CategoryId:    in.CategoryID,
+		Tags:          in.Tags,
+		Slug:          in.Slug,
+		Locale:        in.Locale,
+		DefaultLocale: in.DefaultLocale,
+		Sku:           valueOrEmpty(in.SKU),
+		Gtin:          valueOrEmpty(in.GTIN),
+		TaxCategory:   in.TaxCategory,
+		Status:        mapStatusToProto(in.Status),
+		BasePrice:     base,
+		ProductType:   in.ProductType,
+		BundlePricing: valueOrEmpty(in.BundlePricing),
+		InStock:       in.InStock,
+	}
+
+	for _, p := range in.CurrencyPrices {
+		m, err := decimalToProtoMoney(p.Price, p.Currency)
+		if err != nil {
+			return nil, err
+		}
+		out.Prices = append(out.Prices, m)
+	}
+
+	for _, s := range in.ScheduledPriceChanges {
+		m, err := decimalToProtoMoney(s.Price, s.Currency)
+		if err != nil {
+			return nil, err
+		}
+		effectiveAt, err := time.Parse(time.RFC3339Nano, s.EffectiveAt)
+		if err != nil {
+			return nil, err
+		}
+		out.ScheduledPriceChanges = append(out.ScheduledPriceChanges, &productv1.ScheduledPriceChange{
+			Id:          s.ScheduleID,
+			Price:       m,
+			EffectiveAt: timestamppb.New(effectiveAt),
+			Status:      s.Status,
+		})
+	}
+
+	if len(in.Attributes) > 0 {
+		out.Attributes = make(map[string]string, len(in.Attributes))
+		for _, a := range in.Attributes {
+			out.Attributes[a.Name] = a.Value
+		}
+	}
+
+	for _, t := range in.Translations {
+		out.Translations = append(out.Translations, &productv1.Translation{
+			Locale:      t.Locale,
+			Name:        t.Name,
+			Description: valueOrEmpty(t.Description),
+		})
+	}
+
+	for _, m := range in.Media {
+		pm, err := mapMediaToProto(m)
+		if err != nil {
+			return nil, err
+		}
+		out.Media = append(out.Media, pm)
+	}
+
+	if in.BundleDiscount != nil {
+		pct, ok := new(big.Rat).SetString(*in.BundleDiscount)
+		if !ok {
+			return nil, fmt.Errorf("invalid bundle discount: %q", *in.BundleDiscount)
+		}
+		// bundle_discount_percentage is on a 0-100 scale (0.15 => "15").
+		pctStr := pct.Mul(pct, big.NewRat(100, 1)).FloatString(domain.NumericScale)
+		out.BundleDiscountPercentage = strings.TrimSuffix(strings.TrimRight(pctStr, "0"), ".")
+	}
+	for _, c := range in.BundleComponents {
+		pc, err := mapBundleComponentToProto(c, in.PriceCurrency)
+		if err != nil {
+			return nil, err
+		}
+		out.BundleComponents = append(out.BundleComponents, pc)
+	}
+
+	for _, l := range in.StockLevels {
+		updatedAt, err := time.Parse(time.RFC3339Nano, l.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		out.StockLevels = append(out.StockLevels, &productv1.StockLevel{
+			LocationId:        l.LocationID,
+			OnHand:            l.OnHand,
+			Reserved:          l.Reserved,
+			Available:         l.Available,
+			LowStockThreshold: l.LowStockThreshold,
+			UpdatedAt:         timestamppb.New(updatedAt),
+		})
+	}
+
+	for _, c := range in.Channels {
+		pc, err := mapChannelToProto(c, in.Currency)
+		if err != nil {
+			return nil, err
+		}
+		out.Channels = append(out.Channels, pc)
+	}
+	out.AllowedRegions = in.AllowedRegions
+	out.BlockedRegions = in.BlockedRegions
+
+	for _, r := range in.Relations {
+		createdAt, err := time.Parse(time.RFC3339Nano, r.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		out.Relations = append(out.Relations, &productv1.ProductRelation{
+			Type:      r.Type,
+			ProductId: r.ProductID,
+			CreatedAt: timestamppb.New(createdAt),
+		})
+	}
+
+	for _, v := range in.Variants {
+		pv, err := mapVariantToProto(v, in.Currency, in.PriceCurrency)
+		if err != nil {
+			return nil, err
+		}
+		out.Variants = append(out.Variants, pv)
+	}
+
+	if in.CostPrice != nil {
+		m, err := decimalToProtoMoney(*in.CostPrice, in.Currency)
+		if err != nil {
+			return nil, err
+		}
+		out.CostPrice = m
 	}
 
 	if in.Description != nil {
@@ -138,18 +568,64 @@ func mapProductDTOToProto(in *dto.ProductDTO) (*productv1.Product, error) {
 	} else if ts != nil {
 		out.ArchivedAt = timestamppb.New(*ts)
 	}
+	if ts, err := parseRFC3339Ptr(in.AvailableFrom); err != nil {
+		return nil, err
+	} else if ts != nil {
+		out.AvailableFrom = timestamppb.New(*ts)
+	}
+	if ts, err := parseRFC3339Ptr(in.AvailableUntil); err != nil {
+		return nil, err
+	} else if ts != nil {
+		out.AvailableUntil = timestamppb.New(*ts)
+	}
+	out.OnSale = in.OnSale
 
-	// Effective price
-	if in.EffectivePrice != "" {
+	// Effective price (exact) and its rounded form
+	if exact := firstNonEmpty(in.EffectivePriceExact, in.EffectivePrice); exact != "" {
 		rat := new(big.Rat)
-		if _, ok := rat.SetString(in.EffectivePrice); ok {
-			m, err := ratToProtoMoney(rat)
+		if _, ok := rat.SetString(exact); ok {
+			m, err := ratToProtoMoney(rat, in.PriceCurrency)
 			if err != nil {
 				return nil, err
 			}
 			out.EffectivePrice = m
 		}
 	}
+	if in.RoundedPrice != "" {
+		m, err := decimalToProtoMoney(in.RoundedPrice, in.PriceCurrency)
+		if err != nil {
+			return nil, err
+		}
+		out.RoundedPrice = m
+	}
+	out.RoundingMode = in.RoundingMode
+
+	if in.DisplayPrice != "" {
+		m, err := decimalToProtoMoney(in.DisplayPrice, in.DisplayCurrency)
+		if err != nil {
+			return nil, err
+		}
+		out.DisplayPrice = m
+	}
+
+	if in.Tax != nil {
+		tax, err := mapTaxToProto(in.Tax, in.PriceCurrency)
+		if err != nil {
+			return nil, err
+		}
+		out.Tax = tax
+	}
+
+	if in.PackageQuantity != nil && in.UnitOfMeasure != nil {
+		out.PackageSize = &productv1.PackageSize{Quantity: *in.PackageQuantity, Unit: *in.UnitOfMeasure}
+	}
+	if in.UnitPrice != nil {
+		unitPrice, err := mapUnitPriceToProto(in.UnitPrice, in.PriceCurrency)
+		if err != nil {
+			return nil, err
+		}
+		out.UnitPrice = unitPrice
+	}
 
 	// Discount (only if present; whether it is currently active is handled by read side)
 	if in.DiscountPct != nil && in.DiscountStart != nil && in.DiscountEnd != nil {
@@ -173,6 +649,154 @@ func mapProductDTOToProto(in *dto.ProductDTO) (*productv1.Product, error) {
 	return out, nil
 }
 
+// mapVariantToProto maps a variant; its own price is in the product's currency,
+// its effective prices in the price currency of the read.
+func mapVariantToProto(in *dto.VariantDTO, currency, priceCurrency string) (*productv1.Variant, error) {
+	out := &productv1.Variant{
+		Id:      in.VariantID,
+		Sku:     in.SKU,
+		Options: in.Options,
+		Status:  in.Status,
+	}
+	if in.Price != nil {
+		m, err := decimalToProtoMoney(*in.Price, currency)
+		if err != nil {
+			return nil, err
+		}
+		out.Price = m
+	}
+	if in.EffectivePriceExact != "" {
+		rat, ok := new(big.Rat).SetString(in.EffectivePriceExact)
+		if !ok {
+			return nil, fmt.Errorf("invalid variant effective price: %q", in.EffectivePriceExact)
+		}
+		m, err := ratToProtoMoney(rat, priceCurrency)
+		if err != nil {
+			return nil, err
+		}
+		out.EffectivePrice = m
+	}
+	if in.RoundedPrice != "" {
+		m, err := decimalToProtoMoney(in.RoundedPrice, priceCurrency)
+		if err != nil {
+			return nil, err
+		}
+		out.RoundedPrice = m
+	}
+	for _, ts := range []struct {
+		in  string
+		out **timestamppb.Timestamp
+	}{{in.CreatedAt, &out.CreatedAt}, {in.UpdatedAt, &out.UpdatedAt}} {
+		if ts.in == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, ts.in)
+		if err != nil {
+			return nil, err
+		}
+		*ts.out = timestamppb.New(t)
+	}
+	return out, nil
+}
+
+// mapRelationTypes parses the relation types of a related-products listing.
+func mapRelationTypes(in []string) ([]domain.RelationType, error) {
+	out := make([]domain.RelationType, 0, len(in))
+	for _, t := range in {
+		relType, err := domain.ParseRelationType(t)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, relType)
+	}
+	return out, nil
+}
+
+// mapChannelToProto maps a channel assignment whose price override is in the
+// product's primary currency.
+func mapChannelToProto(in *dto.ChannelDTO, currency string) (*productv1.ChannelAssignment, error) {
+	updatedAt, err := time.Parse(time.RFC3339Nano, in.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	out := &productv1.ChannelAssignment{Channel: in.Channel, Active: in.Active, UpdatedAt: timestamppb.New(updatedAt)}
+	if in.PriceOverride != nil {
+		if out.PriceOverride, err = decimalToProtoMoney(*in.PriceOverride, currency); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func mapSetChannelPriceRequest(req *productv1.SetChannelPriceRequest) set_channel_price.Request {
+	out := set_channel_price.Request{
+		ProductID:      req.GetProductId(),
+		Channel:        req.GetChannel(),
+		ClearPrice:     req.GetClearPrice(),
+		OverrideMargin: req.GetOverrideMargin(),
+	}
+	if price := req.GetPrice(); price != nil {
+		num, den := price.GetNumerator(), price.GetDenominator()
+		out.PriceNum, out.PriceDen, out.Currency = &num, &den, price.GetCurrencyCode()
+	}
+	return out
+}
+
+func mapSetProductAvailabilityRequest(req *productv1.SetProductAvailabilityRequest) set_product_availability.Request {
+	out := set_product_availability.Request{ProductID: req.GetProductId()}
+	if req.AvailableFrom != nil {
+		from := req.AvailableFrom.AsTime().UTC()
+		out.AvailableFrom = &from
+	}
+	if req.AvailableUntil != nil {
+		until := req.AvailableUntil.AsTime().UTC()
+		out.AvailableUntil = &until
+	}
+	return out
+}
+
+// mapBundleComponentToProto maps a bundle component whose allocated price is in the
+// bundle's price currency.
+func mapBundleComponentToProto(in *dto.BundleComponentDTO, priceCurrency string) (*productv1.BundleComponent, error) {
+	unitPrice, err := decimalToProtoMoney(in.UnitPrice, in.Currency)
+	if err != nil {
+		return nil, err
+	}
+	out := &productv1.BundleComponent{ProductId: in.ProductID, Quantity: in.Quantity, UnitPrice: unitPrice}
+	if in.AllocatedPrice != "" {
+		if out.AllocatedPrice, err = decimalToProtoMoney(in.AllocatedPrice, priceCurrency); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func mapMediaToProto(in *dto.MediaDTO) (*productv1.Media, error) {
+	out := &productv1.Media{
+		Id:        in.MediaID,
+		Url:       in.URL,
+		Kind:      in.Kind,
+		AltText:   in.AltText,
+		AltTexts:  in.AltTexts,
+		SortOrder: in.SortOrder,
+		Primary:   in.Primary,
+	}
+	for _, ts := range []struct {
+		in  string
+		out **timestamppb.Timestamp
+	}{{in.CreatedAt, &out.CreatedAt}, {in.UpdatedAt, &out.UpdatedAt}} {
+		if ts.in == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, ts.in)
+		if err != nil {
+			return nil, err
+		}
+		*ts.out = timestamppb.New(t)
+	}
+	return out, nil
+}
+
 func mapProductSummariesToProto(items []*dto.ProductSummaryDTO) ([]*productv1.Product, error) {
 	out := make([]*productv1.Product, 0, len(items))
 	for _, it := range items {
@@ -181,26 +805,71 @@ func mapProductSummariesToProto(items []*dto.ProductSummaryDTO) ([]*productv1.Pr
 		}
 
 		p := &productv1.Product{
-			Id:       it.ProductID,
-			Name:     it.Name,
-			Category: it.Category,
-			Status:   mapStatusToProto(it.Status),
+			Id:           it.ProductID,
+			Name:         it.Name,
+			Category:     it.Category,
+			CategoryId:   it.CategoryID,
+			Tags:         it.Tags,
+			Slug:         it.Slug,
+			Locale:       it.Locale,
+			RelationType: it.RelationType,
+			Sku:          valueOrEmpty(it.SKU),
+			Gtin:         valueOrEmpty(it.GTIN),
+			Status:       mapStatusToProto(it.Status),
+			InStock:      it.InStock,
 		}
 
-		if it.EffectivePrice != "" {
+		if exact := firstNonEmpty(it.EffectivePriceExact, it.EffectivePrice); exact != "" {
 			rat := new(big.Rat)
-			if _, ok := rat.SetString(it.EffectivePrice); ok {
-				m, err := ratToProtoMoney(rat)
+			if _, ok := rat.SetString(exact); ok {
+				m, err := ratToProtoMoney(rat, it.Currency)
 				if err != nil {
 					return nil, err
 				}
 				p.EffectivePrice = m
 			}
 		}
+		if it.RoundedPrice != "" {
+			m, err := decimalToProtoMoney(it.RoundedPrice, it.Currency)
+			if err != nil {
+				return nil, err
+			}
+			p.RoundedPrice = m
+		}
+		p.RoundingMode = it.RoundingMode
+
+		if it.DisplayPrice != "" {
+			m, err := decimalToProtoMoney(it.DisplayPrice, it.DisplayCurrency)
+			if err != nil {
+				return nil, err
+			}
+			p.DisplayPrice = m
+		}
+
+		if it.Tax != nil {
+			tax, err := mapTaxToProto(it.Tax, it.Currency)
+			if err != nil {
+				return nil, err
+			}
+			p.Tax = tax
+			p.TaxCategory = it.Tax.Category
+		}
+
+		if it.UnitPrice != nil {
+			unitPrice, err := mapUnitPriceToProto(it.UnitPrice, it.Currency)
+			if err != nil {
+				return nil, err
+			}
+			p.UnitPrice = unitPrice
+		}
 
 		// Best-effort base price if available (added in phase 5 for better API responses).
-		if it.BasePriceDen != 0 {
-			p.BasePrice = &productv1.Money{Numerator: it.BasePriceNum, Denominator: it.BasePriceDen}
+		if it.BasePrice != "" {
+			m, err := decimalToProtoMoney(it.BasePrice, it.Currency)
+			if err != nil {
+				return nil, err
+			}
+			p.BasePrice = m
 		}
 
 		out = append(out, p)
@@ -208,16 +877,78 @@ func mapProductSummariesToProto(items []*dto.ProductSummaryDTO) ([]*productv1.Pr
 	return out, nil
 }
 
-func ratToProtoMoney(r *big.Rat) (*productv1.Money, error) {
+// mapTaxToProto maps a tax breakdown whose amounts are in the given currency.
+func mapTaxToProto(in *dto.TaxDTO, currency string) (*productv1.TaxBreakdown, error) {
+	out := &productv1.TaxBreakdown{
+		Region:      in.Region,
+		TaxCategory: in.Category,
+		Rate:        in.Rate,
+	}
+	for _, f := range []struct {
+		exact string
+		dst   **productv1.Money
+	}{
+		{in.Net, &out.Net},
+		{in.Amount, &out.TaxAmount},
+		{in.Gross, &out.Gross},
+		{in.RoundedGross, &out.RoundedGross},
+	} {
+		m, err := decimalToProtoMoney(f.exact, currency)
+		if err != nil {
+			return nil, err
+		}
+		*f.dst = m
+	}
+	return out, nil
+}
+
+// mapUnitPriceToProto maps a unit price whose amounts are in the given currency.
+func mapUnitPriceToProto(in *dto.UnitPriceDTO, currency string) (*productv1.UnitPrice, error) {
+	price, err := decimalToProtoMoney(in.Price, currency)
+	if err != nil {
+		return nil, err
+	}
+	rounded, err := decimalToProtoMoney(in.RoundedPrice, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &productv1.UnitPrice{Price: price, RoundedPrice: rounded, Unit: in.Unit}, nil
+}
+
+func ratToProtoMoney(r *big.Rat, currency string) (*productv1.Money, error) {
 	if r == nil {
-		return &productv1.Money{Numerator: 0, Denominator: 1}, nil
+		return &productv1.Money{Numerator: 0, Denominator: 1, CurrencyCode: currency}, nil
 	}
 	n := r.Num()
 	d := r.Denom()
 	if !n.IsInt64() || !d.IsInt64() {
-		return nil, fmt.Errorf("money value out of int64 range")
+		return nil, fmt.Errorf("money value %s: %w", r.RatString(), domain.ErrMoneyOverflow)
+	}
+	return &productv1.Money{Numerator: n.Int64(), Denominator: d.Int64(), CurrencyCode: currency}, nil
+}
+
+func decimalToProtoMoney(s, currency string) (*productv1.Money, error) {
+	rat := new(big.Rat)
+	if _, ok := rat.SetString(s); !ok {
+		return nil, fmt.Errorf("invalid decimal money value: %q", s)
 	}
-	return &productv1.Money{Numerator: n.Int64(), Denominator: d.Int64()}, nil
+	return ratToProtoMoney(rat, currency)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
 }
 
 func parseRFC3339Ptr(s *string) (*time.Time, error) {
@@ -246,3 +977,208 @@ func mapStatusToProto(status string) productv1.ProductStatus {
 		return productv1.ProductStatus_PRODUCT_STATUS_UNSPECIFIED
 	}
 }
+
+func mapUpdatePriceListRequest(req *productv1.UpdatePriceListRequest) update_price_list.Request {
+	out := update_price_list.Request{PriceListID: req.GetPriceListId()}
+	if req.Name != nil {
+		v := req.GetName()
+		out.Name = &v
+	}
+	if req.Description != nil {
+		v := req.GetDescription()
+		out.Description = &v
+	}
+	return out
+}
+
+func mapSetPriceListEntryRequest(req *productv1.SetPriceListEntryRequest) (set_price_list_entry.Request, error) {
+	e := req.GetEntry()
+	out := set_price_list_entry.Request{
+		PriceListID: req.GetPriceListId(),
+		ProductID:   e.GetProductId(),
+	}
+
+	if m := e.GetOverridePrice(); m != nil {
+		num, den := m.Numerator, m.Denominator
+		out.OverridePriceNum = &num
+		out.OverridePriceDen = &den
+		out.OverrideCurrency = m.GetCurrencyCode()
+		return out, nil
+	}
+
+	// adjustment_percentage is on a 0-100 scale ("-15" => -0.15).
+	pct := new(big.Rat)
+	if _, ok := pct.SetString(e.GetAdjustmentPercentage()); !ok {
+		return set_price_list_entry.Request{}, fmt.Errorf("invalid entry.adjustment_percentage: %q", e.GetAdjustmentPercentage())
+	}
+	out.Adjustment = pct.Quo(pct, big.NewRat(100, 1))
+	return out, nil
+}
+
+func mapSetTaxRateRequest(req *productv1.SetTaxRateRequest) (set_tax_rate.Request, error) {
+	// percentage is on a 0-100 scale ("19" => 0.19).
+	pct := new(big.Rat)
+	if _, ok := pct.SetString(req.GetPercentage()); !ok {
+		return set_tax_rate.Request{}, fmt.Errorf("invalid percentage: %q", req.GetPercentage())
+	}
+	out := set_tax_rate.Request{
+		Region:      req.GetRegion(),
+		TaxCategory: req.GetTaxCategory(),
+		Rate:        pct.Quo(pct, big.NewRat(100, 1)),
+	}
+	if req.EffectiveAt != nil {
+		out.EffectiveAt = req.EffectiveAt.AsTime().UTC()
+	}
+	return out, nil
+}
+
+func mapSetBundleRequest(req *productv1.SetBundleRequest) (set_bundle.Request, error) {
+	out := set_bundle.Request{
+		ProductID:      req.GetProductId(),
+		Pricing:        req.GetPricing(),
+		OverrideMargin: req.GetOverrideMargin(),
+	}
+	for _, c := range req.GetComponents() {
+		out.Components = append(out.Components, set_bundle.Component{ProductID: c.GetProductId(), Quantity: c.GetQuantity()})
+	}
+	// discount_percentage is on a 0-100 scale ("15" => 15% off).
+	if s := req.GetDiscountPercentage(); s != "" {
+		pct, ok := new(big.Rat).SetString(s)
+		if !ok {
+			return set_bundle.Request{}, fmt.Errorf("invalid discount_percentage: %q", s)
+		}
+		out.DiscountPercent = pct
+	}
+	return out, nil
+}
+
+func mapPriceListDTOToProto(in *dto.PriceListDTO) (*productv1.PriceList, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil price list")
+	}
+
+	out := &productv1.PriceList{
+		Id:      in.PriceListID,
+		Segment: in.Segment,
+		Name:    in.Name,
+	}
+	if in.Description != nil {
+		out.Description = *in.Description
+	}
+
+	if ts, err := parseRFC3339Ptr(in.CreatedAt); err != nil {
+		return nil, err
+	} else if ts != nil {
+		out.CreatedAt = timestamppb.New(*ts)
+	}
+	if ts, err := parseRFC3339Ptr(in.UpdatedAt); err != nil {
+		return nil, err
+	} else if ts != nil {
+		out.UpdatedAt = timestamppb.New(*ts)
+	}
+
+	for _, e := range in.Entries {
+		if e == nil {
+			continue
+		}
+		pe := &productv1.PriceListEntry{ProductId: e.ProductID}
+		switch {
+		case e.OverridePrice != nil:
+			currency := ""
+			if e.OverrideCurrency != nil {
+				currency = *e.OverrideCurrency
+			}
+			money, err := decimalToProtoMoney(*e.OverridePrice, currency)
+			if err != nil {
+				return nil, err
+			}
+			pe.Rule = &productv1.PriceListEntry_OverridePrice{OverridePrice: money}
+		case e.AdjustmentPct != nil:
+			frac := new(big.Rat)
+			if _, ok := frac.SetString(*e.AdjustmentPct); !ok {
+				return nil, fmt.Errorf("invalid stored adjustment: %q", *e.AdjustmentPct)
+			}
+			pct := new(big.Rat).Mul(frac, big.NewRat(100, 1))
+			pe.Rule = &productv1.PriceListEntry_AdjustmentPercentage{
+				AdjustmentPercentage: pct.FloatString(7),
+			}
+		}
+		out.Entries = append(out.Entries, pe)
+	}
+
+	return out, nil
+}
+
+func mapPriceChangeRequestToProto(in *dto.PriceChangeRequestDTO) (*productv1.PriceChangeRequest, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil price change request")
+	}
+
+	out := &productv1.PriceChangeRequest{
+		Id:             in.RequestID,
+		ProductId:      in.ProductID,
+		Kind:           in.Kind,
+		OverrideMargin: in.OverrideMargin,
+		RequestedBy:    in.RequestedBy,
+		Status:         in.Status,
+		DecidedBy:      in.DecidedBy,
+		Reason:         in.Reason,
+	}
+
+	if in.VariantID != nil {
+		out.VariantId = *in.VariantID
+	}
+	if in.Channel != nil {
+		out.Channel = *in.Channel
+	}
+
+	currency := ""
+	if in.Currency != nil {
+		currency = *in.Currency
+	}
+	if in.OldPrice != nil {
+		m, err := decimalToProtoMoney(*in.OldPrice, currency)
+		if err != nil {
+			return nil, err
+		}
+		out.OldPrice = m
+	}
+	if in.NewPrice != nil {
+		m, err := decimalToProtoMoney(*in.NewPrice, currency)
+		if err != nil {
+			return nil, err
+		}
+		out.NewPrice = m
+	}
+
+	if in.DiscountPct != nil {
+		ds, err := parseRFC3339Ptr(in.DiscountStart)
+		if err != nil {
+			return nil, err
+		}
+		de, err := parseRFC3339Ptr(in.DiscountEnd)
+		if err != nil {
+			return nil, err
+		}
+		out.Discount = &productv1.Discount{Percentage: *in.DiscountPct}
+		if ds != nil {
+			out.Discount.StartDate = timestamppb.New(*ds)
+		}
+		if de != nil {
+			out.Discount.EndDate = timestamppb.New(*de)
+		}
+	}
+
+	if ts, err := parseRFC3339Ptr(&in.CreatedAt); err != nil {
+		return nil, err
+	} else if ts != nil {
+		out.CreatedAt = timestamppb.New(*ts)
+	}
+	if ts, err := parseRFC3339Ptr(in.DecidedAt); err != nil {
+		return nil, err
+	} else if ts != nil {
+		out.DecidedAt = timestamppb.New(*ts)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,34 @@
+package product
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDiscountPercentage(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected string // exact percentage, 0-100 scale
+	}{
+		{"12.345", "2469/200"},
+		{"20", "20"},
+		{"0.2", "20"},
+		{"0.12345", "2469/200"},
+		{"33.3333333", "333333333/10000000"},
+		{"99.9999999", "999999999/10000000"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := parseDiscountPercentage(tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got.RatString())
+		})
+	}
+
+	_, err := parseDiscountPercentage("")
+	assert.Error(t, err)
+	_, err = parseDiscountPercentage("12,5")
+	assert.Error(t, err)
+}
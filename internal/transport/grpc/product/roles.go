@@ -0,0 +1,43 @@
+package product
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+)
+
+// roleMetadataKey carries the caller's role. The service trusts the value as
+// set by the authenticating gateway in front of it.
+const roleMetadataKey = "x-user-role"
+
+// callerRole returns the role from the incoming request metadata, or "" if absent.
+func callerRole(ctx context.Context) domain.Role {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(roleMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return domain.Role(strings.ToLower(strings.TrimSpace(values[0])))
+}
+
+// userIDMetadataKey carries the caller's user id, set by the same gateway.
+const userIDMetadataKey = "x-user-id"
+
+// callerID returns the user id from the incoming request metadata, or "" if absent.
+func callerID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(userIDMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0])
+}
@@ -13,8 +13,8 @@ func validateCreateProduct(req *productv1.CreateProductRequest) error {
 	if req.GetName() == "" {
 		return fmt.Errorf("name is required")
 	}
-	if req.GetCategory() == "" {
-		return fmt.Errorf("category is required")
+	if req.GetCategory() == "" && req.GetCategoryId() == "" {
+		return fmt.Errorf("category_id or category is required")
 	}
 	if req.BasePrice == nil {
 		return fmt.Errorf("base_price is required")
@@ -22,6 +22,9 @@ func validateCreateProduct(req *productv1.CreateProductRequest) error {
 	if req.BasePrice.Denominator == 0 {
 		return fmt.Errorf("base_price.denominator must be non-zero")
 	}
+	if req.CostPrice != nil && req.CostPrice.Denominator == 0 {
+		return fmt.Errorf("cost_price.denominator must be non-zero")
+	}
 	return nil
 }
 
@@ -33,9 +36,25 @@ func validateUpdateProduct(req *productv1.UpdateProductRequest) error {
 		return fmt.Errorf("product_id is required")
 	}
 	// At least one field should be present
-	if req.Name == nil && req.Description == nil && req.Category == nil {
+	if req.Name == nil && req.Description == nil && req.Category == nil && req.CategoryId == nil && req.TaxCategory == nil &&
+		req.Sku == nil && req.Gtin == nil && req.DefaultLocale == nil &&
+		req.CostPrice == nil && !req.GetClearCostPrice() &&
+		req.PackageSize == nil && !req.GetClearPackageSize() &&
+		len(req.GetAttributes()) == 0 && !req.GetClearAttributes() {
 		return fmt.Errorf("at least one field must be provided")
 	}
+	if len(req.GetAttributes()) > 0 && req.GetClearAttributes() {
+		return fmt.Errorf("attributes and clear_attributes are mutually exclusive")
+	}
+	if req.CostPrice != nil && req.GetClearCostPrice() {
+		return fmt.Errorf("cost_price and clear_cost_price are mutually exclusive")
+	}
+	if req.PackageSize != nil && req.GetClearPackageSize() {
+		return fmt.Errorf("package_size and clear_package_size are mutually exclusive")
+	}
+	if req.CostPrice != nil && req.CostPrice.Denominator == 0 {
+		return fmt.Errorf("cost_price.denominator must be non-zero")
+	}
 	return nil
 }
 
@@ -60,3 +79,220 @@ func validateApplyDiscount(req *productv1.ApplyDiscountRequest) error {
 	}
 	return nil
 }
+
+func validateSetProductPrice(req *productv1.SetProductPriceRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetProductId() == "" {
+		return fmt.Errorf("product_id is required")
+	}
+	if req.Price == nil {
+		return fmt.Errorf("price is required")
+	}
+	if req.Price.Denominator == 0 {
+		return fmt.Errorf("price.denominator must be non-zero")
+	}
+	return nil
+}
+
+func validateSchedulePriceChange(req *productv1.SchedulePriceChangeRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetProductId() == "" {
+		return fmt.Errorf("product_id is required")
+	}
+	if req.Price == nil {
+		return fmt.Errorf("price is required")
+	}
+	if req.Price.Denominator == 0 {
+		return fmt.Errorf("price.denominator must be non-zero")
+	}
+	if req.EffectiveAt == nil {
+		return fmt.Errorf("effective_at is required")
+	}
+	if err := req.EffectiveAt.CheckValid(); err != nil {
+		return fmt.Errorf("invalid effective_at: %w", err)
+	}
+	return nil
+}
+
+func validateDefineAttribute(req *productv1.DefineAttributeRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetCategory() == "" {
+		return fmt.Errorf("category is required")
+	}
+	if req.GetName() == "" {
+		return fmt.Errorf("name is required")
+	}
+	if req.GetType() == "" {
+		return fmt.Errorf("type is required")
+	}
+	return nil
+}
+
+func validateAddVariant(req *productv1.AddVariantRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetProductId() == "" {
+		return fmt.Errorf("product_id is required")
+	}
+	if req.GetSku() == "" {
+		return fmt.Errorf("sku is required")
+	}
+	if len(req.GetOptions()) == 0 {
+		return fmt.Errorf("options are required")
+	}
+	if req.Price != nil && req.Price.Denominator == 0 {
+		return fmt.Errorf("price.denominator must be non-zero")
+	}
+	return nil
+}
+
+func validateUpdateVariant(req *productv1.UpdateVariantRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetProductId() == "" {
+		return fmt.Errorf("product_id is required")
+	}
+	if req.GetVariantId() == "" {
+		return fmt.Errorf("variant_id is required")
+	}
+	if req.Sku == nil && len(req.GetOptions()) == 0 && req.Price == nil && !req.GetClearPrice() && req.Status == nil {
+		return fmt.Errorf("at least one field must be provided")
+	}
+	if req.Price != nil && req.GetClearPrice() {
+		return fmt.Errorf("price and clear_price are mutually exclusive")
+	}
+	if req.Price != nil && req.Price.Denominator == 0 {
+		return fmt.Errorf("price.denominator must be non-zero")
+	}
+	return nil
+}
+
+func validateSetChannelPrice(req *productv1.SetChannelPriceRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetProductId() == "" {
+		return fmt.Errorf("product_id is required")
+	}
+	if req.GetChannel() == "" {
+		return fmt.Errorf("channel is required")
+	}
+	if (req.Price == nil) == !req.GetClearPrice() {
+		return fmt.Errorf("exactly one of price and clear_price is required")
+	}
+	if req.Price != nil && req.Price.Denominator == 0 {
+		return fmt.Errorf("price.denominator must be non-zero")
+	}
+	return nil
+}
+
+func validateSetProductAvailability(req *productv1.SetProductAvailabilityRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetProductId() == "" {
+		return fmt.Errorf("product_id is required")
+	}
+	if req.AvailableFrom != nil {
+		if err := req.AvailableFrom.CheckValid(); err != nil {
+			return fmt.Errorf("invalid available_from: %w", err)
+		}
+	}
+	if req.AvailableUntil != nil {
+		if err := req.AvailableUntil.CheckValid(); err != nil {
+			return fmt.Errorf("invalid available_until: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateCreatePriceList(req *productv1.CreatePriceListRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetSegment() == "" {
+		return fmt.Errorf("segment is required")
+	}
+	if req.GetName() == "" {
+		return fmt.Errorf("name is required")
+	}
+	return nil
+}
+
+func validateCreateCategory(req *productv1.CreateCategoryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetName() == "" {
+		return fmt.Errorf("name is required")
+	}
+	return nil
+}
+
+func validateUpdatePriceList(req *productv1.UpdatePriceListRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetPriceListId() == "" {
+		return fmt.Errorf("price_list_id is required")
+	}
+	if req.Name == nil && req.Description == nil {
+		return fmt.Errorf("at least one field must be provided")
+	}
+	return nil
+}
+
+func validateSetPriceListEntry(req *productv1.SetPriceListEntryRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetPriceListId() == "" {
+		return fmt.Errorf("price_list_id is required")
+	}
+	if req.Entry == nil {
+		return fmt.Errorf("entry is required")
+	}
+	if req.Entry.GetProductId() == "" {
+		return fmt.Errorf("entry.product_id is required")
+	}
+	switch rule := req.Entry.Rule.(type) {
+	case *productv1.PriceListEntry_OverridePrice:
+		if rule.OverridePrice == nil {
+			return fmt.Errorf("entry.override_price is required")
+		}
+		if rule.OverridePrice.Denominator == 0 {
+			return fmt.Errorf("entry.override_price.denominator must be non-zero")
+		}
+	case *productv1.PriceListEntry_AdjustmentPercentage:
+		if rule.AdjustmentPercentage == "" {
+			return fmt.Errorf("entry.adjustment_percentage is required")
+		}
+	default:
+		return fmt.Errorf("entry requires override_price or adjustment_percentage")
+	}
+	return nil
+}
+
+func validateSetTaxRate(req *productv1.SetTaxRateRequest) error {
+	if req == nil {
+		return fmt.Errorf("request is required")
+	}
+	if req.GetRegion() == "" {
+		return fmt.Errorf("region is required")
+	}
+	if req.GetTaxCategory() == "" {
+		return fmt.Errorf("tax_category is required")
+	}
+	if req.GetPercentage() == "" {
+		return fmt.Errorf("percentage is required")
+	}
+	return nil
+}
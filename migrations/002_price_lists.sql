@@ -0,0 +1,20 @@
+CREATE TABLE price_lists (
+  price_list_id STRING(36) NOT NULL,
+  segment STRING(50) NOT NULL,
+  name STRING(255) NOT NULL,
+  description STRING(MAX),
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (price_list_id);
+
+CREATE UNIQUE INDEX idx_price_lists_segment ON price_lists(segment);
+
+CREATE TABLE price_list_entries (
+  price_list_id STRING(36) NOT NULL,
+  product_id STRING(36) NOT NULL,
+  override_price_numerator INT64,
+  override_price_denominator INT64,
+  adjustment_percent NUMERIC,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (price_list_id, product_id),
+  INTERLEAVE IN PARENT price_lists ON DELETE CASCADE;
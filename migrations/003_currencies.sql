@@ -0,0 +1,12 @@
+ALTER TABLE products ADD COLUMN currency STRING(3) NOT NULL DEFAULT ('USD');
+
+CREATE TABLE product_prices (
+  product_id STRING(36) NOT NULL,
+  currency STRING(3) NOT NULL,
+  price_numerator INT64 NOT NULL,
+  price_denominator INT64 NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, currency),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+ALTER TABLE price_list_entries ADD COLUMN override_currency STRING(3);
@@ -0,0 +1,7 @@
+CREATE TABLE exchange_rates (
+  from_currency STRING(3) NOT NULL,
+  to_currency STRING(3) NOT NULL,
+  effective_at TIMESTAMP NOT NULL,
+  rate NUMERIC NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (from_currency, to_currency, effective_at DESC);
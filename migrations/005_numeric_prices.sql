@@ -0,0 +1,53 @@
+SELECT CONCAT('products/', product_id)
+FROM products
+WHERE SAFE_DIVIDE(CAST(base_price_numerator AS NUMERIC), CAST(base_price_denominator AS NUMERIC)) IS NULL
+   OR SAFE_DIVIDE(CAST(base_price_numerator AS NUMERIC), CAST(base_price_denominator AS NUMERIC)) * CAST(base_price_denominator AS NUMERIC) != CAST(base_price_numerator AS NUMERIC)
+UNION ALL
+SELECT CONCAT('product_prices/', product_id, '/', currency)
+FROM product_prices
+WHERE SAFE_DIVIDE(CAST(price_numerator AS NUMERIC), CAST(price_denominator AS NUMERIC)) IS NULL
+   OR SAFE_DIVIDE(CAST(price_numerator AS NUMERIC), CAST(price_denominator AS NUMERIC)) * CAST(price_denominator AS NUMERIC) != CAST(price_numerator AS NUMERIC)
+UNION ALL
+SELECT CONCAT('price_list_entries/', price_list_id, '/', product_id)
+FROM price_list_entries
+WHERE override_price_numerator IS NOT NULL
+  AND (SAFE_DIVIDE(CAST(override_price_numerator AS NUMERIC), CAST(override_price_denominator AS NUMERIC)) IS NULL
+    OR SAFE_DIVIDE(CAST(override_price_numerator AS NUMERIC), CAST(override_price_denominator AS NUMERIC)) * CAST(override_price_denominator AS NUMERIC) != CAST(override_price_numerator AS NUMERIC));
+
+ALTER TABLE products ADD COLUMN base_price NUMERIC;
+
+ALTER TABLE product_prices ADD COLUMN price NUMERIC;
+
+ALTER TABLE price_list_entries ADD COLUMN override_price NUMERIC;
+
+UPDATE products
+SET base_price = CAST(base_price_numerator AS NUMERIC) / CAST(base_price_denominator AS NUMERIC)
+WHERE CAST(base_price_numerator AS NUMERIC) / CAST(base_price_denominator AS NUMERIC) * CAST(base_price_denominator AS NUMERIC) = CAST(base_price_numerator AS NUMERIC);
+
+UPDATE product_prices
+SET price = CAST(price_numerator AS NUMERIC) / CAST(price_denominator AS NUMERIC)
+WHERE CAST(price_numerator AS NUMERIC) / CAST(price_denominator AS NUMERIC) * CAST(price_denominator AS NUMERIC) = CAST(price_numerator AS NUMERIC);
+
+UPDATE price_list_entries
+SET override_price = CAST(override_price_numerator AS NUMERIC) / CAST(override_price_denominator AS NUMERIC)
+WHERE override_price_numerator IS NOT NULL
+  AND CAST(override_price_numerator AS NUMERIC) / CAST(override_price_denominator AS NUMERIC) * CAST(override_price_denominator AS NUMERIC) = CAST(override_price_numerator AS NUMERIC);
+
+ALTER TABLE products ALTER COLUMN base_price NUMERIC NOT NULL;
+
+ALTER TABLE product_prices ALTER COLUMN price NUMERIC NOT NULL;
+
+ALTER TABLE price_list_entries ADD CONSTRAINT ck_price_list_entry_rule
+  CHECK (override_price IS NOT NULL OR adjustment_percent IS NOT NULL);
+
+ALTER TABLE products DROP COLUMN base_price_numerator;
+
+ALTER TABLE products DROP COLUMN base_price_denominator;
+
+ALTER TABLE product_prices DROP COLUMN price_numerator;
+
+ALTER TABLE product_prices DROP COLUMN price_denominator;
+
+ALTER TABLE price_list_entries DROP COLUMN override_price_numerator;
+
+ALTER TABLE price_list_entries DROP COLUMN override_price_denominator;
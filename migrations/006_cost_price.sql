@@ -0,0 +1 @@
+ALTER TABLE products ADD COLUMN cost_price NUMERIC;
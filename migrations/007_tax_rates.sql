@@ -0,0 +1,13 @@
+ALTER TABLE products ADD COLUMN tax_category STRING(50);
+
+UPDATE products SET tax_category = 'standard' WHERE tax_category IS NULL;
+
+ALTER TABLE products ALTER COLUMN tax_category STRING(50) NOT NULL;
+
+CREATE TABLE tax_rates (
+  region STRING(8) NOT NULL,
+  tax_category STRING(50) NOT NULL,
+  effective_at TIMESTAMP NOT NULL,
+  rate NUMERIC NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (region, tax_category, effective_at DESC);
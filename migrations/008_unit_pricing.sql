@@ -0,0 +1,6 @@
+ALTER TABLE products ADD COLUMN package_quantity NUMERIC;
+
+ALTER TABLE products ADD COLUMN unit_of_measure STRING(8);
+
+ALTER TABLE products ADD CONSTRAINT ck_products_package_size
+  CHECK ((package_quantity IS NULL) = (unit_of_measure IS NULL));
@@ -0,0 +1,14 @@
+CREATE TABLE scheduled_price_changes (
+  product_id STRING(36) NOT NULL,
+  schedule_id STRING(36) NOT NULL,
+  price NUMERIC NOT NULL,
+  currency STRING(3) NOT NULL,
+  effective_at TIMESTAMP NOT NULL,
+  status STRING(20) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, schedule_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_scheduled_price_changes_due
+  ON scheduled_price_changes(status, effective_at);
@@ -0,0 +1,22 @@
+CREATE TABLE price_change_requests (
+  request_id STRING(36) NOT NULL,
+  product_id STRING(36) NOT NULL,
+  kind STRING(20) NOT NULL,
+  currency STRING(3),
+  old_price NUMERIC,
+  new_price NUMERIC,
+  discount_percent NUMERIC,
+  discount_start_date TIMESTAMP,
+  discount_end_date TIMESTAMP,
+  override_margin BOOL NOT NULL,
+  requested_by STRING(100),
+  requested_role STRING(50),
+  status STRING(20) NOT NULL,
+  decided_by STRING(100),
+  decision_reason STRING(1000),
+  created_at TIMESTAMP NOT NULL,
+  decided_at TIMESTAMP
+) PRIMARY KEY (request_id);
+
+CREATE INDEX idx_price_change_requests_product_status
+  ON price_change_requests(product_id, status);
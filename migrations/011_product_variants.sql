@@ -0,0 +1,13 @@
+CREATE TABLE product_variants (
+  product_id STRING(36) NOT NULL,
+  variant_id STRING(36) NOT NULL,
+  sku STRING(64) NOT NULL,
+  option_values JSON NOT NULL,
+  price NUMERIC,
+  status STRING(20) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, variant_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_product_variants_sku ON product_variants(sku);
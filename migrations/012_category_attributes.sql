@@ -0,0 +1,21 @@
+CREATE TABLE attribute_definitions (
+  category STRING(100) NOT NULL,
+  name STRING(50) NOT NULL,
+  attribute_type STRING(20) NOT NULL,
+  required BOOL NOT NULL,
+  enum_values ARRAY<STRING(100)>,
+  unit STRING(20),
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (category, name);
+
+CREATE TABLE product_attributes (
+  product_id STRING(36) NOT NULL,
+  name STRING(50) NOT NULL,
+  value STRING(500) NOT NULL,
+  number_value NUMERIC,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, name),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_attributes_name_value ON product_attributes(name, value);
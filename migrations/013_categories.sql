@@ -0,0 +1,46 @@
+CREATE TABLE categories (
+  category_id STRING(36) NOT NULL,
+  parent_id STRING(36),
+  name STRING(100) NOT NULL,
+  slug STRING(100) NOT NULL,
+  path STRING(300) NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (category_id);
+
+CREATE UNIQUE INDEX idx_categories_slug ON categories(slug);
+
+CREATE INDEX idx_categories_parent ON categories(parent_id);
+
+CREATE INDEX idx_categories_path ON categories(path);
+
+ALTER TABLE products ADD COLUMN category_id STRING(36);
+
+INSERT INTO categories (category_id, parent_id, name, slug, path, created_at, updated_at)
+SELECT SUBSTR(TO_HEX(SHA256(slug)), 1, 32), NULL, name, slug, SUBSTR(TO_HEX(SHA256(slug)), 1, 32), CURRENT_TIMESTAMP(), CURRENT_TIMESTAMP()
+FROM (
+  SELECT COALESCE(NULLIF(TRIM(REGEXP_REPLACE(LOWER(category), r'[^a-z0-9]+', '-'), '-'), ''), 'uncategorized') AS slug, MIN(TRIM(category)) AS name
+  FROM products
+  GROUP BY slug
+);
+
+UPDATE products
+SET category = COALESCE(NULLIF(TRIM(REGEXP_REPLACE(LOWER(category), r'[^a-z0-9]+', '-'), '-'), ''), 'uncategorized'),
+    category_id = SUBSTR(TO_HEX(SHA256(COALESCE(NULLIF(TRIM(REGEXP_REPLACE(LOWER(category), r'[^a-z0-9]+', '-'), '-'), ''), 'uncategorized'))), 1, 32)
+WHERE category_id IS NULL;
+
+ALTER TABLE products ALTER COLUMN category_id STRING(36) NOT NULL;
+
+CREATE INDEX idx_products_category_id ON products(category_id, status);
+
+INSERT INTO attribute_definitions (category, name, attribute_type, required, enum_values, unit, created_at, updated_at)
+SELECT slug, name, ANY_VALUE(attribute_type), LOGICAL_OR(required), ANY_VALUE(enum_values), ANY_VALUE(unit), MIN(created_at), MAX(updated_at)
+FROM (
+  SELECT COALESCE(NULLIF(TRIM(REGEXP_REPLACE(LOWER(category), r'[^a-z0-9]+', '-'), '-'), ''), 'uncategorized') AS slug, name, attribute_type, required, enum_values, unit, created_at, updated_at
+  FROM attribute_definitions
+) d
+WHERE NOT EXISTS (SELECT 1 FROM attribute_definitions e WHERE e.category = d.slug AND e.name = d.name)
+GROUP BY slug, name;
+
+DELETE FROM attribute_definitions
+WHERE category != COALESCE(NULLIF(TRIM(REGEXP_REPLACE(LOWER(category), r'[^a-z0-9]+', '-'), '-'), ''), 'uncategorized');
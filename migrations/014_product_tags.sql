@@ -0,0 +1,8 @@
+CREATE TABLE product_tags (
+  product_id STRING(36) NOT NULL,
+  tag STRING(50) NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, tag),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_tags_tag ON product_tags(tag);
@@ -0,0 +1,7 @@
+ALTER TABLE products ADD COLUMN sku STRING(64);
+
+ALTER TABLE products ADD COLUMN gtin STRING(14);
+
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_sku ON products(sku);
+
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_gtin ON products(gtin);
@@ -0,0 +1,17 @@
+ALTER TABLE products ADD COLUMN slug STRING(120);
+
+UPDATE products
+SET slug = CONCAT(COALESCE(NULLIF(RTRIM(SUBSTR(TRIM(REGEXP_REPLACE(LOWER(name), r'[^a-z0-9]+', '-'), '-'), 1, 100), '-'), ''), 'product'), '-', SUBSTR(REPLACE(LOWER(product_id), '-', ''), 1, 8))
+WHERE slug IS NULL;
+
+ALTER TABLE products ALTER COLUMN slug STRING(120) NOT NULL;
+
+CREATE UNIQUE INDEX idx_products_slug ON products(slug);
+
+CREATE TABLE product_slug_history (
+  slug STRING(120) NOT NULL,
+  product_id STRING(36) NOT NULL,
+  retired_at TIMESTAMP NOT NULL
+) PRIMARY KEY (slug);
+
+CREATE INDEX idx_product_slug_history_product ON product_slug_history(product_id);
@@ -0,0 +1,14 @@
+ALTER TABLE products ADD COLUMN default_locale STRING(10);
+
+UPDATE products SET default_locale = 'en' WHERE default_locale IS NULL;
+
+ALTER TABLE products ALTER COLUMN default_locale STRING(10) NOT NULL;
+
+CREATE TABLE product_translations (
+  product_id STRING(36) NOT NULL,
+  locale STRING(10) NOT NULL,
+  name STRING(255) NOT NULL,
+  description STRING(MAX),
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, locale),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
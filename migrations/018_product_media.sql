@@ -0,0 +1,12 @@
+CREATE TABLE product_media (
+  product_id STRING(36) NOT NULL,
+  media_id STRING(36) NOT NULL,
+  url STRING(2048) NOT NULL,
+  kind STRING(20) NOT NULL,
+  alt_texts JSON NOT NULL,
+  sort_order INT64 NOT NULL,
+  is_primary BOOL NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, media_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,9 @@
+CREATE TABLE product_relations (
+  product_id STRING(36) NOT NULL,
+  relation_type STRING(30) NOT NULL,
+  related_product_id STRING(36) NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, relation_type, related_product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_relations_related ON product_relations(related_product_id, relation_type);
@@ -0,0 +1,19 @@
+ALTER TABLE products ADD COLUMN product_type STRING(10);
+
+UPDATE products SET product_type = 'simple' WHERE product_type IS NULL;
+
+ALTER TABLE products ALTER COLUMN product_type STRING(10) NOT NULL;
+
+ALTER TABLE products ADD COLUMN bundle_pricing STRING(20);
+
+ALTER TABLE products ADD COLUMN bundle_discount NUMERIC;
+
+CREATE TABLE product_bundle_components (
+  product_id STRING(36) NOT NULL,
+  component_product_id STRING(36) NOT NULL,
+  quantity INT64 NOT NULL,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, component_product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE INDEX idx_product_bundle_components_component ON product_bundle_components(component_product_id);
@@ -0,0 +1,19 @@
+CREATE TABLE stock_levels (
+  product_id STRING(36) NOT NULL,
+  location_id STRING(50) NOT NULL,
+  on_hand INT64 NOT NULL,
+  reserved INT64 NOT NULL,
+  low_stock_threshold INT64 NOT NULL,
+  version INT64 NOT NULL,
+  updated_at TIMESTAMP NOT NULL,
+  CONSTRAINT ck_stock_levels_reserved CHECK (reserved >= 0 AND reserved <= on_hand)
+) PRIMARY KEY (product_id, location_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE TABLE stock_reservations (
+  reservation_id STRING(36) NOT NULL,
+  product_id STRING(36) NOT NULL,
+  location_id STRING(50) NOT NULL,
+  quantity INT64 NOT NULL,
+  created_at TIMESTAMP NOT NULL
+) PRIMARY KEY (reservation_id);
@@ -0,0 +1,8 @@
+CREATE TABLE product_channels (
+  product_id STRING(36) NOT NULL,
+  channel STRING(30) NOT NULL,
+  active BOOL NOT NULL,
+  price_override NUMERIC,
+  updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY (product_id, channel),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+ALTER TABLE products ADD COLUMN allowed_regions ARRAY<STRING(2)>;
+
+ALTER TABLE products ADD COLUMN blocked_regions ARRAY<STRING(2)>;
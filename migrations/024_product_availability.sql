@@ -0,0 +1,7 @@
+ALTER TABLE products ADD COLUMN available_from TIMESTAMP;
+
+ALTER TABLE products ADD COLUMN available_until TIMESTAMP;
+
+CREATE INDEX idx_products_available_from ON products(available_from);
+
+CREATE INDEX idx_products_available_until ON products(available_until);
@@ -0,0 +1,5 @@
+ALTER TABLE scheduled_price_changes ADD COLUMN margin_override BOOL;
+
+UPDATE scheduled_price_changes SET margin_override = TRUE WHERE margin_override IS NULL;
+
+ALTER TABLE scheduled_price_changes ALTER COLUMN margin_override BOOL NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE price_change_requests ADD COLUMN variant_id STRING(36);
+
+ALTER TABLE price_change_requests ADD COLUMN channel STRING(30);
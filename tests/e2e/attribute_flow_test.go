@@ -0,0 +1,112 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/define_attribute"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_attribute"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+)
+
+func TestAttributeFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// A fresh category keeps the required attribute away from other tests' products.
+	category := mustCreateCategory(ctx, t, "laptops-"+uuid.New().String()[:8])
+	for _, def := range []define_attribute.Request{
+		{Category: category, Name: "screen_size", Type: "number", Required: true, Unit: "in"},
+		{Category: category, Name: "panel", Type: "enum", EnumValues: []string{"IPS", "OLED"}},
+		{Category: category, Name: "touch", Type: "boolean"},
+	} {
+		require.NoError(t, defineAttributeUC.Execute(ctx, def))
+	}
+	defs, err := readModel.ListAttributeDefinitions(ctx, category)
+	require.NoError(t, err)
+	require.Len(t, defs, 3)
+	assert.Equal(t, "panel", defs[0].Name)
+	assert.Equal(t, []string{"IPS", "OLED"}, defs[0].EnumValues)
+
+	create := func(name string, attrs map[string]string) (string, error) {
+		return createUC.Execute(ctx, create_product.Request{
+			Name: name, Category: category, BasePriceNum: 99900, BasePriceDen: 100, Attributes: attrs,
+		})
+	}
+	_, err = create("No Size", map[string]string{"panel": "ips"})
+	assert.ErrorIs(t, err, domain.ErrMissingRequiredAttribute)
+	_, err = create("Bad Size", map[string]string{"screen_size": "large"})
+	assert.ErrorIs(t, err, domain.ErrInvalidAttributeValue)
+	_, err = create("Unknown", map[string]string{"screen_size": "14", "weight": "1.2"})
+	assert.ErrorIs(t, err, domain.ErrUnknownAttribute)
+
+	smallID, err := create("Ultrabook 13", map[string]string{"screen_size": "13.30", "panel": "oled", "touch": "1"})
+	require.NoError(t, err)
+	largeID, err := create("Workstation 16", map[string]string{"screen_size": "16", "panel": "IPS"})
+	require.NoError(t, err)
+	for _, id := range []string{smallID, largeID} {
+		require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: id}))
+	}
+
+	prod, err := get_product.NewHandler(readModel).Execute(ctx, smallID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	values := map[string]string{}
+	for _, a := range prod.Attributes {
+		values[a.Name] = a.Value
+	}
+	assert.Equal(t, map[string]string{"screen_size": "13.3", "panel": "OLED", "touch": "true"}, values)
+
+	listQ := list_products.NewHandler(readModel)
+	ids := func(filters ...contracts.AttributeFilter) []string {
+		items, err := listQ.Execute(ctx, contracts.ProductFilter{Category: &category, Attributes: filters}, contracts.ProductReadOptions{}, 10, 0)
+		require.NoError(t, err)
+		var out []string
+		for _, it := range items {
+			out = append(out, it.ProductID)
+		}
+		return out
+	}
+	ips, size := "ips", "13.3"
+	assert.ElementsMatch(t, []string{smallID, largeID}, ids())
+	assert.Equal(t, []string{largeID}, ids(contracts.AttributeFilter{Name: "panel", Value: &ips}))
+	assert.Equal(t, []string{smallID}, ids(contracts.AttributeFilter{Name: "screen_size", Value: &size}))
+	assert.Equal(t, []string{largeID}, ids(contracts.AttributeFilter{Name: "screen_size", Min: big.NewRat(15, 1)}))
+	assert.Empty(t, ids(contracts.AttributeFilter{Name: "touch"}, contracts.AttributeFilter{Name: "panel", Value: &ips}))
+
+	// Replacing the attributes drops the ones left out.
+	require.NoError(t, updateUC.Execute(ctx, update_product.Request{
+		ProductID: smallID, Attributes: map[string]string{"screen_size": "14"},
+	}))
+	assert.ElementsMatch(t, []string{smallID, largeID}, ids(contracts.AttributeFilter{Name: "screen_size", Min: big.NewRat(14, 1)}))
+	assert.Empty(t, ids(contracts.AttributeFilter{Name: "touch"}))
+
+	// Moving to a category without a schema only works without attributes.
+	other := mustCreateCategory(ctx, t, "misc-"+uuid.New().String()[:8])
+	err = updateUC.Execute(ctx, update_product.Request{ProductID: largeID, Category: &other})
+	assert.ErrorIs(t, err, domain.ErrUnknownAttribute)
+	require.NoError(t, updateUC.Execute(ctx, update_product.Request{ProductID: largeID, Category: &other, ClearAttributes: true}))
+
+	err = removeAttributeUC.Execute(ctx, remove_attribute.Request{Category: category, Name: "weight"})
+	assert.ErrorIs(t, err, domain.ErrAttributeNotFound)
+	require.NoError(t, removeAttributeUC.Execute(ctx, remove_attribute.Request{Category: category, Name: "touch"}))
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, category) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 3, eventTypes["attribute.defined"])
+	assert.Equal(t, 1, eventTypes["attribute.removed"])
+}
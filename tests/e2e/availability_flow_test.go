@@ -0,0 +1,153 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/repo"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_scheduled_availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+	"github.com/murkotick/product-catalog-service/internal/models/m_product"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	committer "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+func TestAvailabilityFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name: "Winter Jacket", Category: "apparel", BasePriceNum: 12900, BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+
+	launch := clk.Now().Add(24 * time.Hour).Truncate(time.Second)
+	sunset := launch.Add(90 * 24 * time.Hour)
+	err = setAvailabilityUC.Execute(ctx, set_product_availability.Request{ProductID: productID, AvailableFrom: &sunset, AvailableUntil: &launch})
+	assert.ErrorIs(t, err, domain.ErrInvalidAvailabilityWindow)
+	require.NoError(t, setAvailabilityUC.Execute(ctx, set_product_availability.Request{
+		ProductID: productID, AvailableFrom: &launch, AvailableUntil: &sunset,
+	}))
+
+	// Reads honour the window before the scheduler has run.
+	getQ := get_product.NewHandler(readModel)
+	listQ := list_products.NewHandler(readModel)
+	listedAt := func(at time.Time) bool {
+		items, err := listQ.Execute(ctx, contracts.ProductFilter{}, contracts.ProductReadOptions{At: at}, 100, 0)
+		require.NoError(t, err)
+		for _, it := range items {
+			if it.ProductID == productID {
+				return true
+			}
+		}
+		return false
+	}
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "draft", prod.Status)
+	assert.False(t, prod.OnSale)
+	require.NotNil(t, prod.AvailableFrom)
+	assert.Equal(t, launch.UTC().Format(time.RFC3339), *prod.AvailableFrom)
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{At: launch})
+	require.NoError(t, err)
+	assert.True(t, prod.OnSale)
+	assert.False(t, listedAt(launch.Add(-time.Second)))
+	assert.True(t, listedAt(launch))
+	assert.False(t, listedAt(sunset))
+
+	// The scheduler performs the transitions through the regular interactors.
+	runScheduler := func(at time.Time) int {
+		schedClock := clock.NewFake(at)
+		prodRepo, outboxRepo, cm := repo.NewProductRepo(), repo.NewOutboxRepo(), committer.NewAdapter(spClient)
+		scheduler := apply_scheduled_availability.NewInteractor(
+			activate_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, schedClock),
+			deactivate_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, schedClock),
+			readModel, schedClock)
+		performed, err := scheduler.Execute(ctx, apply_scheduled_availability.Request{})
+		require.NoError(t, err)
+		return performed
+	}
+	assert.GreaterOrEqual(t, runScheduler(launch.Add(time.Second)), 1)
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "active", prod.Status)
+	assert.Nil(t, prod.AvailableFrom)
+
+	assert.GreaterOrEqual(t, runScheduler(sunset.Add(time.Second)), 1)
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "inactive", prod.Status)
+	assert.Nil(t, prod.AvailableUntil)
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, productID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 1, eventTypes["product.activated"])
+	assert.Equal(t, 1, eventTypes["product.deactivated"])
+}
+
+func TestAvailabilityWaitsForRegionPrices(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name: "Rain Boots", Category: "apparel", BasePriceNum: 5900, BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+	launch := clk.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, setAvailabilityUC.Execute(ctx, set_product_availability.Request{ProductID: productID, AvailableFrom: &launch}))
+
+	// Rows written before region prices were enforced may ship where the product has no price.
+	_, err = spClient.Apply(ctx, []*spanner.Mutation{spanner.Update(m_product.TableName,
+		[]string{m_product.ColProductID, m_product.ColAllowedRegions}, []interface{}{productID, []string{"DE"}})})
+	require.NoError(t, err)
+
+	getQ := get_product.NewHandler(readModel)
+	listQ := list_products.NewHandler(readModel)
+	at := launch.Add(time.Second)
+	visible := func() (onSale, listed, due bool) {
+		t.Helper()
+		prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{At: at})
+		require.NoError(t, err)
+		items, err := listQ.Execute(ctx, contracts.ProductFilter{}, contracts.ProductReadOptions{At: at}, 100, 0)
+		require.NoError(t, err)
+		for _, it := range items {
+			listed = listed || it.ProductID == productID
+		}
+		transitions, err := readModel.ListDueAvailabilityTransitions(ctx, at, 1000)
+		require.NoError(t, err)
+		for _, d := range transitions {
+			due = due || d.ProductID == productID
+		}
+		return prod.OnSale, listed, due
+	}
+
+	// Activate would refuse the launch, so reads do not show it and the scheduler skips it.
+	onSale, listed, due := visible()
+	assert.False(t, onSale)
+	assert.False(t, listed)
+	assert.False(t, due)
+
+	_, err = setPriceUC.Execute(ctx, set_product_price.Request{ProductID: productID, PriceNum: 5500, PriceDen: 100, Currency: "EUR"})
+	require.NoError(t, err)
+	onSale, listed, due = visible()
+	assert.True(t, onSale)
+	assert.True(t, listed)
+	assert.True(t, due)
+}
@@ -0,0 +1,85 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_bundle"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+)
+
+func TestBundleFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	create := func(name string, price int64) string {
+		id, err := createUC.Execute(ctx, create_product.Request{
+			Name: name, Category: "grocery", BasePriceNum: price, BasePriceDen: 100,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	soapID := create("Olive Soap", 500)
+	towelID := create("Linen Towel", 2000)
+	giftID := create("Bath Gift Set", 100)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: giftID}))
+
+	require.NoError(t, setBundleUC.Execute(ctx, set_bundle.Request{
+		ProductID: giftID,
+		Pricing:   "components_sum",
+		Components: []set_bundle.Component{
+			{ProductID: soapID, Quantity: 2},
+			{ProductID: towelID, Quantity: 1},
+		},
+		DiscountPercent: big.NewRat(20, 1),
+	}))
+
+	getQ := get_product.NewHandler(readModel)
+	gift, err := getQ.Execute(ctx, giftID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "bundle", gift.ProductType)
+	require.NotNil(t, gift.BundlePricing)
+	assert.Equal(t, "components_sum", *gift.BundlePricing)
+	assert.Equal(t, "24", gift.EffectivePrice) // (2*5 + 20) less 20%
+	require.Len(t, gift.BundleComponents, 2)
+	allocated := map[string]string{}
+	for _, c := range gift.BundleComponents {
+		allocated[c.ProductID] = c.AllocatedPrice
+	}
+	assert.Equal(t, map[string]string{soapID: "8", towelID: "16"}, allocated)
+
+	// The price follows the components and can't be set directly.
+	_, err = setPriceUC.Execute(ctx, set_product_price.Request{ProductID: giftID, PriceNum: 3000, PriceDen: 100})
+	assert.ErrorIs(t, err, domain.ErrBundlePriceDerived)
+
+	// A bundle can't be a component of another bundle.
+	err = setBundleUC.Execute(ctx, set_bundle.Request{
+		ProductID: create("Spa Hamper", 100), Pricing: "fixed",
+		Components: []set_bundle.Component{{ProductID: giftID, Quantity: 1}},
+	})
+	assert.ErrorIs(t, err, domain.ErrNestedBundle)
+
+	// Archiving a component takes the bundle off sale.
+	require.NoError(t, archiveProductUC.Execute(ctx, archive_product.Request{ProductID: soapID}))
+	gift, err = getQ.Execute(ctx, giftID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "inactive", gift.Status)
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, giftID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 1, eventTypes["product.deactivated"])
+}
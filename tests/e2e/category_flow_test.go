@@ -0,0 +1,111 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/categories"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/delete_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/move_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_category"
+)
+
+func TestCategoryTreeFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	suffix := uuid.New().String()[:8]
+	create := func(name, parentID string) string {
+		id, err := createCategoryUC.Execute(ctx, create_category.Request{Name: name + " " + suffix, ParentID: parentID})
+		require.NoError(t, err)
+		return id
+	}
+	electronicsID := create("Electronics", "")
+	audioID := create("Audio", electronicsID)
+	headphonesID := create("Headphones", audioID)
+	mediaID := create("Media", "")
+
+	_, err := createCategoryUC.Execute(ctx, create_category.Request{Name: "AUDIO  " + suffix})
+	assert.ErrorIs(t, err, domain.ErrCategorySlugTaken)
+
+	catQ := categories.NewHandler(readModel)
+	headphones, err := catQ.Get(ctx, headphonesID)
+	require.NoError(t, err)
+	assert.Equal(t, "headphones-"+suffix, headphones.Slug)
+	assert.Equal(t, electronicsID+"/"+audioID+"/"+headphonesID, headphones.Path)
+	children, err := catQ.List(ctx, electronicsID)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	assert.Equal(t, audioID, children[0].CategoryID)
+
+	// Products reference categories by ID; the legacy category field resolves by slug.
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Studio Headphones",
+		CategoryID:   headphonesID,
+		BasePriceNum: 19900,
+		BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: productID}))
+	_, err = createUC.Execute(ctx, create_product.Request{Name: "Orphan", Category: "no-such-" + suffix, BasePriceNum: 100, BasePriceDen: 100})
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+
+	// Listing by category ID includes the whole subtree.
+	listQ := list_products.NewHandler(readModel)
+	ids := func(categoryID string) []string {
+		items, err := listQ.Execute(ctx, contracts.ProductFilter{CategoryID: &categoryID}, contracts.ProductReadOptions{}, 10, 0)
+		require.NoError(t, err)
+		out := make([]string, 0, len(items))
+		for _, it := range items {
+			out = append(out, it.ProductID)
+		}
+		return out
+	}
+	assert.Equal(t, []string{productID}, ids(electronicsID))
+	assert.Empty(t, ids(mediaID))
+
+	// Renaming keeps the slug; cycles are rejected; moving carries the subtree along.
+	require.NoError(t, updateCategoryUC.Execute(ctx, update_category.Request{CategoryID: audioID, Name: "Hi-Fi " + suffix}))
+	err = moveCategoryUC.Execute(ctx, move_category.Request{CategoryID: electronicsID, ParentID: headphonesID})
+	assert.ErrorIs(t, err, domain.ErrCategoryCycle)
+	require.NoError(t, moveCategoryUC.Execute(ctx, move_category.Request{CategoryID: audioID, ParentID: mediaID}))
+
+	audio, err := catQ.Get(ctx, audioID)
+	require.NoError(t, err)
+	assert.Equal(t, "Hi-Fi "+suffix, audio.Name)
+	assert.Equal(t, "audio-"+suffix, audio.Slug)
+	headphones, err = catQ.Get(ctx, headphonesID)
+	require.NoError(t, err)
+	assert.Equal(t, mediaID+"/"+audioID+"/"+headphonesID, headphones.Path)
+	assert.Empty(t, ids(electronicsID))
+	assert.Equal(t, []string{productID}, ids(mediaID))
+
+	// Only empty categories can be deleted.
+	err = deleteCategoryUC.Execute(ctx, delete_category.Request{CategoryID: mediaID})
+	assert.ErrorIs(t, err, domain.ErrCategoryNotEmpty)
+	err = deleteCategoryUC.Execute(ctx, delete_category.Request{CategoryID: headphonesID})
+	assert.ErrorIs(t, err, domain.ErrCategoryNotEmpty)
+	require.NoError(t, deleteCategoryUC.Execute(ctx, delete_category.Request{CategoryID: electronicsID}))
+	_, err = catQ.Get(ctx, electronicsID)
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, audioID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 1, eventTypes["category.created"])
+	assert.Equal(t, 1, eventTypes["category.updated"])
+	assert.Equal(t, 1, eventTypes["category.moved"])
+}
@@ -0,0 +1,90 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_channel"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/deactivate_channel"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_channel_price"
+)
+
+func TestChannelFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	create := func(name string) string {
+		id, err := createUC.Execute(ctx, create_product.Request{
+			Name: name, Category: "furniture", BasePriceNum: 8000, BasePriceDen: 100,
+		})
+		require.NoError(t, err)
+		require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: id}))
+		return id
+	}
+	chairID := create("Oak Chair")
+	stoolID := create("Oak Stool")
+
+	const marketplace = "marketplace-x"
+	require.NoError(t, activateChannelUC.Execute(ctx, activate_channel.Request{ProductID: chairID, Channel: "Web"}))
+	require.NoError(t, activateChannelUC.Execute(ctx, activate_channel.Request{ProductID: chairID, Channel: marketplace}))
+	require.NoError(t, activateChannelUC.Execute(ctx, activate_channel.Request{ProductID: stoolID, Channel: "web"}))
+	num, den := int64(9500), int64(100)
+	_, err := setChannelPriceUC.Execute(ctx, set_channel_price.Request{
+		ProductID: chairID, Channel: marketplace, PriceNum: &num, PriceDen: &den,
+	})
+	require.NoError(t, err)
+	err = activateChannelUC.Execute(ctx, activate_channel.Request{ProductID: chairID, Channel: "web"})
+	assert.ErrorIs(t, err, domain.ErrChannelAlreadyActive)
+
+	getQ := get_product.NewHandler(readModel)
+	chair, err := getQ.Execute(ctx, chairID, contracts.ProductReadOptions{Channel: marketplace})
+	require.NoError(t, err)
+	assert.Equal(t, "95", chair.EffectivePrice)
+	require.Len(t, chair.Channels, 2)
+	assert.Equal(t, marketplace, chair.Channels[0].Channel)
+
+	chair, err = getQ.Execute(ctx, chairID, contracts.ProductReadOptions{Channel: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, "80", chair.EffectivePrice)
+
+	_, err = getQ.Execute(ctx, stoolID, contracts.ProductReadOptions{Channel: marketplace})
+	assert.ErrorIs(t, err, domain.ErrNotActiveOnChannel)
+
+	listQ := list_products.NewHandler(readModel)
+	listed := func(channel string) map[string]string {
+		items, err := listQ.Execute(ctx, contracts.ProductFilter{}, contracts.ProductReadOptions{Channel: channel}, 100, 0)
+		require.NoError(t, err)
+		out := map[string]string{}
+		for _, it := range items {
+			out[it.ProductID] = it.EffectivePrice
+		}
+		return out
+	}
+	onMarketplace := listed(marketplace)
+	assert.Equal(t, "95", onMarketplace[chairID])
+	assert.NotContains(t, onMarketplace, stoolID)
+
+	// Deactivating on one channel leaves the others as they were.
+	require.NoError(t, deactivateChannelUC.Execute(ctx, deactivate_channel.Request{ProductID: chairID, Channel: marketplace}))
+	assert.NotContains(t, listed(marketplace), chairID)
+	assert.Contains(t, listed("web"), chairID)
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, chairID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 2, eventTypes["product.channel_activated"])
+	assert.Equal(t, 1, eventTypes["product.channel_deactivated"])
+	assert.Equal(t, 1, eventTypes["price.channel_changed"])
+}
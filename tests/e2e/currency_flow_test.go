@@ -0,0 +1,138 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/import_exchange_rates"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+)
+
+func TestMultiCurrencyPriceFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	category := mustCreateCategory(ctx, t, "currency-"+time.Now().UTC().Format("150405.000000"))
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Euro Product",
+		Category:     category,
+		BasePriceNum: 2500,
+		BasePriceDen: 100,
+		Currency:     "EUR",
+	})
+	require.NoError(t, err)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: productID}))
+
+	_, err = setPriceUC.Execute(ctx, set_product_price.Request{
+		ProductID: productID,
+		PriceNum:  2799,
+		PriceDen:  100,
+		Currency:  "USD",
+	})
+	require.NoError(t, err)
+
+	getQ := get_product.NewHandler(readModel)
+
+	primary, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", primary.Currency)
+	assert.Equal(t, "EUR", primary.PriceCurrency)
+	assert.Equal(t, "25.0000000000", primary.EffectivePrice)
+	assert.Equal(t, "25", primary.EffectivePriceExact)
+	assert.Equal(t, "25.00", primary.RoundedPrice)
+	assert.Equal(t, "half_up", primary.RoundingMode)
+	require.Len(t, primary.CurrencyPrices, 1)
+	assert.Equal(t, "USD", primary.CurrencyPrices[0].Currency)
+
+	usd, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{Currency: "USD"})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", usd.PriceCurrency)
+	assert.Equal(t, "27.9900000000", usd.EffectivePrice)
+
+	_, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{Currency: "GBP"})
+	assert.ErrorIs(t, err, domain.ErrNoPriceInCurrency)
+
+	listQ := list_products.NewHandler(readModel)
+	items, err := listQ.Execute(ctx, contracts.ProductFilter{Category: &category}, contracts.ProductReadOptions{Currency: "USD"}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "USD", items[0].Currency)
+
+	items, err = listQ.Execute(ctx, contracts.ProductFilter{Category: &category}, contracts.ProductReadOptions{Currency: "GBP"}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	// The primary currency price cannot be removed; additional ones can.
+	err = removePriceUC.Execute(ctx, remove_product_price.Request{ProductID: productID, Currency: "EUR"})
+	assert.ErrorIs(t, err, domain.ErrCannotRemovePrimaryPrice)
+	require.NoError(t, removePriceUC.Execute(ctx, remove_product_price.Request{ProductID: productID, Currency: "USD"}))
+
+	_, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{Currency: "USD"})
+	assert.ErrorIs(t, err, domain.ErrNoPriceInCurrency)
+
+	events := mustFetchOutboxEvents(ctx, t, spClient, productID)
+	types := make([]string, 0, len(events))
+	for _, e := range events {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "price.changed")
+	assert.Contains(t, types, "price.currency_removed")
+}
+
+func TestDisplayCurrencyConversion(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	category := mustCreateCategory(ctx, t, "display-"+time.Now().UTC().Format("150405.000000"))
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Display Product",
+		Category:     category,
+		BasePriceNum: 1999,
+		BasePriceDen: 100,
+		Currency:     "EUR",
+	})
+	require.NoError(t, err)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: productID}))
+
+	getQ := get_product.NewHandler(readModel)
+
+	// No rate yet: conversion is refused rather than guessed.
+	_, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{DisplayCurrency: "JPY"})
+	assert.ErrorIs(t, err, domain.ErrExchangeRateNotFound)
+
+	// Only the reverse pair is stored; it is inverted on read. 1 JPY = 0.00625 EUR => 1 EUR = 160 JPY.
+	n, err := importRatesUC.Execute(ctx, import_exchange_rates.Request{Rates: []import_exchange_rates.Rate{
+		{From: "JPY", To: "EUR", Rate: big.NewRat(625, 100000), EffectiveAt: time.Now().UTC().Add(-time.Hour)},
+		// Future rates are ignored until they take effect.
+		{From: "JPY", To: "EUR", Rate: big.NewRat(1, 100), EffectiveAt: time.Now().UTC().Add(24 * time.Hour)},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// 19.99 EUR * 160 = 3198.4 JPY, rounded to whole yen.
+	got, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{DisplayCurrency: "JPY"})
+	require.NoError(t, err)
+	assert.Equal(t, "JPY", got.DisplayCurrency)
+	assert.Equal(t, "3198", got.DisplayPrice)
+	assert.Equal(t, "19.9900000000", got.EffectivePrice)
+
+	listQ := list_products.NewHandler(readModel)
+	items, err := listQ.Execute(ctx, contracts.ProductFilter{Category: &category}, contracts.ProductReadOptions{DisplayCurrency: "JPY"}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "3198", items[0].DisplayPrice)
+}
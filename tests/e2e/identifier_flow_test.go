@@ -0,0 +1,91 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+)
+
+// randomEAN13 returns a random EAN-13 with a valid check digit, so repeated runs
+// against the same database do not collide on the unique GTIN index.
+func randomEAN13() string {
+	body := fmt.Sprintf("%012d", rand.Int63n(1_000_000_000_000))
+	sum := 0
+	for i, r := range body {
+		d := int(r - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return body + fmt.Sprint((10-sum%10)%10)
+}
+
+func TestProductIdentifierFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	suffix := uuid.New().String()[:8]
+	sku := "KB-" + suffix
+	ean := randomEAN13()
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name: "Keyboard", Category: "electronics", SKU: sku, GTIN: ean,
+		BasePriceNum: 4999, BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+
+	// SKUs are shared with variants; GTINs compare in their GTIN-14 form.
+	_, err = createUC.Execute(ctx, create_product.Request{
+		Name: "Copy", Category: "electronics", SKU: sku, BasePriceNum: 100, BasePriceDen: 100,
+	})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+	_, err = createUC.Execute(ctx, create_product.Request{
+		Name: "Copy", Category: "electronics", GTIN: "0" + ean, BasePriceNum: 100, BasePriceDen: 100,
+	})
+	assert.ErrorIs(t, err, domain.ErrDuplicateGTIN)
+	_, _, err = addVariantUC.Execute(ctx, add_variant.Request{ProductID: productID, SKU: sku, Options: map[string]string{"layout": "us"}})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+	_, _, err = addVariantUC.Execute(ctx, add_variant.Request{ProductID: productID, SKU: sku + "-DE", Options: map[string]string{"layout": "de"}})
+	require.NoError(t, err)
+
+	// Lookups resolve product and variant SKUs, and any form of the GTIN.
+	getQ := get_product.NewHandler(readModel)
+	for _, s := range []string{sku, sku + "-DE"} {
+		p, err := getQ.ExecuteBySKU(ctx, s, contracts.ProductReadOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, productID, p.ProductID)
+	}
+	p, err := getQ.ExecuteByGTIN(ctx, "0"+ean, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, productID, p.ProductID)
+	require.NotNil(t, p.GTIN)
+	assert.Equal(t, "0"+ean, *p.GTIN)
+	_, err = getQ.ExecuteBySKU(ctx, "missing-"+suffix, contracts.ProductReadOptions{})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	// Clearing the identifiers frees them for other products.
+	empty := ""
+	require.NoError(t, updateUC.Execute(ctx, update_product.Request{ProductID: productID, SKU: &empty, GTIN: &empty}))
+	p, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, p.SKU)
+	assert.Nil(t, p.GTIN)
+	_, err = createUC.Execute(ctx, create_product.Request{
+		Name: "Keyboard v2", Category: "electronics", SKU: sku, GTIN: ean, BasePriceNum: 5999, BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package e2e
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/adjust_stock"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/release_reservation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reserve_stock"
+)
+
+func TestInventoryFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	create := func(name string) string {
+		id, err := createUC.Execute(ctx, create_product.Request{
+			Name: name, Category: "stationery", BasePriceNum: 499, BasePriceDen: 100,
+		})
+		require.NoError(t, err)
+		require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: id}))
+		return id
+	}
+	notebookID := create("Dotted Notebook")
+	penID := create("Fountain Pen")
+
+	threshold := int64(2)
+	require.NoError(t, adjustStockUC.Execute(ctx, adjust_stock.Request{
+		ProductID: notebookID, LocationID: "Berlin-1", Delta: 5, LowStockThreshold: &threshold,
+	}))
+	require.NoError(t, adjustStockUC.Execute(ctx, adjust_stock.Request{ProductID: notebookID, LocationID: "hamburg", Delta: 1}))
+	err := adjustStockUC.Execute(ctx, adjust_stock.Request{ProductID: notebookID, LocationID: "hamburg", Delta: -2})
+	assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+
+	// Concurrent reservations never oversell: 5 units go to at most two orders of 2.
+	var (
+		wg           sync.WaitGroup
+		mu           sync.Mutex
+		reservations []string
+		insufficient int
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := reserveStockUC.Execute(ctx, reserve_stock.Request{ProductID: notebookID, LocationID: "berlin-1", Quantity: 2})
+			mu.Lock()
+			defer mu.Unlock()
+			if err == nil {
+				reservations = append(reservations, id)
+				return
+			}
+			assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+			insufficient++
+		}()
+	}
+	wg.Wait()
+	require.Len(t, reservations, 2)
+	assert.Equal(t, 2, insufficient)
+
+	getQ := get_product.NewHandler(readModel)
+	levels := func() map[string][3]int64 {
+		p, err := getQ.Execute(ctx, notebookID, contracts.ProductReadOptions{})
+		require.NoError(t, err)
+		out := map[string][3]int64{}
+		for _, l := range p.StockLevels {
+			out[l.LocationID] = [3]int64{l.OnHand, l.Reserved, l.Available}
+		}
+		return out
+	}
+	assert.Equal(t, map[string][3]int64{"berlin-1": {5, 4, 1}, "hamburg": {1, 0, 1}}, levels())
+
+	require.NoError(t, releaseReservationUC.Execute(ctx, release_reservation.Request{ReservationID: reservations[0], Fulfilled: true}))
+	require.NoError(t, releaseReservationUC.Execute(ctx, release_reservation.Request{ReservationID: reservations[1]}))
+	assert.Equal(t, map[string][3]int64{"berlin-1": {3, 0, 3}, "hamburg": {1, 0, 1}}, levels())
+	err = releaseReservationUC.Execute(ctx, release_reservation.Request{ReservationID: reservations[1]})
+	assert.ErrorIs(t, err, domain.ErrReservationNotFound)
+
+	listQ := list_products.NewHandler(readModel)
+	inStock := map[string]bool{}
+	items, err := listQ.Execute(ctx, contracts.ProductFilter{InStock: true}, contracts.ProductReadOptions{}, 100, 0)
+	require.NoError(t, err)
+	for _, it := range items {
+		inStock[it.ProductID] = it.InStock
+	}
+	assert.True(t, inStock[notebookID])
+	assert.NotContains(t, inStock, penID)
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, notebookID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 6, eventTypes["stock.level_changed"])
+	assert.Equal(t, 1, eventTypes["stock.low"]) // berlin-1 fell to 1 available
+}
@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reorder_media"
+)
+
+func TestMediaFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name: "Espresso Machine", Category: "appliances", BasePriceNum: 19900, BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+
+	add := func(url, kind string, altTexts map[string]string, primary bool) string {
+		id, err := addMediaUC.Execute(ctx, add_media.Request{
+			ProductID: productID, URL: url, Kind: kind, AltTexts: altTexts, Primary: primary,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	videoID := add("https://media.example.com/espresso/demo.mp4", "video", nil, false)
+	frontID := add("https://media.example.com/espresso/front.jpg", "image",
+		map[string]string{"en": "Front view", "de": "Vorderansicht"}, false)
+	sideID := add("https://media.example.com/espresso/side.jpg", "image", nil, false)
+
+	_, err = addMediaUC.Execute(ctx, add_media.Request{ProductID: productID, URL: "https://media.example.com/espresso/side.jpg", Kind: "image"})
+	assert.ErrorIs(t, err, domain.ErrDuplicateMediaURL)
+	_, err = addMediaUC.Execute(ctx, add_media.Request{ProductID: productID, URL: "media/espresso.jpg", Kind: "image"})
+	assert.ErrorIs(t, err, domain.ErrInvalidMediaURL)
+
+	getQ := get_product.NewHandler(readModel)
+	media := func(locales ...string) []*productMedia {
+		p, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{Locales: locales})
+		require.NoError(t, err)
+		out := make([]*productMedia, 0, len(p.Media))
+		for _, m := range p.Media {
+			out = append(out, &productMedia{id: m.MediaID, primary: m.Primary, altText: m.AltText})
+		}
+		return out
+	}
+
+	// The first image became primary; alt texts follow the reader's locale.
+	got := media("de-AT")
+	require.Len(t, got, 3)
+	assert.Equal(t, []string{videoID, frontID, sideID}, mediaIDs(got))
+	assert.True(t, got[1].primary)
+	assert.Equal(t, "Vorderansicht", got[1].altText)
+	assert.Equal(t, "Front view", media("fr")[1].altText)
+
+	require.NoError(t, reorderMediaUC.Execute(ctx, reorder_media.Request{
+		ProductID: productID, MediaIDs: []string{sideID, frontID, videoID}, PrimaryMediaID: sideID,
+	}))
+	got = media()
+	assert.Equal(t, []string{sideID, frontID, videoID}, mediaIDs(got))
+	assert.True(t, got[0].primary)
+	assert.False(t, got[1].primary)
+
+	err = reorderMediaUC.Execute(ctx, reorder_media.Request{ProductID: productID, MediaIDs: []string{sideID, frontID}})
+	assert.ErrorIs(t, err, domain.ErrInvalidMediaOrder)
+	err = reorderMediaUC.Execute(ctx, reorder_media.Request{ProductID: productID, PrimaryMediaID: videoID})
+	assert.ErrorIs(t, err, domain.ErrPrimaryMediaNotImage)
+
+	// Removing the primary image promotes the next image.
+	require.NoError(t, removeMediaUC.Execute(ctx, remove_media.Request{ProductID: productID, MediaID: sideID}))
+	got = media()
+	assert.Equal(t, []string{frontID, videoID}, mediaIDs(got))
+	assert.True(t, got[0].primary)
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, productID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 5, eventTypes["product.updated"])
+}
+
+type productMedia struct {
+	id      string
+	primary bool
+	altText string
+}
+
+func mediaIDs(media []*productMedia) []string {
+	out := make([]string, 0, len(media))
+	for _, m := range media {
+		out = append(out, m.id)
+	}
+	return out
+}
@@ -0,0 +1,267 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/dto"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_discount"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/approve_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reject_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_channel_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_variant"
+)
+
+func TestPriceApprovalFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Standing Desk",
+		Category:     "furniture",
+		BasePriceNum: 49900,
+		BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: productID}))
+	getQ := get_product.NewHandler(readModel)
+
+	// A small change goes live right away.
+	pendingID, err := guardedSetPriceUC.Execute(ctx, set_product_price.Request{
+		ProductID: productID, PriceNum: 44900, PriceDen: 100, Actor: "alice", Role: domain.RoleEditor,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, pendingID)
+
+	// A mistyped price (-90%) is held back until a second actor approves it.
+	pendingID, err = guardedSetPriceUC.Execute(ctx, set_product_price.Request{
+		ProductID: productID, PriceNum: 4490, PriceDen: 100, Actor: "alice", Role: domain.RoleEditor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, pendingID)
+
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "449", prod.BasePrice)
+
+	// The scheduler applies changes without a second actor, so the same price cannot be scheduled.
+	_, err = guardedSchedulePriceUC.Execute(ctx, schedule_price_change.Request{
+		ProductID: productID, PriceNum: 4490, PriceDen: 100, EffectiveAt: time.Now().Add(time.Hour), Role: domain.RoleEditor,
+	})
+	assert.ErrorIs(t, err, domain.ErrScheduledPriceNeedsApproval)
+
+	request, err := readModel.GetPriceChangeRequest(ctx, pendingID)
+	require.NoError(t, err)
+	assert.Equal(t, "pending", request.Status)
+	assert.Equal(t, "44.9", *request.NewPrice)
+
+	err = approveChangeUC.Execute(ctx, approve_price_change.Request{RequestID: pendingID, Actor: "alice", Role: domain.RoleAdmin})
+	assert.ErrorIs(t, err, domain.ErrSelfApprovalNotAllowed)
+	err = approveChangeUC.Execute(ctx, approve_price_change.Request{RequestID: pendingID, Actor: "bob", Role: domain.RoleEditor})
+	assert.ErrorIs(t, err, domain.ErrApprovalNotAllowed)
+
+	require.NoError(t, approveChangeUC.Execute(ctx, approve_price_change.Request{
+		RequestID: pendingID, Actor: "bob", Role: domain.RolePricingManager,
+	}))
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "44.9", prod.BasePrice)
+
+	err = approveChangeUC.Execute(ctx, approve_price_change.Request{RequestID: pendingID, Actor: "bob", Role: domain.RolePricingManager})
+	assert.ErrorIs(t, err, domain.ErrPriceChangeRequestNotPending)
+
+	requestTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, pendingID) {
+		requestTypes[ev.EventType]++
+	}
+	assert.Equal(t, 1, requestTypes["price_change_request.requested"])
+	assert.Equal(t, 1, requestTypes["price_change_request.approved"])
+
+	// A deep discount is rejected and never applied.
+	now := time.Now().UTC()
+	pendingID, err = guardedApplyDisUC.Execute(ctx, apply_discount.Request{
+		ProductID:  productID,
+		Percentage: big.NewRat(80, 1),
+		StartDate:  now.Add(-time.Hour),
+		EndDate:    now.Add(time.Hour),
+		Actor:      "alice",
+		Role:       domain.RoleEditor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, pendingID)
+
+	require.NoError(t, rejectChangeUC.Execute(ctx, reject_price_change.Request{
+		RequestID: pendingID, Actor: "bob", Role: domain.RoleAdmin, Reason: "too deep",
+	}))
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, prod.DiscountPct)
+
+	all, err := readModel.ListPriceChangeRequests(ctx, productID, "", 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+	rejected, err := readModel.ListPriceChangeRequests(ctx, productID, "rejected", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, rejected, 1)
+	assert.Equal(t, pendingID, rejected[0].RequestID)
+	assert.Equal(t, "too deep", rejected[0].Reason)
+}
+
+func TestVariantAndChannelPriceApprovalFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Hoodie",
+		Category:     "apparel",
+		BasePriceNum: 6000,
+		BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+	getQ := get_product.NewHandler(readModel)
+	approve := func(requestID string) {
+		t.Helper()
+		require.NoError(t, approveChangeUC.Execute(ctx, approve_price_change.Request{
+			RequestID: requestID, Actor: "bob", Role: domain.RolePricingManager,
+		}))
+	}
+	variantPrice := func(variantID string) (*string, string) {
+		t.Helper()
+		prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+		require.NoError(t, err)
+		for _, v := range prod.Variants {
+			if v.VariantID == variantID {
+				return v.Price, v.Status
+			}
+		}
+		t.Fatalf("variant %s not found", variantID)
+		return nil, ""
+	}
+
+	// A variant priced far below the base price is added at the base price meanwhile.
+	num, den := int64(1500), int64(100)
+	variantID, pendingID, err := guardedAddVariantUC.Execute(ctx, add_variant.Request{
+		ProductID: productID, SKU: "HOODIE-" + productID[:8] + "-XS", Options: map[string]string{"size": "XS"},
+		PriceNum: &num, PriceDen: &den, Actor: "alice", Role: domain.RoleEditor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, pendingID)
+	price, _ := variantPrice(variantID)
+	assert.Nil(t, price)
+
+	request, err := readModel.GetPriceChangeRequest(ctx, pendingID)
+	require.NoError(t, err)
+	assert.Equal(t, "variant_price", request.Kind)
+	require.NotNil(t, request.VariantID)
+	assert.Equal(t, variantID, *request.VariantID)
+	approve(pendingID)
+	price, _ = variantPrice(variantID)
+	require.NotNil(t, price)
+	assert.Equal(t, "15", *price)
+
+	// The rest of a variant update is applied while its price waits.
+	num = 500
+	inactive := "inactive"
+	pendingID, err = guardedUpdateVariantUC.Execute(ctx, update_variant.Request{
+		ProductID: productID, VariantID: variantID, PriceNum: &num, PriceDen: &den, Status: &inactive,
+		Actor: "alice", Role: domain.RoleEditor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, pendingID)
+	price, status := variantPrice(variantID)
+	assert.Equal(t, "15", *price)
+	assert.Equal(t, "inactive", status)
+	require.NoError(t, rejectChangeUC.Execute(ctx, reject_price_change.Request{RequestID: pendingID, Actor: "alice"}))
+
+	// A channel price is held back against the base price the channel sells at.
+	num = 1000
+	pendingID, err = guardedSetChannelPriceUC.Execute(ctx, set_channel_price.Request{
+		ProductID: productID, Channel: "Web", PriceNum: &num, PriceDen: &den, Actor: "alice", Role: domain.RoleEditor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, pendingID)
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, prod.Channels)
+
+	request, err = readModel.GetPriceChangeRequest(ctx, pendingID)
+	require.NoError(t, err)
+	assert.Equal(t, "channel_price", request.Kind)
+	require.NotNil(t, request.Channel)
+	assert.Equal(t, "web", *request.Channel)
+	approve(pendingID)
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	require.Len(t, prod.Channels, 1)
+	require.NotNil(t, prod.Channels[0].PriceOverride)
+	assert.Equal(t, "10", *prod.Channels[0].PriceOverride)
+}
+
+// snapshotRequestRM serves a price change request as it was read before a concurrent decision.
+type snapshotRequestRM struct {
+	contracts.PriceChangeRequestReadModel
+	snapshot *dto.PriceChangeRequestDTO
+}
+
+func (s snapshotRequestRM) GetPriceChangeRequest(context.Context, string) (*dto.PriceChangeRequestDTO, error) {
+	return s.snapshot, nil
+}
+
+func TestPriceApprovalRace(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Office Chair",
+		Category:     "furniture",
+		BasePriceNum: 29900,
+		BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+	pendingID, err := guardedSetPriceUC.Execute(ctx, set_product_price.Request{
+		ProductID: productID, PriceNum: 2990, PriceDen: 100, Actor: "alice", Role: domain.RoleEditor,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, pendingID)
+
+	// Both deciders load the pending request before either commits.
+	snapshot, err := readModel.GetPriceChangeRequest(ctx, pendingID)
+	require.NoError(t, err)
+	require.NoError(t, rejectChangeUC.Execute(ctx, reject_price_change.Request{
+		RequestID: pendingID, Actor: "carol", Role: domain.RoleAdmin, Reason: "typo",
+	}))
+
+	staleApprove := *approveChangeUC
+	staleApprove.RequestRM = snapshotRequestRM{PriceChangeRequestReadModel: readModel, snapshot: snapshot}
+	err = staleApprove.Execute(ctx, approve_price_change.Request{RequestID: pendingID, Actor: "bob", Role: domain.RolePricingManager})
+	assert.ErrorIs(t, err, domain.ErrPriceChangeRequestNotPending)
+
+	staleReject := *rejectChangeUC
+	staleReject.RequestRM = snapshotRequestRM{PriceChangeRequestReadModel: readModel, snapshot: snapshot}
+	err = staleReject.Execute(ctx, reject_price_change.Request{RequestID: pendingID, Actor: "bob", Role: domain.RoleAdmin})
+	assert.ErrorIs(t, err, domain.ErrPriceChangeRequestNotPending)
+
+	prod, err := get_product.NewHandler(readModel).Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "299", prod.BasePrice)
+	request, err := readModel.GetPriceChangeRequest(ctx, pendingID)
+	require.NoError(t, err)
+	assert.Equal(t, "rejected", request.Status)
+	assert.Equal(t, "carol", request.DecidedBy)
+}
@@ -0,0 +1,90 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_discount"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_price_list"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+)
+
+func TestSegmentPriceListFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Wholesale Product",
+		Category:     "office",
+		BasePriceNum: 10000,
+		BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: productID}))
+
+	// Segments are unique per database; keep them unique per run.
+	segment := "wholesale-" + uuid.New().String()[:8]
+	priceListID, err := createPriceListUC.Execute(ctx, create_price_list.Request{
+		Segment: segment,
+		Name:    "Wholesale",
+	})
+	require.NoError(t, err)
+
+	// 15% below base price for the segment.
+	require.NoError(t, setPriceEntryUC.Execute(ctx, set_price_list_entry.Request{
+		PriceListID: priceListID,
+		ProductID:   productID,
+		Adjustment:  big.NewRat(-15, 100),
+	}))
+
+	getQ := get_product.NewHandler(readModel)
+
+	retail, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "100.0000000000", retail.EffectivePrice)
+
+	wholesale, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{Segment: segment})
+	require.NoError(t, err)
+	assert.Equal(t, "85.0000000000", wholesale.EffectivePrice)
+
+	// A product discount applies on top of the segment price: 85.00 - 20% = 68.00
+	now := time.Now().UTC()
+	_, err = applyDisUC.Execute(ctx, apply_discount.Request{
+		ProductID:  productID,
+		Percentage: big.NewRat(20, 1),
+		StartDate:  now.Add(-1 * time.Hour),
+		EndDate:    now.Add(1 * time.Hour),
+	})
+	require.NoError(t, err)
+	wholesale, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{Segment: segment})
+	require.NoError(t, err)
+	assert.Equal(t, "68.0000000000", wholesale.EffectivePrice)
+
+	// Duplicate segments are rejected.
+	_, err = createPriceListUC.Execute(ctx, create_price_list.Request{Segment: segment, Name: "Again"})
+	assert.ErrorIs(t, err, domain.ErrPriceListSegmentTaken)
+
+	// Unknown segments are reported, not silently priced at retail.
+	_, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{Segment: "no-such-segment"})
+	assert.ErrorIs(t, err, domain.ErrPriceListNotFound)
+
+	events := mustFetchOutboxEvents(ctx, t, spClient, priceListID)
+	types := make([]string, 0, len(events))
+	for _, e := range events {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "price_list.created")
+	assert.Contains(t, types, "price_list.entry_set")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
 	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
@@ -36,7 +37,7 @@ func TestProductCreationFlow(t *testing.T) {
 	require.NotEmpty(t, productID)
 
 	getQ := get_product.NewHandler(readModel)
-	prod, err := getQ.Execute(ctx, productID)
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
 	require.NoError(t, err)
 
 	assert.Equal(t, "Test Product", prod.Name)
@@ -72,9 +73,9 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	start := now.Add(-1 * time.Hour)
 	end := now.Add(1 * time.Hour)
 
-	err = applyDisUC.Execute(ctx, apply_discount.Request{
+	_, err = applyDisUC.Execute(ctx, apply_discount.Request{
 		ProductID:  productID,
-		Percentage: 20, // 20% off
+		Percentage: big.NewRat(20, 1), // 20% off
 		StartDate:  start,
 		EndDate:    end,
 	})
@@ -82,7 +83,7 @@ func TestDiscountApplicationFlow(t *testing.T) {
 
 	// Verify effective price.
 	getQ := get_product.NewHandler(readModel)
-	prod, err := getQ.Execute(ctx, productID)
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
 	require.NoError(t, err)
 
 	// 100.00 - 20% = 80.00
@@ -90,7 +91,7 @@ func TestDiscountApplicationFlow(t *testing.T) {
 
 	// Also verify via list query (active products).
 	listQ := list_products.NewHandler(readModel)
-	items, err := listQ.Execute(ctx, nil, 10, 0)
+	items, err := listQ.Execute(ctx, contracts.ProductFilter{}, contracts.ProductReadOptions{}, 10, 0)
 	require.NoError(t, err)
 	found := false
 	for _, it := range items {
@@ -118,9 +119,9 @@ func TestBusinessRuleValidation_CannotApplyDiscountToInactiveProduct(t *testing.
 	require.NoError(t, err)
 
 	now := time.Now().UTC()
-	err = applyDisUC.Execute(ctx, apply_discount.Request{
+	_, err = applyDisUC.Execute(ctx, apply_discount.Request{
 		ProductID:  productID,
-		Percentage: 10,
+		Percentage: big.NewRat(10, 1),
 		StartDate:  now.Add(-1 * time.Hour),
 		EndDate:    now.Add(1 * time.Hour),
 	})
@@ -163,7 +164,7 @@ func TestProductUpdateFlow_CreatesOutboxEvent(t *testing.T) {
 	}))
 
 	getQ := get_product.NewHandler(readModel)
-	prod, err := getQ.Execute(ctx, productID)
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
 	require.NoError(t, err)
 	assert.Equal(t, "New Name", prod.Name)
 	assert.Equal(t, "stationery", prod.Category)
@@ -198,15 +199,16 @@ func TestEffectivePriceMathMatchesBigRat(t *testing.T) {
 	now := time.Now().UTC()
 	start := now.Add(-1 * time.Hour)
 	end := now.Add(1 * time.Hour)
-	require.NoError(t, applyDisUC.Execute(ctx, apply_discount.Request{
+	_, err = applyDisUC.Execute(ctx, apply_discount.Request{
 		ProductID:  productID,
-		Percentage: 20,
+		Percentage: big.NewRat(20, 1),
 		StartDate:  start,
 		EndDate:    end,
-	}))
+	})
+	require.NoError(t, err)
 
 	getQ := get_product.NewHandler(readModel)
-	prod, err := getQ.Execute(ctx, productID)
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
 	require.NoError(t, err)
 
 	base := new(big.Rat).SetFrac(big.NewInt(1999), big.NewInt(100))
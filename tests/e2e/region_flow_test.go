@@ -0,0 +1,81 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_regions"
+)
+
+func TestRegionFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	create := func(name string) string {
+		id, err := createUC.Execute(ctx, create_product.Request{
+			Name: name, Category: "garden", BasePriceNum: 2000, BasePriceDen: 100,
+		})
+		require.NoError(t, err)
+		require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: id}))
+		return id
+	}
+	fertilizerID := create("Lawn Fertilizer")
+	shovelID := create("Garden Shovel")
+
+	// Germany needs a euro price first.
+	err := setRegionsUC.Execute(ctx, set_product_regions.Request{ProductID: fertilizerID, AllowedRegions: []string{"US", "DE"}})
+	assert.ErrorIs(t, err, domain.ErrNoPriceInRegionCurrency)
+	_, err = setPriceUC.Execute(ctx, set_product_price.Request{
+		ProductID: fertilizerID, PriceNum: 1900, PriceDen: 100, Currency: "EUR",
+	})
+	require.NoError(t, err)
+	require.NoError(t, setRegionsUC.Execute(ctx, set_product_regions.Request{ProductID: fertilizerID, AllowedRegions: []string{"us", "de"}}))
+	err = removePriceUC.Execute(ctx, remove_product_price.Request{ProductID: fertilizerID, Currency: "EUR"})
+	assert.ErrorIs(t, err, domain.ErrPriceNeededByRegion)
+	require.NoError(t, setRegionsUC.Execute(ctx, set_product_regions.Request{ProductID: shovelID, BlockedRegions: []string{"AU"}}))
+
+	getQ := get_product.NewHandler(readModel)
+	fertilizer, err := getQ.Execute(ctx, fertilizerID, contracts.ProductReadOptions{Region: "DE"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DE", "US"}, fertilizer.AllowedRegions)
+	_, err = getQ.Execute(ctx, fertilizerID, contracts.ProductReadOptions{Region: "AU"})
+	assert.ErrorIs(t, err, domain.ErrNotAvailableInRegion)
+
+	listQ := list_products.NewHandler(readModel)
+	listed := func(region string) map[string]bool {
+		items, err := listQ.Execute(ctx, contracts.ProductFilter{}, contracts.ProductReadOptions{Region: region}, 100, 0)
+		require.NoError(t, err)
+		out := map[string]bool{}
+		for _, it := range items {
+			out[it.ProductID] = true
+		}
+		return out
+	}
+	inAustralia := listed("AU")
+	assert.NotContains(t, inAustralia, fertilizerID)
+	assert.NotContains(t, inAustralia, shovelID)
+	inGermany := listed("DE")
+	assert.Contains(t, inGermany, fertilizerID)
+	assert.Contains(t, inGermany, shovelID)
+	inAll := listed("")
+	assert.Contains(t, inAll, fertilizerID)
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, shovelID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 1, eventTypes["product.updated"])
+}
@@ -0,0 +1,84 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_relation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_relation"
+)
+
+func TestRelationFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	create := func(name string, price int64, active bool) string {
+		id, err := createUC.Execute(ctx, create_product.Request{
+			Name: name, Category: "cameras", BasePriceNum: price, BasePriceDen: 100,
+		})
+		require.NoError(t, err)
+		if active {
+			require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: id}))
+		}
+		return id
+	}
+	cameraID := create("Mirrorless Camera", 129900, true)
+	lensID := create("Portrait Lens", 49900, true)
+	bagID := create("Camera Bag", 5900, true)
+	oldCameraID := create("Mirrorless Camera (2024)", 99900, false)
+
+	link := func(from, to string, relType domain.RelationType) {
+		require.NoError(t, addRelationUC.Execute(ctx, add_relation.Request{ProductID: from, RelatedProductID: to, Type: string(relType)}))
+	}
+	link(lensID, cameraID, domain.RelationAccessoryOf)
+	link(bagID, cameraID, domain.RelationAccessoryOf)
+	link(cameraID, lensID, domain.RelationFrequentlyBoughtWith)
+	link(cameraID, oldCameraID, domain.RelationReplacementFor)
+
+	err := addRelationUC.Execute(ctx, add_relation.Request{ProductID: cameraID, RelatedProductID: cameraID, Type: "accessory_of"})
+	assert.ErrorIs(t, err, domain.ErrSelfRelation)
+	err = addRelationUC.Execute(ctx, add_relation.Request{ProductID: cameraID, RelatedProductID: "no-such-product", Type: "accessory_of"})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	err = addRelationUC.Execute(ctx, add_relation.Request{ProductID: cameraID, RelatedProductID: lensID, Type: "goes_with"})
+	assert.ErrorIs(t, err, domain.ErrInvalidRelationType)
+
+	camera, err := get_product.NewHandler(readModel).Execute(ctx, cameraID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	require.Len(t, camera.Relations, 2)
+	assert.Equal(t, "frequently_bought_with", camera.Relations[0].Type)
+	assert.Equal(t, lensID, camera.Relations[0].ProductID)
+
+	listQ := list_products.NewHandler(readModel)
+	related := func(inverse bool, types ...domain.RelationType) map[string]string {
+		items, err := listQ.ExecuteRelated(ctx, cameraID, types, inverse, contracts.ProductReadOptions{}, 10, 0)
+		require.NoError(t, err)
+		out := make(map[string]string, len(items))
+		for _, it := range items {
+			assert.NotEmpty(t, it.EffectivePrice)
+			out[it.ProductID] = it.RelationType
+		}
+		return out
+	}
+
+	// The inactive old camera is linked but not listed.
+	assert.Equal(t, map[string]string{lensID: "frequently_bought_with"}, related(false))
+	assert.Empty(t, related(false, domain.RelationReplacementFor))
+	assert.Equal(t, map[string]string{lensID: "accessory_of", bagID: "accessory_of"}, related(true, domain.RelationAccessoryOf))
+
+	require.NoError(t, removeRelationUC.Execute(ctx, remove_relation.Request{ProductID: bagID, RelatedProductID: cameraID, Type: "accessory_of"}))
+	assert.Equal(t, map[string]string{lensID: "accessory_of"}, related(true))
+
+	_, err = listQ.ExecuteRelated(ctx, "no-such-product", nil, false, contracts.ProductReadOptions{}, 10, 0)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
@@ -0,0 +1,126 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/repo"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_scheduled_prices"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/cancel_scheduled_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
+	committer "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+)
+
+func TestScheduledPriceFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Espresso Machine",
+		Category:     "appliances",
+		BasePriceNum: 19900,
+		BasePriceDen: 100,
+		Currency:     "EUR",
+	})
+	require.NoError(t, err)
+
+	effectiveAt := clk.Now().Add(7 * 24 * time.Hour)
+	scheduleID, err := schedulePriceUC.Execute(ctx, schedule_price_change.Request{
+		ProductID:   productID,
+		PriceNum:    21900,
+		PriceDen:    100,
+		EffectiveAt: effectiveAt,
+	})
+	require.NoError(t, err)
+
+	// Before the effective time the current price applies; from it on the scheduled one,
+	// even though the scheduler has not run yet.
+	getQ := get_product.NewHandler(readModel)
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{At: effectiveAt.Add(-time.Minute)})
+	require.NoError(t, err)
+	assert.Equal(t, "199", prod.EffectivePriceExact)
+	require.Len(t, prod.ScheduledPriceChanges, 1)
+	assert.Equal(t, scheduleID, prod.ScheduledPriceChanges[0].ScheduleID)
+	assert.Equal(t, "pending", prod.ScheduledPriceChanges[0].Status)
+
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{At: effectiveAt})
+	require.NoError(t, err)
+	assert.Equal(t, "219", prod.EffectivePriceExact)
+	assert.Equal(t, "199", prod.BasePrice)
+
+	// The scheduler applies the change once it is due and emits price.changed.
+	schedClock := clock.NewFake(effectiveAt.Add(time.Second))
+	scheduler := apply_scheduled_prices.NewInteractor(repo.NewProductRepo(), repo.NewOutboxRepo(),
+		committer.NewAdapter(spClient), readModel, readModel, nil, schedClock)
+	applied, err := scheduler.Execute(ctx, apply_scheduled_prices.Request{})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, applied, 1)
+
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "219", prod.BasePrice)
+	assert.Equal(t, "applied", prod.ScheduledPriceChanges[0].Status)
+
+	types := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, productID) {
+		types[ev.EventType]++
+	}
+	assert.Equal(t, 1, types["price.change_scheduled"])
+	assert.Equal(t, 1, types["price.changed"])
+
+	// Applied changes can no longer be cancelled.
+	err = cancelScheduledPriceUC.Execute(ctx, cancel_scheduled_price_change.Request{ProductID: productID, ScheduleID: scheduleID})
+	assert.ErrorIs(t, err, domain.ErrScheduledPriceChangeNotPending)
+}
+
+func TestCancelScheduledPriceFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Grinder",
+		Category:     "appliances",
+		BasePriceNum: 9900,
+		BasePriceDen: 100,
+		Currency:     "EUR",
+	})
+	require.NoError(t, err)
+
+	effectiveAt := clk.Now().Add(24 * time.Hour)
+	scheduleID, err := schedulePriceUC.Execute(ctx, schedule_price_change.Request{
+		ProductID:   productID,
+		PriceNum:    10900,
+		PriceDen:    100,
+		EffectiveAt: effectiveAt,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, cancelScheduledPriceUC.Execute(ctx, cancel_scheduled_price_change.Request{ProductID: productID, ScheduleID: scheduleID}))
+
+	getQ := get_product.NewHandler(readModel)
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{At: effectiveAt.Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, "99", prod.EffectivePriceExact)
+	require.Len(t, prod.ScheduledPriceChanges, 1)
+	assert.Equal(t, "cancelled", prod.ScheduledPriceChanges[0].Status)
+
+	// Scheduling in the past is rejected.
+	_, err = schedulePriceUC.Execute(ctx, schedule_price_change.Request{
+		ProductID:   productID,
+		PriceNum:    10900,
+		PriceDen:    100,
+		EffectiveAt: clk.Now().Add(-time.Hour),
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidScheduleTime)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -20,14 +21,54 @@ import (
 	databasepb "cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
 	instancepb "cloud.google.com/go/spanner/admin/instance/apiv1/instancepb"
 
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
 	"github.com/murkotick/product-catalog-service/internal/app/product/queries"
 	"github.com/murkotick/product-catalog-service/internal/app/product/repo"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_channel"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_relation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_tags"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/adjust_stock"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_discount"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/approve_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/cancel_scheduled_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_price_list"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/deactivate_channel"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/define_attribute"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/delete_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/import_exchange_rates"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/move_category"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reject_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/release_reservation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_attribute"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_relation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_tags"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_translation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reorder_media"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/reserve_stock"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/schedule_price_change"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_bundle"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_channel_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_price_list_entry"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_availability"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_price"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_product_regions"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_tax_rate"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_translation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_category"
 	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_variant"
 	"github.com/murkotick/product-catalog-service/internal/pkg/clock"
 	committer "github.com/murkotick/product-catalog-service/internal/pkg/committer"
+	"github.com/murkotick/product-catalog-service/internal/pkg/migration"
 )
 
 var (
@@ -39,6 +80,67 @@ var (
 	activateUC *activate_product.Interactor
 	applyDisUC *apply_discount.Interactor
 
+	setPriceUC    *set_product_price.Interactor
+	removePriceUC *remove_product_price.Interactor
+
+	schedulePriceUC        *schedule_price_change.Interactor
+	cancelScheduledPriceUC *cancel_scheduled_price_change.Interactor
+
+	// guardedSetPriceUC, guardedApplyDisUC and the variant and channel price interactors
+	// hold large changes back for approval; guardedSchedulePriceUC refuses to schedule them.
+	guardedSetPriceUC        *set_product_price.Interactor
+	guardedApplyDisUC        *apply_discount.Interactor
+	guardedSchedulePriceUC   *schedule_price_change.Interactor
+	guardedAddVariantUC      *add_variant.Interactor
+	guardedUpdateVariantUC   *update_variant.Interactor
+	guardedSetChannelPriceUC *set_channel_price.Interactor
+	approveChangeUC          *approve_price_change.Interactor
+	rejectChangeUC           *reject_price_change.Interactor
+
+	addVariantUC    *add_variant.Interactor
+	updateVariantUC *update_variant.Interactor
+	removeVariantUC *remove_variant.Interactor
+
+	addTagsUC    *add_tags.Interactor
+	removeTagsUC *remove_tags.Interactor
+
+	setTranslationUC    *set_translation.Interactor
+	removeTranslationUC *remove_translation.Interactor
+
+	addMediaUC     *add_media.Interactor
+	reorderMediaUC *reorder_media.Interactor
+	removeMediaUC  *remove_media.Interactor
+
+	addRelationUC    *add_relation.Interactor
+	removeRelationUC *remove_relation.Interactor
+
+	setBundleUC      *set_bundle.Interactor
+	archiveProductUC *archive_product.Interactor
+
+	adjustStockUC        *adjust_stock.Interactor
+	reserveStockUC       *reserve_stock.Interactor
+	releaseReservationUC *release_reservation.Interactor
+
+	activateChannelUC   *activate_channel.Interactor
+	deactivateChannelUC *deactivate_channel.Interactor
+	setChannelPriceUC   *set_channel_price.Interactor
+	setRegionsUC        *set_product_regions.Interactor
+	setAvailabilityUC   *set_product_availability.Interactor
+
+	createPriceListUC *create_price_list.Interactor
+	setPriceEntryUC   *set_price_list_entry.Interactor
+
+	importRatesUC *import_exchange_rates.Interactor
+	setTaxRateUC  *set_tax_rate.Interactor
+
+	defineAttributeUC *define_attribute.Interactor
+	removeAttributeUC *remove_attribute.Interactor
+
+	createCategoryUC *create_category.Interactor
+	updateCategoryUC *update_category.Interactor
+	moveCategoryUC   *move_category.Interactor
+	deleteCategoryUC *delete_category.Interactor
+
 	readModel *queries.SpannerReadModel
 
 	dbName string
@@ -99,13 +201,25 @@ func TestMain(m *testing.M) {
 		}
 	}
 
-	// Apply DDL.
-	ddlPath := filepath.Join("..", "..", "migrations", "001_initial_schema.sql")
-	ddl, err := os.ReadFile(ddlPath)
+	// Apply DDL (all migrations, in order).
+	ddlPaths, err := filepath.Glob(filepath.Join("..", "..", "migrations", "*.sql"))
 	if err != nil {
-		panic(fmt.Sprintf("read %s: %v", ddlPath, err))
+		panic(fmt.Sprintf("list migrations: %v", err))
+	}
+	sort.Strings(ddlPaths)
+	stmts := make([]string, 0)
+	for _, ddlPath := range ddlPaths {
+		ddl, err := os.ReadFile(ddlPath)
+		if err != nil {
+			panic(fmt.Sprintf("read %s: %v", ddlPath, err))
+		}
+		// The database is fresh, so data backfills and checks have nothing to do.
+		for _, stmt := range migration.Split(string(ddl)) {
+			if migration.Classify(stmt) == migration.KindDDL {
+				stmts = append(stmts, stmt)
+			}
+		}
 	}
-	stmts := splitDDL(string(ddl))
 	ddlOp, err := dbAdmin.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
 		Database:   dbName,
 		Statements: stmts,
@@ -129,10 +243,79 @@ func TestMain(m *testing.M) {
 	cm := committer.NewAdapter(spClient)
 	readModel = queries.NewSpannerReadModel(spClient)
 
-	createUC = create_product.NewInteractor(prodRepo, outboxRepo, cm, clk)
-	updateUC = update_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	createUC = create_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, readModel, readModel, nil, clk)
+	updateUC = update_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, readModel, readModel, nil, clk)
 	activateUC = activate_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
-	applyDisUC = apply_discount.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	changeRequestRepo := repo.NewPriceChangeRequestRepo()
+	applyDisUC = apply_discount.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, nil, clk)
+	setPriceUC = set_product_price.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, nil, clk)
+	removePriceUC = remove_product_price.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	schedulePriceUC = schedule_price_change.NewInteractor(prodRepo, outboxRepo, cm, readModel, nil, nil, clk)
+	cancelScheduledPriceUC = cancel_scheduled_price_change.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+
+	approvals, err := domain.ParseApprovalPolicy("price_drop=0.3;discount=0.5")
+	if err != nil {
+		panic(fmt.Sprintf("ParseApprovalPolicy: %v", err))
+	}
+	guardedSetPriceUC = set_product_price.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, approvals, clk)
+	guardedApplyDisUC = apply_discount.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, approvals, clk)
+	guardedSchedulePriceUC = schedule_price_change.NewInteractor(prodRepo, outboxRepo, cm, readModel, nil, approvals, clk)
+	guardedAddVariantUC = add_variant.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, approvals, clk)
+	guardedUpdateVariantUC = update_variant.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, approvals, clk)
+	guardedSetChannelPriceUC = set_channel_price.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, approvals, clk)
+	approveChangeUC = approve_price_change.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, readModel, nil, clk)
+	rejectChangeUC = reject_price_change.NewInteractor(changeRequestRepo, outboxRepo, cm, readModel, clk)
+
+	addVariantUC = add_variant.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, nil, clk)
+	updateVariantUC = update_variant.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, nil, clk)
+	removeVariantUC = remove_variant.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+
+	addTagsUC = add_tags.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	removeTagsUC = remove_tags.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	setTranslationUC = set_translation.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	removeTranslationUC = remove_translation.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	addMediaUC = add_media.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	reorderMediaUC = reorder_media.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	removeMediaUC = remove_media.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	addRelationUC = add_relation.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	removeRelationUC = remove_relation.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	setBundleUC = set_bundle.NewInteractor(prodRepo, outboxRepo, cm, readModel, nil, clk)
+	archiveProductUC = archive_product.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+
+	stockRepo := repo.NewStockRepo()
+	adjustStockUC = adjust_stock.NewInteractor(stockRepo, outboxRepo, cm, readModel, readModel, clk)
+	reserveStockUC = reserve_stock.NewInteractor(stockRepo, outboxRepo, cm, readModel, readModel, clk)
+	releaseReservationUC = release_reservation.NewInteractor(stockRepo, outboxRepo, cm, readModel, clk)
+
+	activateChannelUC = activate_channel.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	deactivateChannelUC = deactivate_channel.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	setChannelPriceUC = set_channel_price.NewInteractor(prodRepo, changeRequestRepo, outboxRepo, cm, readModel, nil, nil, clk)
+	setRegionsUC = set_product_regions.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+	setAvailabilityUC = set_product_availability.NewInteractor(prodRepo, outboxRepo, cm, readModel, clk)
+
+	priceListRepo := repo.NewPriceListRepo()
+	createPriceListUC = create_price_list.NewInteractor(priceListRepo, outboxRepo, cm, readModel, clk)
+	setPriceEntryUC = set_price_list_entry.NewInteractor(priceListRepo, outboxRepo, cm, readModel, readModel, clk)
+
+	importRatesUC = import_exchange_rates.NewInteractor(repo.NewExchangeRateRepo(), outboxRepo, cm, clk)
+	setTaxRateUC = set_tax_rate.NewInteractor(repo.NewTaxRateRepo(), outboxRepo, cm, clk)
+
+	attributeRepo := repo.NewAttributeSchemaRepo()
+	defineAttributeUC = define_attribute.NewInteractor(attributeRepo, outboxRepo, cm, readModel, readModel, clk)
+	removeAttributeUC = remove_attribute.NewInteractor(attributeRepo, outboxRepo, cm, readModel, clk)
+
+	categoryRepo := repo.NewCategoryRepo()
+	createCategoryUC = create_category.NewInteractor(categoryRepo, outboxRepo, cm, readModel, clk)
+	updateCategoryUC = update_category.NewInteractor(categoryRepo, outboxRepo, cm, readModel, clk)
+	moveCategoryUC = move_category.NewInteractor(categoryRepo, outboxRepo, cm, readModel, clk)
+	deleteCategoryUC = delete_category.NewInteractor(categoryRepo, outboxRepo, cm, readModel, clk)
+
+	// Products must belong to an existing category; seed the ones the flows share.
+	for _, name := range []string{"apparel", "appliances", "books", "electronics", "furniture", "grocery", "office", "stationery"} {
+		if _, err := createCategoryUC.Execute(ctx, create_category.Request{Name: name}); err != nil {
+			panic(fmt.Sprintf("create category %s: %v", name, err))
+		}
+	}
 
 	code := m.Run()
 
@@ -186,21 +369,6 @@ func shortDBID() string {
 	return "e2e_" + hex[:12]
 }
 
-func splitDDL(sql string) []string {
-	// normalize line endings
-	sql = strings.ReplaceAll(sql, "\r\n", "\n")
-	parts := strings.Split(sql, ";")
-	out := make([]string, 0, len(parts))
-	for _, p := range parts {
-		stmt := strings.TrimSpace(p)
-		if stmt == "" {
-			continue
-		}
-		out = append(out, stmt)
-	}
-	return out
-}
-
 func env(key, def string) string {
 	if v := os.Getenv(key); v != "" {
 		return v
@@ -208,6 +376,16 @@ func env(key, def string) string {
 	return def
 }
 
+// mustCreateCategory creates a root category for a test's own products and returns its slug.
+func mustCreateCategory(ctx context.Context, t *testing.T, name string) string {
+	t.Helper()
+	id, err := createCategoryUC.Execute(ctx, create_category.Request{Name: name})
+	require.NoError(t, err)
+	category, err := readModel.GetCategory(ctx, id)
+	require.NoError(t, err)
+	return category.Slug
+}
+
 func requireEmulator(t *testing.T) {
 	// A quick sanity check so failures are easier to understand.
 	require.NotEmpty(t, os.Getenv("SPANNER_EMULATOR_HOST"), "SPANNER_EMULATOR_HOST must be set (e.g. localhost:9010)")
@@ -0,0 +1,76 @@
+package e2e
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+)
+
+func TestProductSlugFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	suffix := uuid.New().String()[:8]
+	name := "Wireless Mouse " + suffix
+	slug := "wireless-mouse-" + suffix
+	create := func(name string) string {
+		id, err := createUC.Execute(ctx, create_product.Request{Name: name, Category: "electronics", BasePriceNum: 2999, BasePriceDen: 100})
+		require.NoError(t, err)
+		return id
+	}
+	getQ := get_product.NewHandler(readModel)
+	bySlug := func(slug string) (string, string, bool) {
+		p, redirect, err := getQ.ExecuteBySlug(ctx, slug, contracts.ProductReadOptions{})
+		require.NoError(t, err)
+		return p.ProductID, p.Slug, redirect
+	}
+
+	firstID := create(name)
+	id, current, redirect := bySlug(slug)
+	assert.Equal(t, firstID, id)
+	assert.Equal(t, slug, current)
+	assert.False(t, redirect)
+
+	// A second product with the same name gets its ID appended.
+	secondID := create(strings.ToUpper(name))
+	id, current, _ = bySlug(slug + "-" + strings.ReplaceAll(secondID, "-", "")[:8])
+	assert.Equal(t, secondID, id)
+	assert.NotEqual(t, slug, current)
+
+	// Renaming moves the old slug to the history; it still resolves, with a redirect.
+	newName := "Silent Mouse " + suffix
+	require.NoError(t, updateUC.Execute(ctx, update_product.Request{ProductID: firstID, Name: &newName}))
+	id, current, redirect = bySlug(slug)
+	assert.Equal(t, firstID, id)
+	assert.Equal(t, "silent-mouse-"+suffix, current)
+	assert.True(t, redirect)
+	_, _, redirect = bySlug("Silent-Mouse-" + suffix)
+	assert.False(t, redirect)
+
+	// Former slugs stay reserved for their product, which can take them back.
+	thirdID := create(name)
+	_, current, _ = bySlug(slug + "-" + strings.ReplaceAll(thirdID, "-", "")[:8])
+	assert.NotEqual(t, slug, current)
+	require.NoError(t, updateUC.Execute(ctx, update_product.Request{ProductID: firstID, Name: &name}))
+	id, current, redirect = bySlug(slug)
+	assert.Equal(t, firstID, id)
+	assert.Equal(t, slug, current)
+	assert.False(t, redirect)
+	_, _, redirect = bySlug("silent-mouse-" + suffix)
+	assert.True(t, redirect)
+
+	_, _, err := getQ.ExecuteBySlug(ctx, "no-such-"+suffix, contracts.ProductReadOptions{})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
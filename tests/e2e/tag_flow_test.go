@@ -0,0 +1,71 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_tags"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_tags"
+)
+
+func TestTagFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Unique tags keep other tests' products out of the listings.
+	suffix := uuid.New().String()[:8]
+	eco, holiday := "eco-"+suffix, "holiday-"+suffix
+
+	create := func(name string) string {
+		id, err := createUC.Execute(ctx, create_product.Request{
+			Name: name, Category: "grocery", BasePriceNum: 499, BasePriceDen: 100,
+		})
+		require.NoError(t, err)
+		require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: id}))
+		return id
+	}
+	teaID, cookiesID := create("Green Tea"), create("Gingerbread")
+
+	require.NoError(t, addTagsUC.Execute(ctx, add_tags.Request{ProductID: teaID, Tags: []string{" ECO-" + suffix, "new"}}))
+	require.NoError(t, addTagsUC.Execute(ctx, add_tags.Request{ProductID: cookiesID, Tags: []string{eco, holiday}}))
+	err := addTagsUC.Execute(ctx, add_tags.Request{ProductID: teaID, Tags: []string{"50% off"}})
+	assert.ErrorIs(t, err, domain.ErrInvalidTag)
+
+	prod, err := get_product.NewHandler(readModel).Execute(ctx, teaID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{eco, "new"}, prod.Tags)
+
+	listQ := list_products.NewHandler(readModel)
+	ids := func(match contracts.TagMatch, tags ...string) []string {
+		items, err := listQ.Execute(ctx, contracts.ProductFilter{Tags: tags, TagMatch: match}, contracts.ProductReadOptions{}, 10, 0)
+		require.NoError(t, err)
+		out := make([]string, 0, len(items))
+		for _, it := range items {
+			out = append(out, it.ProductID)
+		}
+		return out
+	}
+	assert.ElementsMatch(t, []string{teaID, cookiesID}, ids(contracts.TagMatchAny, eco, holiday))
+	assert.Equal(t, []string{cookiesID}, ids(contracts.TagMatchAll, eco, holiday))
+
+	require.NoError(t, removeTagsUC.Execute(ctx, remove_tags.Request{ProductID: cookiesID, Tags: []string{holiday, "unknown"}}))
+	assert.Empty(t, ids(contracts.TagMatchAny, holiday))
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, cookiesID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 2, eventTypes["product.updated"])
+}
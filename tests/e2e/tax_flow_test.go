@@ -0,0 +1,73 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_tax_rate"
+)
+
+func TestTaxInclusivePriceFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	suffix := time.Now().UTC().Format("150405000000")
+	category := mustCreateCategory(ctx, t, "tax-"+suffix)
+	taxCategory := "reduced-" + suffix
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Book",
+		Category:     category,
+		BasePriceNum: 1999,
+		BasePriceDen: 100,
+		Currency:     "EUR",
+		TaxCategory:  taxCategory,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: productID}))
+
+	getQ := get_product.NewHandler(readModel)
+
+	_, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{TaxRegion: "DE"})
+	assert.ErrorIs(t, err, domain.ErrTaxRateNotFound)
+
+	require.NoError(t, setTaxRateUC.Execute(ctx, set_tax_rate.Request{
+		Region:      "DE",
+		TaxCategory: taxCategory,
+		Rate:        big.NewRat(7, 100),
+		EffectiveAt: time.Now().UTC().Add(-time.Hour),
+	}))
+
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{TaxRegion: "DE"})
+	require.NoError(t, err)
+	assert.Equal(t, taxCategory, prod.TaxCategory)
+	require.NotNil(t, prod.Tax)
+	assert.Equal(t, "0.07", prod.Tax.Rate)
+	// 19.99 net + 7% = 1.3993 tax, 21.3893 gross
+	assert.Equal(t, "1999/100", prod.Tax.Net)
+	assert.Equal(t, "13993/10000", prod.Tax.Amount)
+	assert.Equal(t, "213893/10000", prod.Tax.Gross)
+	assert.Equal(t, "21.39", prod.Tax.RoundedGross)
+
+	listQ := list_products.NewHandler(readModel)
+	items, err := listQ.Execute(ctx, contracts.ProductFilter{Category: &category}, contracts.ProductReadOptions{TaxRegion: "DE"}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.NotNil(t, items[0].Tax)
+	assert.Equal(t, "213893/10000", items[0].Tax.Gross)
+
+	events := mustFetchOutboxEvents(ctx, t, spClient, "DE/"+taxCategory)
+	require.Len(t, events, 1)
+	assert.Equal(t, "tax_rate.set", events[0].EventType)
+}
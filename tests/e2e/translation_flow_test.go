@@ -0,0 +1,102 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_tags"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_translation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/set_translation"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+)
+
+func TestTranslationFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	id, err := createUC.Execute(ctx, create_product.Request{
+		Name: "Kettle", Description: "Boils water", Category: "appliances", BasePriceNum: 3999, BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: id}))
+
+	require.NoError(t, setTranslationUC.Execute(ctx, set_translation.Request{ProductID: id, Locale: "de", Name: "Wasserkocher", Description: "Kocht Wasser"}))
+	require.NoError(t, setTranslationUC.Execute(ctx, set_translation.Request{ProductID: id, Locale: "de_ch", Name: "Wasserchocher"}))
+	err = setTranslationUC.Execute(ctx, set_translation.Request{ProductID: id, Locale: "en", Name: "Kettle"})
+	assert.ErrorIs(t, err, domain.ErrDefaultLocaleTranslation)
+
+	getQ := get_product.NewHandler(readModel)
+	get := func(locales ...string) (string, string, string) {
+		p, err := getQ.Execute(ctx, id, contracts.ProductReadOptions{Locales: locales})
+		require.NoError(t, err)
+		desc := ""
+		if p.Description != nil {
+			desc = *p.Description
+		}
+		return p.Locale, p.Name, desc
+	}
+
+	// The Swiss name has no description of its own; it falls back to German.
+	locale, name, desc := get("de-CH")
+	assert.Equal(t, []string{"de-CH", "Wasserchocher", "Kocht Wasser"}, []string{locale, name, desc})
+	locale, name, _ = get("de-AT")
+	assert.Equal(t, []string{"de", "Wasserkocher"}, []string{locale, name})
+	locale, name, desc = get("fr")
+	assert.Equal(t, []string{"en", "Kettle", "Boils water"}, []string{locale, name, desc})
+
+	p, err := getQ.Execute(ctx, id, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "en", p.DefaultLocale)
+	require.Len(t, p.Translations, 2)
+	assert.Equal(t, "de", p.Translations[0].Locale)
+	assert.Equal(t, "de-CH", p.Translations[1].Locale)
+
+	tag := "kettle-" + uuid.New().String()[:8]
+	require.NoError(t, addTagsUC.Execute(ctx, add_tags.Request{ProductID: id, Tags: []string{tag}}))
+	items, err := list_products.NewHandler(readModel).Execute(ctx, contracts.ProductFilter{Tags: []string{tag}}, contracts.ProductReadOptions{Locales: []string{"de-AT"}}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Wasserkocher", items[0].Name)
+	assert.Equal(t, "de", items[0].Locale)
+
+	// Switching the default locale swaps the German translation with the English texts.
+	de := "de"
+	require.NoError(t, updateUC.Execute(ctx, update_product.Request{ProductID: id, DefaultLocale: &de}))
+	locale, name, desc = get()
+	assert.Equal(t, []string{"de", "Wasserkocher", "Kocht Wasser"}, []string{locale, name, desc})
+	locale, name, _ = get("en-GB")
+	assert.Equal(t, []string{"en", "Kettle"}, []string{locale, name})
+
+	require.NoError(t, removeTranslationUC.Execute(ctx, remove_translation.Request{ProductID: id, Locale: "en"}))
+	locale, name, _ = get("en")
+	assert.Equal(t, []string{"de", "Wasserkocher"}, []string{locale, name})
+
+	stmt := spanner.Statement{
+		SQL: `SELECT JSON_VALUE(payload, '$.locale') FROM outbox_events
+        WHERE aggregate_id = @id AND event_type = 'product.updated' AND JSON_VALUE(payload, '$.locale') IS NOT NULL`,
+		Params: map[string]any{"id": id},
+	}
+	locales := map[string]int{}
+	require.NoError(t, spClient.Single().Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		var l string
+		if err := r.Columns(&l); err != nil {
+			return err
+		}
+		locales[l]++
+		return nil
+	}))
+	assert.Equal(t, map[string]int{"de": 2, "de-CH": 1, "en": 1}, locales)
+}
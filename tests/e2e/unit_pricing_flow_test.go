@@ -0,0 +1,51 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_product"
+)
+
+func TestUnitPricingFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:            "Coffee Beans",
+		Category:        "grocery",
+		BasePriceNum:    749,
+		BasePriceDen:    100,
+		Currency:        "EUR",
+		PackageQuantity: big.NewRat(250, 1),
+		UnitOfMeasure:   "g",
+	})
+	require.NoError(t, err)
+
+	getQ := get_product.NewHandler(readModel)
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, prod.PackageQuantity)
+	assert.Equal(t, "250", *prod.PackageQuantity)
+	assert.Equal(t, "g", *prod.UnitOfMeasure)
+	require.NotNil(t, prod.UnitPrice)
+	// 7.49 per 250 g = 29.96 per kg
+	assert.Equal(t, "749/25", prod.UnitPrice.Price)
+	assert.Equal(t, "29.96", prod.UnitPrice.RoundedPrice)
+	assert.Equal(t, "kg", prod.UnitPrice.Unit)
+
+	require.NoError(t, updateUC.Execute(ctx, update_product.Request{ProductID: productID, ClearPackageSize: true}))
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, prod.PackageQuantity)
+	assert.Nil(t, prod.UnitPrice)
+}
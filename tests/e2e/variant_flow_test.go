@@ -0,0 +1,111 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/murkotick/product-catalog-service/internal/app/product/contracts"
+	"github.com/murkotick/product-catalog-service/internal/app/product/domain"
+	"github.com/murkotick/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/add_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/apply_discount"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/remove_variant"
+	"github.com/murkotick/product-catalog-service/internal/app/product/usecases/update_variant"
+)
+
+func TestVariantFlow(t *testing.T) {
+	requireEmulator(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	productID, err := createUC.Execute(ctx, create_product.Request{
+		Name:         "Merino Tee",
+		Category:     "apparel",
+		BasePriceNum: 4000,
+		BasePriceDen: 100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, activateUC.Execute(ctx, activate_product.Request{ProductID: productID}))
+	getQ := get_product.NewHandler(readModel)
+
+	sku := func(size string) string { return "TEE-" + productID[:8] + "-" + size }
+	num, den := int64(4500), int64(100)
+
+	// One variant inherits the parent price, the other has its own.
+	mediumID, _, err := addVariantUC.Execute(ctx, add_variant.Request{
+		ProductID: productID, SKU: sku("m"), Options: map[string]string{"size": "M", "color": "Black"},
+	})
+	require.NoError(t, err)
+	xxlID, _, err := addVariantUC.Execute(ctx, add_variant.Request{
+		ProductID: productID, SKU: sku("xxl"), Options: map[string]string{"size": "XXL", "color": "Black"},
+		PriceNum: &num, PriceDen: &den,
+	})
+	require.NoError(t, err)
+
+	_, _, err = addVariantUC.Execute(ctx, add_variant.Request{
+		ProductID: productID, SKU: sku("m2"), Options: map[string]string{"Size": "m", "color": "black"},
+	})
+	assert.ErrorIs(t, err, domain.ErrDuplicateVariantOptions)
+
+	_, err = applyDisUC.Execute(ctx, apply_discount.Request{
+		ProductID:  productID,
+		Percentage: big.NewRat(10, 1),
+		StartDate:  clk.Now().Add(-time.Hour),
+		EndDate:    clk.Now().Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	prod, err := getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	require.Len(t, prod.Variants, 2)
+	byID := map[string]int{}
+	for i, v := range prod.Variants {
+		byID[v.VariantID] = i
+	}
+	medium, xxl := prod.Variants[byID[mediumID]], prod.Variants[byID[xxlID]]
+	assert.Nil(t, medium.Price)
+	assert.Equal(t, "36.0000000000", medium.EffectivePrice)
+	require.NotNil(t, xxl.Price)
+	assert.Equal(t, "45", *xxl.Price)
+	assert.Equal(t, "40.5000000000", xxl.EffectivePrice)
+	assert.Equal(t, "M", medium.Options["size"])
+
+	inactive := "inactive"
+	_, err = updateVariantUC.Execute(ctx, update_variant.Request{
+		ProductID: productID, VariantID: xxlID, ClearPrice: true, Status: &inactive,
+	})
+	require.NoError(t, err)
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	for _, v := range prod.Variants {
+		if v.VariantID == xxlID {
+			assert.Nil(t, v.Price)
+			assert.Equal(t, "inactive", v.Status)
+			assert.Equal(t, "36.0000000000", v.EffectivePrice)
+		}
+	}
+
+	require.NoError(t, removeVariantUC.Execute(ctx, remove_variant.Request{ProductID: productID, VariantID: mediumID}))
+	err = removeVariantUC.Execute(ctx, remove_variant.Request{ProductID: productID, VariantID: mediumID})
+	assert.ErrorIs(t, err, domain.ErrVariantNotFound)
+
+	prod, err = getQ.Execute(ctx, productID, contracts.ProductReadOptions{})
+	require.NoError(t, err)
+	require.Len(t, prod.Variants, 1)
+	assert.Equal(t, xxlID, prod.Variants[0].VariantID)
+
+	eventTypes := map[string]int{}
+	for _, ev := range mustFetchOutboxEvents(ctx, t, spClient, productID) {
+		eventTypes[ev.EventType]++
+	}
+	assert.Equal(t, 2, eventTypes["product.variant_added"])
+	assert.Equal(t, 1, eventTypes["product.variant_updated"])
+	assert.Equal(t, 1, eventTypes["product.variant_removed"])
+}